@@ -0,0 +1,150 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// IssueBatchCommand issues the get or set command to every device selected by the batch command, at most
+// BatchCommand.MaxConcurrency devices are commanded at the same time. The failure of a device is reported in its result
+// instead of failing the whole batch.
+func IssueBatchCommand(ctx context.Context, command commandDTOs.BatchCommand, dic *di.Container) (result commandDTOs.BatchCommandResult, err errors.EdgeX) {
+	batchCommandInfo := commandContainer.ConfigurationFrom(dic.Get).BatchCommand
+	deviceNames, err := selectDevices(ctx, command.Selector, batchCommandInfo.MaxDevices, dic)
+	if err != nil {
+		return result, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	queryParams := url.Values{}
+	for k, v := range command.QueryParams {
+		queryParams.Set(k, v)
+	}
+	rawQuery := queryParams.Encode()
+
+	result.Results = make([]commandDTOs.DeviceCommandResult, len(deviceNames))
+	semaphore := make(chan struct{}, max(batchCommandInfo.MaxConcurrency, 1))
+	var wg sync.WaitGroup
+	for i, deviceName := range deviceNames {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			result.Results[i] = issueDeviceCommand(ctx, deviceName, command, rawQuery, dic)
+		}()
+	}
+	wg.Wait()
+
+	for _, r := range result.Results {
+		if r.StatusCode < http.StatusMultipleChoices {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+// issueDeviceCommand issues the get or set command of the batch command to the device and returns the result
+func issueDeviceCommand(ctx context.Context, deviceName string, command commandDTOs.BatchCommand, queryParams string, dic *di.Container) commandDTOs.DeviceCommandResult {
+	result := commandDTOs.DeviceCommandResult{DeviceName: deviceName, StatusCode: http.StatusOK}
+	if command.Method == constants.CommandMethodGet {
+		res, err := IssueGetCommandByName(ctx, deviceName, command.CommandName, queryParams, dic)
+		if err != nil {
+			result.StatusCode = CommandErrorCode(err)
+			result.Message = err.Error()
+			return result
+		}
+		// no event is returned if ds-returnevent is false
+		if res != nil {
+			result.StatusCode = res.StatusCode
+			result.Message = res.Message
+			result.Event = &res.Event
+		}
+		return result
+	}
+
+	res, err := IssueSetCommandByName(ctx, deviceName, command.CommandName, queryParams, command.Settings, dic)
+	if err != nil {
+		result.StatusCode = CommandErrorCode(err)
+		result.Message = err.Error()
+		return result
+	}
+	result.StatusCode = res.StatusCode
+	result.Message = res.Message
+	return result
+}
+
+// selectDevices returns the names of the devices selected by the selector, error is returned if more than maxDevices
+// devices are selected
+func selectDevices(ctx context.Context, selector commandDTOs.DeviceSelector, maxDevices int, dic *di.Container) ([]string, errors.EdgeX) {
+	var deviceNames []string
+	if len(selector.DeviceNames) > 0 {
+		selected := make(map[string]bool, len(selector.DeviceNames))
+		for _, name := range selector.DeviceNames {
+			if !selected[name] {
+				selected[name] = true
+				deviceNames = append(deviceNames, name)
+			}
+		}
+	} else {
+		dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+		if dc == nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+		}
+		// the devices queried by labels are filtered by the profile name afterwards, so the total count is not final
+		filtered := len(selector.Labels) > 0 && selector.ProfileName != ""
+		// page through the devices since core-metadata limits the number of devices returned at once
+		for offset := 0; ; {
+			var res responses.MultiDevicesResponse
+			var err errors.EdgeX
+			if len(selector.Labels) == 0 {
+				res, err = dc.DevicesByProfileName(ctx, selector.ProfileName, offset, -1)
+			} else {
+				res, err = dc.AllDevices(ctx, selector.Labels, offset, -1)
+			}
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			if maxDevices > 0 && int(res.TotalCount) > maxDevices && !filtered {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%d devices are selected, which exceeds the maximum %d devices of a batch command", res.TotalCount, maxDevices), nil)
+			}
+			for _, device := range res.Devices {
+				if !filtered || device.ProfileName == selector.ProfileName {
+					deviceNames = append(deviceNames, device.Name)
+				}
+			}
+			offset += len(res.Devices)
+			if len(res.Devices) == 0 || offset >= int(res.TotalCount) {
+				break
+			}
+		}
+	}
+
+	if len(deviceNames) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "no device is selected by the batch command", nil)
+	}
+	if maxDevices > 0 && len(deviceNames) > maxDevices {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%d devices are selected, which exceeds the maximum %d devices of a batch command", len(deviceNames), maxDevices), nil)
+	}
+	return deviceNames, nil
+}
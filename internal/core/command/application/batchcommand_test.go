@@ -0,0 +1,161 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+const (
+	testServiceName = "testService"
+	testBaseAddress = "http://localhost:59900"
+	testLabel       = "thermostat"
+	testProfileName = "testProfile"
+)
+
+func newBatchCommandDIC(maxDevices int) (*di.Container, *mocks.DeviceClient, *mocks.DeviceServiceCommandClient) {
+	dcMock := &mocks.DeviceClient{}
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), testServiceName).
+		Return(responses.NewDeviceServiceResponse("", "", http.StatusOK, dtos.DeviceService{Name: testServiceName, BaseAddress: testBaseAddress}), nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).
+		Return(responses.NewDeviceProfileResponse("", "", http.StatusOK, dtos.DeviceProfile{
+			DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+			DeviceResources: []dtos.DeviceResource{
+				{Name: "setpoint", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+				{Name: "temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+			},
+		}), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				BatchCommand: config.BatchCommandInfo{MaxConcurrency: 2, MaxDevices: maxDevices},
+			}
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	return dic, dcMock, dsccMock
+}
+
+func mockDevice(dcMock *mocks.DeviceClient, name string) {
+	dcMock.On("DeviceByName", context.Background(), name).
+		Return(responses.NewDeviceResponse("", "", http.StatusOK, dtos.Device{Name: name, ServiceName: testServiceName, ProfileName: testProfileName}), nil)
+}
+
+func TestIssueBatchCommand_Set(t *testing.T) {
+	settings := map[string]any{"setpoint": "21"}
+	dic, dcMock, dsccMock := newBatchCommandDIC(10)
+	mockDevice(dcMock, "device1")
+	mockDevice(dcMock, "device2")
+	dcMock.On("DeviceByName", context.Background(), "unknown").
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, "device1", "setpoint", "", settings).
+		Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, "device2", "setpoint", "", settings).
+		Return(commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device unreachable", nil))
+
+	command := commandDTOs.BatchCommand{
+		CommandName: "setpoint",
+		Method:      constants.CommandMethodSet,
+		Selector:    commandDTOs.DeviceSelector{DeviceNames: []string{"device1", "device2", "unknown", "device1"}},
+		Settings:    settings,
+	}
+	result, err := IssueBatchCommand(context.Background(), command, dic)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Succeeded)
+	assert.Equal(t, 2, result.Failed)
+	require.Len(t, result.Results, 3)
+	assert.Equal(t, "device1", result.Results[0].DeviceName)
+	assert.Equal(t, http.StatusOK, result.Results[0].StatusCode)
+	assert.Equal(t, "device2", result.Results[1].DeviceName)
+	assert.Equal(t, http.StatusServiceUnavailable, result.Results[1].StatusCode)
+	assert.NotEmpty(t, result.Results[1].Message)
+	assert.Equal(t, "unknown", result.Results[2].DeviceName)
+	assert.Equal(t, http.StatusNotFound, result.Results[2].StatusCode)
+}
+
+func TestIssueBatchCommand_GetByLabels(t *testing.T) {
+	dic, dcMock, dsccMock := newBatchCommandDIC(10)
+	mockDevice(dcMock, "device1")
+	dcMock.On("AllDevices", context.Background(), []string{testLabel}, 0, -1).
+		Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 2, []dtos.Device{
+			{Name: "device1", ProfileName: testProfileName},
+			{Name: "device2", ProfileName: "otherProfile"},
+		}), nil)
+	event := dtos.Event{DeviceName: "device1", SourceName: "temperature"}
+	dsccMock.On("GetCommand", context.Background(), testBaseAddress, "device1", "temperature", "ds-pushevent=true").
+		Return(&responses.EventResponse{BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK), Event: event}, nil)
+
+	command := commandDTOs.BatchCommand{
+		CommandName: "temperature",
+		Method:      constants.CommandMethodGet,
+		Selector:    commandDTOs.DeviceSelector{Labels: []string{testLabel}, ProfileName: testProfileName},
+		QueryParams: map[string]string{"ds-pushevent": "true"},
+	}
+	result, err := IssueBatchCommand(context.Background(), command, dic)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Succeeded)
+	require.Len(t, result.Results, 1)
+	require.NotNil(t, result.Results[0].Event)
+	assert.Equal(t, event.SourceName, result.Results[0].Event.SourceName)
+}
+
+func TestIssueBatchCommand_SelectorErrors(t *testing.T) {
+	dic, dcMock, _ := newBatchCommandDIC(1)
+	dcMock.On("DevicesByProfileName", context.Background(), testProfileName, 0, -1).
+		Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 2, []dtos.Device{{Name: "device1"}, {Name: "device2"}}), nil)
+	dcMock.On("DevicesByProfileName", context.Background(), "emptyProfile", 0, -1).
+		Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 0, []dtos.Device{}), nil)
+
+	tests := []struct {
+		name         string
+		selector     commandDTOs.DeviceSelector
+		expectedKind errors.ErrKind
+	}{
+		{"too many devices", commandDTOs.DeviceSelector{ProfileName: testProfileName}, errors.KindContractInvalid},
+		{"too many device names", commandDTOs.DeviceSelector{DeviceNames: []string{"device1", "device2"}}, errors.KindContractInvalid},
+		{"no device selected", commandDTOs.DeviceSelector{ProfileName: "emptyProfile"}, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			command := commandDTOs.BatchCommand{CommandName: "temperature", Method: constants.CommandMethodGet, Selector: testCase.selector}
+			_, err := IssueBatchCommand(context.Background(), command, dic)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,8 +8,11 @@ package application
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -33,23 +36,17 @@ func AllCommands(offset int, limit int, dic *di.Container) (deviceCoreCommands [
 		return deviceCoreCommands, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	// retrieve device profile information through Metadata DeviceProfileClient
-	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
-	if dpc == nil {
-		return deviceCoreCommands, totalCount, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
-	}
-
 	// Prepare the url for command
 	configuration := commandContainer.ConfigurationFrom(dic.Get)
 	serviceUrl := configuration.Service.Url()
 
 	deviceCoreCommands = make([]dtos.DeviceCoreCommand, len(multiDevicesResponse.Devices))
 	for i, device := range multiDevicesResponse.Devices {
-		deviceProfileResponse, err := dpc.DeviceProfileByName(context.Background(), device.ProfileName)
+		profile, err := DeviceProfileByName(context.Background(), device.ProfileName, dic)
 		if err != nil {
 			return deviceCoreCommands, totalCount, errors.NewCommonEdgeXWrapper(err)
 		}
-		commands, err := buildCoreCommands(device.Name, serviceUrl, deviceProfileResponse.Profile)
+		commands, err := buildCoreCommands(device.Name, serviceUrl, profile)
 		if err != nil {
 			return nil, totalCount, errors.NewCommonEdgeXWrapper(err)
 		}
@@ -68,22 +65,11 @@ func CommandsByDeviceName(name string, dic *di.Container) (deviceCoreCommand dto
 		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), name)
+	device, err := DeviceByName(context.Background(), name, dic)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
-
-	// retrieve device profile information through Metadata DeviceProfileClient
-	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
-	if dpc == nil {
-		return deviceCoreCommand, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
-	}
-	deviceProfileResponse, err := dpc.DeviceProfileByName(context.Background(), deviceResponse.Device.ProfileName)
+	profile, err := DeviceProfileByName(context.Background(), device.ProfileName, dic)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -92,14 +78,14 @@ func CommandsByDeviceName(name string, dic *di.Container) (deviceCoreCommand dto
 	configuration := commandContainer.ConfigurationFrom(dic.Get)
 	serviceUrl := configuration.Service.Url()
 
-	commands, err := buildCoreCommands(deviceResponse.Device.Name, serviceUrl, deviceProfileResponse.Profile)
+	commands, err := buildCoreCommands(device.Name, serviceUrl, profile)
 	if err != nil {
 		return deviceCoreCommand, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	deviceCoreCommand = dtos.DeviceCoreCommand{
-		DeviceName:   deviceResponse.Device.Name,
-		ProfileName:  deviceResponse.Device.ProfileName,
+		DeviceName:   device.Name,
+		ProfileName:  device.ProfileName,
 		CoreCommands: commands,
 	}
 	return deviceCoreCommand, nil
@@ -183,8 +169,8 @@ func buildCoreCommands(deviceName string, serviceUrl string, profile dtos.Device
 }
 
 // IssueGetCommandByName issues the specified get(read) command referenced by the command name to the device/sensor, also
-// referenced by name.
-func IssueGetCommandByName(deviceName string, commandName string, queryParams string, dic *di.Container) (res *responses.EventResponse, err errors.EdgeX) {
+// referenced by name. The command is recorded in the command history with the caller carried by the ctx.
+func IssueGetCommandByName(ctx context.Context, deviceName string, commandName string, queryParams string, dic *di.Container) (res *responses.EventResponse, err errors.EdgeX) {
 	if deviceName == "" {
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
 	}
@@ -193,22 +179,22 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	start := time.Now()
+	defer func() {
+		statusCode, message := http.StatusOK, ""
+		if err != nil {
+			statusCode, message = CommandErrorCode(err), err.Error()
+		} else if res != nil {
+			statusCode, message = res.StatusCode, res.Message
+		}
+		RecordCommand(ctx, newCommandRecord(deviceName, commandName, constants.CommandMethodGet, queryParams, nil, start, statusCode, message), dic)
+	}()
+
+	device, err := DeviceByName(context.Background(), deviceName, dic)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
-	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := DeviceServiceByName(context.Background(), device.ServiceName, dic)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -218,7 +204,12 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	res, err = dscc.GetCommand(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams)
+	release, err := AcquireCommand(ctx, device, commandName, false, dic)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	defer release()
+	res, err = dscc.GetCommand(context.Background(), deviceService.BaseAddress, deviceName, commandName, queryParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -227,8 +218,8 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 }
 
 // IssueSetCommandByName issues the specified set(write) command referenced by the command name to the device/sensor, also
-// referenced by name.
-func IssueSetCommandByName(deviceName string, commandName string, queryParams string, settings map[string]interface{}, dic *di.Container) (response commonDTO.BaseResponse, err errors.EdgeX) {
+// referenced by name. The command is recorded in the command history with the caller carried by the ctx.
+func IssueSetCommandByName(ctx context.Context, deviceName string, commandName string, queryParams string, settings map[string]interface{}, dic *di.Container) (response commonDTO.BaseResponse, err errors.EdgeX) {
 	if deviceName == "" {
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
 	}
@@ -237,22 +228,25 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	start := time.Now()
+	defer func() {
+		statusCode, message := response.StatusCode, response.Message
+		if err != nil {
+			statusCode, message = CommandErrorCode(err), err.Error()
+		}
+		RecordCommand(ctx, newCommandRecord(deviceName, commandName, constants.CommandMethodSet, queryParams, settings, start, statusCode, message), dic)
+	}()
+
+	device, err := DeviceByName(context.Background(), deviceName, dic)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
-
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
+	// reject the invalid settings before anything is sent to the device service
+	err = ValidateSetCommand(context.Background(), device, commandName, settings, dic)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
 	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := DeviceServiceByName(context.Background(), device.ServiceName, dic)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -262,5 +256,10 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	return dscc.SetCommandWithObject(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams, settings)
+	release, err := AcquireCommand(ctx, device, commandName, true, dic)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	defer release()
+	return dscc.SetCommandWithObject(context.Background(), deviceService.BaseAddress, deviceName, commandName, queryParams, settings)
 }
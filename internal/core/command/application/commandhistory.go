@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+type commandCallerKey struct{}
+
+// commandCaller identifies who issued the command and where the command is received from
+type commandCaller struct {
+	identity string
+	source   string
+}
+
+// WithCommandCaller returns the context carrying the caller identity and the source of the commands issued with it, so
+// that they are recorded in the command history
+func WithCommandCaller(ctx context.Context, identity string, source string) context.Context {
+	return context.WithValue(ctx, commandCallerKey{}, commandCaller{identity: identity, source: source})
+}
+
+// RecordCommand stores the audit record of the command issued to the device if the command history is enabled. The
+// caller, source and correlation id are taken from the context if they are not set in the record, and the outcome is
+// determined by the status code.
+func RecordCommand(ctx context.Context, record models.CommandRecord, dic *di.Container) {
+	if !commandContainer.ConfigurationFrom(dic.Get).CommandHistory.Enabled {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if caller, ok := ctx.Value(commandCallerKey{}).(commandCaller); ok {
+		if record.Caller == "" {
+			record.Caller = caller.identity
+		}
+		if record.Source == "" {
+			record.Source = caller.source
+		}
+	}
+	if record.CorrelationId == "" {
+		record.CorrelationId = correlation.FromContext(ctx)
+	}
+	record.Outcome = constants.CommandOutcomeSucceeded
+	if record.StatusCode >= http.StatusMultipleChoices {
+		record.Outcome = constants.CommandOutcomeFailed
+	}
+
+	if _, err := commandContainer.DBClientFrom(dic.Get).AddCommandRecord(context.WithoutCancel(ctx), record); err != nil {
+		lc.Errorf("failed to record the %s command '%s' of device '%s': %v", record.Method, record.CommandName, record.DeviceName, err)
+	}
+}
+
+// newCommandRecord returns the record of the command issued at the start time and completed now
+func newCommandRecord(deviceName, commandName, method, queryParams string, settings map[string]any, start time.Time, statusCode int, message string) models.CommandRecord {
+	return models.CommandRecord{
+		DeviceName:  deviceName,
+		CommandName: commandName,
+		Method:      method,
+		QueryParams: queryParams,
+		Settings:    settings,
+		StatusCode:  statusCode,
+		Message:     message,
+		Latency:     time.Since(start).Milliseconds(),
+		Created:     start.UnixMilli(),
+	}
+}
+
+// CommandHistory returns the command records matching the query in descending order of creation along with the total
+// count of the matching records
+func CommandHistory(ctx context.Context, query models.CommandRecordQuery, dic *di.Container) (records []commandDTOs.CommandRecord, totalCount uint32, err errors.EdgeX) {
+	if query.Outcome != "" && query.Outcome != constants.CommandOutcomeSucceeded && query.Outcome != constants.CommandOutcomeFailed {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown command outcome '%s', only '%s' or '%s' is allowed", query.Outcome, constants.CommandOutcomeSucceeded, constants.CommandOutcomeFailed), nil)
+	}
+	if query.End < query.Start {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end %d should not be earlier than start %d", query.End, query.Start), nil)
+	}
+
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.CommandRecordCount(ctx, query)
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	recordModels, err := dbClient.CommandRecords(ctx, query)
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	records = make([]commandDTOs.CommandRecord, len(recordModels))
+	for i, record := range recordModels {
+		records[i] = commandDTOs.FromCommandRecordModelToDTO(record)
+	}
+	return records, totalCount, nil
+}
+
+// AsyncPurgeCommandHistory deletes the command records older than the CommandHistory.Retention periodically if the command
+// history is enabled
+func AsyncPurgeCommandHistory(ctx context.Context, dic *di.Container) errors.EdgeX {
+	historyInfo := commandContainer.ConfigurationFrom(dic.Get).CommandHistory
+	if !historyInfo.Enabled {
+		return nil
+	}
+	return asyncPurge(ctx, "command records", historyInfo.Retention, commandContainer.DBClientFrom(dic.Get).DeleteCommandRecordsByAge, dic)
+}
+
+// asyncPurge calls the deleteByAge periodically to delete the entities older than the retention
+func asyncPurge(ctx context.Context, entities string, retentionValue string, deleteByAge func(context.Context, int64) errors.EdgeX, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	retention, err := time.ParseDuration(retentionValue)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s Retention parse failed", entities), err)
+	}
+	if retention <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s Retention '%s' should be greater than zero", entities, retention), nil)
+	}
+
+	go func() {
+		ticker := time.NewTicker(min(retention, time.Hour))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting %s purging", entities)
+				return
+			case <-ticker.C:
+				if err := deleteByAge(ctx, retention.Milliseconds()); err != nil {
+					lc.Errorf("failed to purge the %s older than %s: %v", entities, retention, err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+func TestIssueCommand_RecordCommand(t *testing.T) {
+	dic, dcMock, dsccMock := newBatchCommandDIC(10)
+	dbClient := addCommandJobDependencies(dic)
+	commandContainer.ConfigurationFrom(dic.Get).CommandHistory.Enabled = true
+	mockDevice(dcMock, "device1")
+	dsccMock.On("GetCommand", context.Background(), testBaseAddress, "device1", "temperature", "ds-pushevent=true").
+		Return(&responses.EventResponse{BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK), Event: dtos.Event{DeviceName: "device1"}}, nil)
+	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, "device1", "setpoint", "", map[string]any{"setpoint": "21"}).
+		Return(commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device unreachable", nil))
+
+	ctx := WithCommandCaller(context.Background(), "admin", constants.CommandSourceHTTP)
+	_, err := IssueGetCommandByName(ctx, "device1", "temperature", "ds-pushevent=true", dic)
+	require.NoError(t, err)
+	_, err = IssueSetCommandByName(ctx, "device1", "setpoint", "", map[string]any{"setpoint": "21"}, dic)
+	require.Error(t, err)
+
+	query := models.CommandRecordQuery{DeviceName: "device1", End: math.MaxInt64, Limit: -1}
+	records, err := dbClient.CommandRecords(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		assert.Equal(t, "admin", record.Caller)
+		assert.Equal(t, constants.CommandSourceHTTP, record.Source)
+		switch record.Method {
+		case constants.CommandMethodGet:
+			assert.Equal(t, constants.CommandOutcomeSucceeded, record.Outcome)
+			assert.Equal(t, "ds-pushevent=true", record.QueryParams)
+		case constants.CommandMethodSet:
+			assert.Equal(t, constants.CommandOutcomeFailed, record.Outcome)
+			assert.Equal(t, http.StatusServiceUnavailable, record.StatusCode)
+			assert.Equal(t, map[string]any{"setpoint": "21"}, record.Settings)
+		}
+	}
+
+	query.Outcome = constants.CommandOutcomeFailed
+	failed, totalCount, err := CommandHistory(context.Background(), query, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), totalCount)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "setpoint", failed[0].CommandName)
+}
+
+func TestCommandHistory_Invalid(t *testing.T) {
+	dic, _, _ := newBatchCommandDIC(10)
+	addCommandJobDependencies(dic)
+
+	tests := []struct {
+		name  string
+		query models.CommandRecordQuery
+	}{
+		{"Invalid - unknown outcome", models.CommandRecordQuery{Outcome: "UNKNOWN", End: math.MaxInt64}},
+		{"Invalid - end earlier than start", models.CommandRecordQuery{Start: 2, End: 1}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, _, err := CommandHistory(context.Background(), testCase.query, dic)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
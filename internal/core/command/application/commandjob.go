@@ -0,0 +1,264 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	dtoResponses "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// SubmitCommandJob stores the get or set command as a pending job and issues it to the device in the background, the job
+// is returned immediately. The outcome of the command is stored in the job and delivered to the callbacks of the job.
+func SubmitCommandJob(ctx context.Context, job models.CommandJob, dic *di.Container) (models.CommandJob, errors.EdgeX) {
+	if job.DeviceName == "" {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
+	}
+	if job.CommandName == "" {
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
+	}
+	switch job.Method {
+	case constants.CommandMethodGet:
+	case constants.CommandMethodSet:
+		if len(job.Settings) == 0 {
+			return job, errors.NewCommonEdgeX(errors.KindContractInvalid, "the settings of the set command should not be empty", nil)
+		}
+	default:
+		return job, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown command method '%s', only '%s' or '%s' is allowed", job.Method, constants.CommandMethodGet, constants.CommandMethodSet), nil)
+	}
+	if job.CallbackUrl != "" {
+		u, err := url.Parse(job.CallbackUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return job, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid callback url '%s', an absolute http or https url is expected", job.CallbackUrl), err)
+		}
+	}
+	// fail fast if the device doesn't exist instead of creating a job which is doomed to fail
+	device, err := DeviceByName(ctx, job.DeviceName, dic)
+	if err != nil {
+		return job, errors.NewCommonEdgeXWrapper(err)
+	}
+	if job.Method == constants.CommandMethodSet {
+		if err = ValidateSetCommand(ctx, device, job.CommandName, job.Settings, dic); err != nil {
+			return job, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	job.Id = uuid.NewString()
+	job.Status = constants.CommandJobStatusPending
+	job, err = commandContainer.DBClientFrom(dic.Get).AddCommandJob(ctx, job)
+	if err != nil {
+		return job, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the job outlives the request, but keeps the caller of the request for the command history
+	go runCommandJob(context.WithoutCancel(ctx), job, dic)
+	return job, nil
+}
+
+// runCommandJob issues the command of the job to the device, stores the outcome and delivers the completed job to the
+// callbacks
+func runCommandJob(ctx context.Context, job models.CommandJob, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+
+	job.Status = constants.CommandJobStatusRunning
+	if err := dbClient.UpdateCommandJob(ctx, job); err != nil {
+		lc.Errorf("failed to update the status of command job '%s' to %s: %v", job.Id, job.Status, err)
+	}
+
+	var response any
+	var err errors.EdgeX
+	job.StatusCode = http.StatusOK
+	if job.Method == constants.CommandMethodGet {
+		var eventResponse *dtoResponses.EventResponse
+		eventResponse, err = IssueGetCommandByName(ctx, job.DeviceName, job.CommandName, job.QueryParams, dic)
+		// no event is returned if ds-returnevent is false
+		if eventResponse != nil {
+			response = eventResponse
+			job.StatusCode, job.Message = eventResponse.StatusCode, eventResponse.Message
+		}
+	} else {
+		var baseResponse commonDTO.BaseResponse
+		baseResponse, err = IssueSetCommandByName(ctx, job.DeviceName, job.CommandName, job.QueryParams, job.Settings, dic)
+		response = baseResponse
+		job.StatusCode, job.Message = baseResponse.StatusCode, baseResponse.Message
+	}
+	if err != nil {
+		response = nil
+		job.StatusCode, job.Message = CommandErrorCode(err), err.Error()
+	}
+	if response != nil {
+		data, marshalErr := json.Marshal(response)
+		if marshalErr != nil {
+			lc.Errorf("failed to encode the response of command job '%s': %v", job.Id, marshalErr)
+		} else {
+			job.Response = data
+		}
+	}
+
+	completeCommandJob(ctx, job, dic)
+}
+
+// completeCommandJob marks the job as succeeded or failed by its status code, stores it and delivers it to the callbacks
+func completeCommandJob(ctx context.Context, job models.CommandJob, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	job.Status = constants.CommandJobStatusSucceeded
+	if job.StatusCode >= http.StatusMultipleChoices {
+		job.Status = constants.CommandJobStatusFailed
+	}
+	if err := commandContainer.DBClientFrom(dic.Get).UpdateCommandJob(ctx, job); err != nil {
+		lc.Errorf("failed to update the status of command job '%s' to %s: %v", job.Id, job.Status, err)
+	}
+	lc.Debugf("Command job '%s' of device '%s' command '%s' completed with status %s", job.Id, job.DeviceName, job.CommandName, job.Status)
+
+	deliverCommandJob(ctx, job, dic)
+}
+
+// deliverCommandJob sends the completed job to the callback url and publishes it to the callback topic of the job
+func deliverCommandJob(ctx context.Context, job models.CommandJob, dic *di.Container) {
+	if job.CallbackUrl == "" && job.CallbackTopic == "" {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := commandContainer.ConfigurationFrom(dic.Get)
+	response := responses.NewCommandJobResponse("", "", http.StatusOK, commandDTOs.FromCommandJobModelToDTO(job))
+	// correlate the callbacks with the job
+	ctx = context.WithValue(ctx, common.CorrelationHeader, job.Id) //nolint: staticcheck
+
+	if job.CallbackUrl != "" {
+		if timeout, err := time.ParseDuration(configuration.Service.RequestTimeout); err == nil {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		if err := postCommandJob(ctx, job.CallbackUrl, job.Id, response); err != nil {
+			lc.Errorf("failed to send command job '%s' to callback url '%s': %v", job.Id, job.CallbackUrl, err)
+		}
+	}
+
+	if job.CallbackTopic != "" {
+		messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+		if messagingClient == nil {
+			lc.Errorf("failed to publish command job '%s' to callback topic '%s': messaging client is not available", job.Id, job.CallbackTopic)
+			return
+		}
+		ctx = context.WithValue(ctx, common.ContentType, common.ContentTypeJSON) //nolint: staticcheck
+		if err := messagingClient.Publish(types.NewMessageEnvelope(response, ctx), job.CallbackTopic); err != nil {
+			lc.Errorf("failed to publish command job '%s' to callback topic '%s': %v", job.Id, job.CallbackTopic, err)
+		}
+	}
+}
+
+// postCommandJob sends the job response to the callback url in JSON
+func postCommandJob(ctx context.Context, callbackUrl string, jobId string, response responses.CommandJobResponse) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackUrl, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(common.ContentType, common.ContentTypeJSON)
+	req.Header.Set(common.CorrelationHeader, jobId)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("callback responded with status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// CommandJobById returns the command job by id
+func CommandJobById(ctx context.Context, id string, dic *di.Container) (commandDTOs.CommandJob, errors.EdgeX) {
+	if id == "" {
+		return commandDTOs.CommandJob{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	job, err := commandContainer.DBClientFrom(dic.Get).CommandJobById(ctx, id)
+	if err != nil {
+		return commandDTOs.CommandJob{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commandDTOs.FromCommandJobModelToDTO(job), nil
+}
+
+// AllCommandJobs returns the command jobs of the status with offset and limit, all the jobs are returned if the status
+// is empty
+func AllCommandJobs(ctx context.Context, status string, offset, limit int, dic *di.Container) (jobs []commandDTOs.CommandJob, totalCount uint32, err errors.EdgeX) {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	var jobModels []models.CommandJob
+	switch {
+	case status == "":
+		totalCount, err = dbClient.CommandJobTotalCount(ctx)
+		if err == nil {
+			jobModels, err = dbClient.AllCommandJobs(ctx, offset, limit)
+		}
+	case slices.Contains([]string{constants.CommandJobStatusPending, constants.CommandJobStatusRunning, constants.CommandJobStatusSucceeded, constants.CommandJobStatusFailed}, status):
+		totalCount, err = dbClient.CommandJobCountByStatus(ctx, status)
+		if err == nil {
+			jobModels, err = dbClient.CommandJobsByStatus(ctx, status, offset, limit)
+		}
+	default:
+		return nil, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown command job status '%s'", status), nil)
+	}
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	jobs = make([]commandDTOs.CommandJob, len(jobModels))
+	for i, job := range jobModels {
+		jobs[i] = commandDTOs.FromCommandJobModelToDTO(job)
+	}
+	return jobs, totalCount, nil
+}
+
+// RecoverCommandJobs fails the jobs which were pending or running when core-command stopped, since the outcome of their
+// commands is unknown. The commands are not issued again as they might not be idempotent.
+func RecoverCommandJobs(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	for _, status := range []string{constants.CommandJobStatusPending, constants.CommandJobStatusRunning} {
+		jobs, err := dbClient.CommandJobsByStatus(ctx, status, 0, -1)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, job := range jobs {
+			lc.Warnf("Command job '%s' of device '%s' command '%s' was interrupted by the restart of core-command", job.Id, job.DeviceName, job.CommandName)
+			job.StatusCode = http.StatusInternalServerError
+			job.Message = fmt.Sprintf("core-command restarted while the job was %s, the outcome of the command is unknown", status)
+			completeCommandJob(ctx, job, dic)
+		}
+	}
+	return nil
+}
+
+// AsyncPurgeCommandJobs deletes the command jobs older than the CommandJob.Retention periodically
+func AsyncPurgeCommandJobs(ctx context.Context, dic *di.Container) errors.EdgeX {
+	retention := commandContainer.ConfigurationFrom(dic.Get).CommandJob.Retention
+	return asyncPurge(ctx, "command jobs", retention, commandContainer.DBClientFrom(dic.Get).DeleteCommandJobsByAge, dic)
+}
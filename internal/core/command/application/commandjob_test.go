@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandResponses "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/memory"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+func addCommandJobDependencies(dic *di.Container) *memory.Client {
+	dbClient := memory.NewClient()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	return dbClient
+}
+
+func waitForCommandJob(t *testing.T, dbClient *memory.Client, id string, status string) models.CommandJob {
+	var job models.CommandJob
+	require.Eventually(t, func() bool {
+		var err errors.EdgeX
+		job, err = dbClient.CommandJobById(context.Background(), id)
+		return err == nil && job.Status == status
+	}, time.Second, 10*time.Millisecond, "command job is not %s", status)
+	return job
+}
+
+func TestSubmitCommandJob(t *testing.T) {
+	callbacks := make(chan commandResponses.CommandJobResponse, 1)
+	callbackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res commandResponses.CommandJobResponse
+		_ = json.NewDecoder(r.Body).Decode(&res)
+		assert.Equal(t, res.Job.Id, r.Header.Get(common.CorrelationHeader))
+		callbacks <- res
+	}))
+	defer callbackServer.Close()
+
+	dic, dcMock, dsccMock := newBatchCommandDIC(10)
+	dbClient := addCommandJobDependencies(dic)
+	mockDevice(dcMock, "device1")
+	dcMock.On("DeviceByName", context.Background(), "unknown").
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+	event := dtos.Event{DeviceName: "device1", SourceName: "temperature"}
+	dsccMock.On("GetCommand", context.Background(), testBaseAddress, "device1", "temperature", "").
+		Return(&responses.EventResponse{BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK), Event: event}, nil)
+	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, "device1", "setpoint", "", map[string]any{"setpoint": "21"}).
+		Return(commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device unreachable", nil))
+
+	job, err := SubmitCommandJob(context.Background(), models.CommandJob{
+		DeviceName:  "device1",
+		CommandName: "temperature",
+		Method:      constants.CommandMethodGet,
+		CallbackUrl: callbackServer.URL,
+	}, dic)
+	require.NoError(t, err)
+	require.NotEmpty(t, job.Id)
+	assert.Equal(t, constants.CommandJobStatusPending, job.Status)
+
+	completed := waitForCommandJob(t, dbClient, job.Id, constants.CommandJobStatusSucceeded)
+	assert.Equal(t, http.StatusOK, completed.StatusCode)
+	var eventResponse responses.EventResponse
+	require.NoError(t, json.Unmarshal(completed.Response, &eventResponse))
+	assert.Equal(t, event.SourceName, eventResponse.Event.SourceName)
+	select {
+	case res := <-callbacks:
+		assert.Equal(t, job.Id, res.Job.Id)
+		assert.Equal(t, constants.CommandJobStatusSucceeded, res.Job.Status)
+	case <-time.After(time.Second):
+		assert.Fail(t, "command job is not sent to the callback url")
+	}
+
+	job, err = SubmitCommandJob(context.Background(), models.CommandJob{
+		DeviceName:  "device1",
+		CommandName: "setpoint",
+		Method:      constants.CommandMethodSet,
+		Settings:    map[string]any{"setpoint": "21"},
+	}, dic)
+	require.NoError(t, err)
+	completed = waitForCommandJob(t, dbClient, job.Id, constants.CommandJobStatusFailed)
+	assert.Equal(t, http.StatusServiceUnavailable, completed.StatusCode)
+	assert.Contains(t, completed.Message, "device unreachable")
+}
+
+func TestSubmitCommandJob_Invalid(t *testing.T) {
+	dic, dcMock, _ := newBatchCommandDIC(10)
+	addCommandJobDependencies(dic)
+	dcMock.On("DeviceByName", context.Background(), "unknown").
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	tests := []struct {
+		name         string
+		job          models.CommandJob
+		expectedKind errors.ErrKind
+	}{
+		{"Invalid - empty device name", models.CommandJob{CommandName: "temperature", Method: constants.CommandMethodGet}, errors.KindContractInvalid},
+		{"Invalid - unknown method", models.CommandJob{DeviceName: "device1", CommandName: "temperature", Method: "delete"}, errors.KindContractInvalid},
+		{"Invalid - set without settings", models.CommandJob{DeviceName: "device1", CommandName: "setpoint", Method: constants.CommandMethodSet}, errors.KindContractInvalid},
+		{"Invalid - relative callback url", models.CommandJob{DeviceName: "device1", CommandName: "temperature", Method: constants.CommandMethodGet, CallbackUrl: "/callback"}, errors.KindContractInvalid},
+		{"Not found - unknown device", models.CommandJob{DeviceName: "unknown", CommandName: "temperature", Method: constants.CommandMethodGet}, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := SubmitCommandJob(context.Background(), testCase.job, dic)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+		})
+	}
+}
+
+func TestRecoverCommandJobs(t *testing.T) {
+	dic, _, _ := newBatchCommandDIC(10)
+	dbClient := addCommandJobDependencies(dic)
+	running, err := dbClient.AddCommandJob(context.Background(), models.CommandJob{Id: "running", Status: constants.CommandJobStatusRunning})
+	require.NoError(t, err)
+	succeeded, err := dbClient.AddCommandJob(context.Background(), models.CommandJob{Id: "succeeded", Status: constants.CommandJobStatusSucceeded, StatusCode: http.StatusOK})
+	require.NoError(t, err)
+
+	err = RecoverCommandJobs(context.Background(), dic)
+	require.NoError(t, err)
+
+	job, err := dbClient.CommandJobById(context.Background(), running.Id)
+	require.NoError(t, err)
+	assert.Equal(t, constants.CommandJobStatusFailed, job.Status)
+	assert.Equal(t, http.StatusInternalServerError, job.StatusCode)
+	job, err = dbClient.CommandJobById(context.Background(), succeeded.Id)
+	require.NoError(t, err)
+	assert.Equal(t, constants.CommandJobStatusSucceeded, job.Status)
+}
@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/limiter"
+)
+
+// AcquireCommand waits for the turn of the command issued to the device according to the command policies, and returns
+// the function to be called once the command is completed
+func AcquireCommand(ctx context.Context, device dtos.Device, commandName string, isSet bool, dic *di.Container) (release func(), err errors.EdgeX) {
+	commandLimiter := commandContainer.CommandLimiterFrom(dic.Get)
+	if commandLimiter == nil {
+		return func() {}, nil
+	}
+	return commandLimiter.Acquire(ctx, device.Name, device.ProfileName, commandName, isSet)
+}
+
+// CommandErrorCode returns the status code of the command error, which is 429 if the command is rejected by the command
+// policies since the error kinds have no such status code
+func CommandErrorCode(err error) int {
+	if stdErrors.Is(err, limiter.ErrTooManyCommands) {
+		return http.StatusTooManyRequests
+	}
+	var edgexErr errors.EdgeX
+	if stdErrors.As(err, &edgexErr) {
+		return edgexErr.Code()
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,141 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// DeviceByName returns the device from the metadata cache, or queries core-metadata and caches the device on a miss
+func DeviceByName(ctx context.Context, name string, dic *di.Container) (dtos.Device, errors.EdgeX) {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	var generation uint64
+	if metadataCache != nil {
+		if device, ok := metadataCache.Device(name); ok {
+			return device, nil
+		}
+		generation = metadataCache.DeviceGeneration()
+	}
+
+	// retrieve device information through Metadata DeviceClient
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return dtos.Device{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	deviceResponse, err := dc.DeviceByName(ctx, name)
+	if err != nil {
+		return dtos.Device{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if metadataCache != nil {
+		// the device might be stale if the devices are changed by a system event during the query, it is not cached then
+		metadataCache.FillDevice(deviceResponse.Device, generation)
+	}
+	return deviceResponse.Device, nil
+}
+
+// DeviceProfileByName returns the device profile from the metadata cache, or queries core-metadata and caches the device
+// profile on a miss
+func DeviceProfileByName(ctx context.Context, name string, dic *di.Container) (dtos.DeviceProfile, errors.EdgeX) {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	var generation uint64
+	if metadataCache != nil {
+		if profile, ok := metadataCache.DeviceProfile(name); ok {
+			return profile, nil
+		}
+		generation = metadataCache.DeviceProfileGeneration()
+	}
+
+	// retrieve device profile information through Metadata DeviceProfileClient
+	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
+	}
+	deviceProfileResponse, err := dpc.DeviceProfileByName(ctx, name)
+	if err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if metadataCache != nil {
+		// the device profile might be stale if the device profiles are changed by a system event during the query, it is not cached then
+		metadataCache.FillDeviceProfile(deviceProfileResponse.Profile, generation)
+	}
+	return deviceProfileResponse.Profile, nil
+}
+
+// DeviceServiceByName returns the device service from the metadata cache, or queries core-metadata and caches the device
+// service on a miss
+func DeviceServiceByName(ctx context.Context, name string, dic *di.Container) (dtos.DeviceService, errors.EdgeX) {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	var generation uint64
+	if metadataCache != nil {
+		if service, ok := metadataCache.DeviceService(name); ok {
+			return service, nil
+		}
+		generation = metadataCache.DeviceServiceGeneration()
+	}
+
+	// retrieve device service information through Metadata DeviceServiceClient
+	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
+	if dsc == nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
+	}
+	deviceServiceResponse, err := dsc.DeviceServiceByName(ctx, name)
+	if err != nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if metadataCache != nil {
+		// the device service might be stale if the device services are changed by a system event during the query, it is not cached then
+		metadataCache.FillDeviceService(deviceServiceResponse.Service, generation)
+	}
+	return deviceServiceResponse.Service, nil
+}
+
+// WarmMetadataCache loads all the devices, device profiles and device services from core-metadata into the metadata cache
+func WarmMetadataCache(ctx context.Context, dic *di.Container) errors.EdgeX {
+	metadataCache := commandContainer.MetadataCacheFrom(dic.Get)
+	if metadataCache == nil {
+		return nil
+	}
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
+	if dc == nil || dpc == nil || dsc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil core-metadata client returned", nil)
+	}
+
+	// the entries queried before a system event changes the same kind of entries might be stale, they are not cached then
+	serviceGeneration := metadataCache.DeviceServiceGeneration()
+	services, err := dsc.AllDeviceServices(ctx, nil, 0, -1)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, service := range services.Services {
+		metadataCache.FillDeviceService(service, serviceGeneration)
+	}
+	profileGeneration := metadataCache.DeviceProfileGeneration()
+	profiles, err := dpc.AllDeviceProfiles(ctx, nil, 0, -1)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, profile := range profiles.Profiles {
+		metadataCache.FillDeviceProfile(profile, profileGeneration)
+	}
+	deviceGeneration := metadataCache.DeviceGeneration()
+	devices, err := dc.AllDevices(ctx, nil, 0, -1)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, device := range devices.Devices {
+		metadataCache.FillDevice(device, deviceGeneration)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// numericValueTypeBitSizes maps the integer and float value types to their bit sizes
+var numericValueTypeBitSizes = map[string]int{
+	common.ValueTypeUint8:   8,
+	common.ValueTypeUint16:  16,
+	common.ValueTypeUint32:  32,
+	common.ValueTypeUint64:  64,
+	common.ValueTypeInt8:    8,
+	common.ValueTypeInt16:   16,
+	common.ValueTypeInt32:   32,
+	common.ValueTypeInt64:   64,
+	common.ValueTypeFloat32: 32,
+	common.ValueTypeFloat64: 64,
+}
+
+// InvalidSettingsError holds the settings of the set command rejected by the device profile
+type InvalidSettingsError struct {
+	Errors []commandDTOs.SettingError
+}
+
+func (e InvalidSettingsError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, settingError := range e.Errors {
+		messages[i] = fmt.Sprintf("%s: %s", settingError.ResourceName, settingError.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// SettingErrorsFrom returns the per-setting errors if the error is caused by the invalid settings of the set command
+func SettingErrorsFrom(err error) []commandDTOs.SettingError {
+	var invalidSettings InvalidSettingsError
+	if stdErrors.As(err, &invalidSettings) {
+		return invalidSettings.Errors
+	}
+	return nil
+}
+
+// ValidateSetCommand validates the settings of the set command against the device resources written by the command, so
+// that the invalid settings are rejected before they are sent to the device service. The per-setting errors can be
+// retrieved from the returned error by SettingErrorsFrom.
+func ValidateSetCommand(ctx context.Context, device dtos.Device, commandName string, settings map[string]any, dic *di.Container) errors.EdgeX {
+	profile, err := DeviceProfileByName(ctx, device.ProfileName, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return validateSettings(profile, commandName, settings)
+}
+
+// validateSettings validates each setting against the properties of its device resource, the settings of the resources
+// having a default value can be omitted
+func validateSettings(profile dtos.DeviceProfile, commandName string, settings map[string]any) errors.EdgeX {
+	resourceOperations, err := setCommandResourceOperations(profile, commandName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var settingErrors []commandDTOs.SettingError
+	written := make(map[string]bool, len(resourceOperations))
+	for _, ro := range resourceOperations {
+		written[ro.DeviceResource] = true
+		resource, exists := deviceResourcesByName(profile.DeviceResources, ro.DeviceResource)
+		if !exists {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device command's resource %s doesn't match any deivce resource", ro.DeviceResource), nil)
+		}
+		value, ok := settings[ro.DeviceResource]
+		if !ok {
+			if ro.DefaultValue == "" && resource.Properties.DefaultValue == "" {
+				settingErrors = append(settingErrors, commandDTOs.SettingError{ResourceName: ro.DeviceResource, Message: "the setting is required since the resource has no default value"})
+			}
+			continue
+		}
+		if message := validateSettingValue(resource, ro.Mappings, value); message != "" {
+			settingErrors = append(settingErrors, commandDTOs.SettingError{ResourceName: ro.DeviceResource, Value: value, Message: message})
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		if !written[name] {
+			settingErrors = append(settingErrors, commandDTOs.SettingError{ResourceName: name, Value: settings[name], Message: fmt.Sprintf("the resource is not written by command %s", commandName)})
+		}
+	}
+
+	if len(settingErrors) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid settings of command %s of device profile %s", commandName, profile.Name), InvalidSettingsError{Errors: settingErrors})
+	}
+	return nil
+}
+
+// setCommandResourceOperations returns the resource operations written by the command, which is either a device command
+// or a device resource of the profile
+func setCommandResourceOperations(profile dtos.DeviceProfile, commandName string) ([]dtos.ResourceOperation, errors.EdgeX) {
+	for _, dc := range profile.DeviceCommands {
+		if dc.Name != commandName {
+			continue
+		}
+		if !strings.Contains(dc.ReadWrite, common.ReadWrite_W) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command %s of device profile %s is not writable", commandName, profile.Name), nil)
+		}
+		return dc.ResourceOperations, nil
+	}
+	if resource, exists := deviceResourcesByName(profile.DeviceResources, commandName); exists {
+		if !strings.Contains(resource.Properties.ReadWrite, common.ReadWrite_W) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command %s of device profile %s is not writable", commandName, profile.Name), nil)
+		}
+		return []dtos.ResourceOperation{{DeviceResource: resource.Name}}, nil
+	}
+	return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command %s doesn't exist in device profile %s", commandName, profile.Name), nil)
+}
+
+// validateSettingValue checks the setting value against the read/write mode, the mappings, the value type and the range
+// of the device resource, an empty message is returned if the value is valid. The mappings of the resource operation
+// translate the allowed setting values to the values written to the device.
+func validateSettingValue(resource dtos.DeviceResource, mappings map[string]string, value any) string {
+	properties := resource.Properties
+	if !strings.Contains(properties.ReadWrite, common.ReadWrite_W) {
+		return "the resource is read-only"
+	}
+	switch properties.ValueType {
+	case common.ValueTypeObject:
+		// the objects are passed to the device service as is
+		return ""
+	case common.ValueTypeObjectArray:
+		if _, ok := value.([]any); !ok {
+			return "the value should be an array of objects"
+		}
+		return ""
+	}
+
+	text, err := settingValueToString(value)
+	if err != nil {
+		return fmt.Sprintf("the value cannot be encoded: %v", err)
+	}
+	if len(mappings) > 0 {
+		mapped, ok := mappings[text]
+		if !ok {
+			return fmt.Sprintf("the value '%s' is not one of the allowed values %v", text, slices.Sorted(maps.Keys(mappings)))
+		}
+		text = mapped
+	}
+
+	elementType, isArray := strings.CutSuffix(properties.ValueType, "Array")
+	if !isArray {
+		return validateScalarValue(properties, properties.ValueType, text)
+	}
+	var elements []any
+	if err := json.Unmarshal([]byte(text), &elements); err != nil {
+		return fmt.Sprintf("the value should be a JSON array of %s", elementType)
+	}
+	for i, element := range elements {
+		elementText, err := settingValueToString(element)
+		if err == nil {
+			if message := validateScalarValue(properties, elementType, elementText); message != "" {
+				return fmt.Sprintf("element %d: %s", i, message)
+			}
+		}
+	}
+	return ""
+}
+
+// validateScalarValue parses the text as the value type and checks it against the minimum and maximum of the resource,
+// the value types which can't be validated are accepted
+func validateScalarValue(properties dtos.ResourceProperties, valueType string, text string) string {
+	var number float64
+	switch valueType {
+	case common.ValueTypeBool:
+		if _, err := strconv.ParseBool(text); err != nil {
+			return fmt.Sprintf("the value '%s' is not a valid %s", text, valueType)
+		}
+		return ""
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64:
+		v, err := strconv.ParseUint(text, 10, numericValueTypeBitSizes[valueType])
+		if err != nil {
+			return fmt.Sprintf("the value '%s' is not a valid %s", text, valueType)
+		}
+		number = float64(v)
+	case common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64:
+		v, err := strconv.ParseInt(text, 10, numericValueTypeBitSizes[valueType])
+		if err != nil {
+			return fmt.Sprintf("the value '%s' is not a valid %s", text, valueType)
+		}
+		number = float64(v)
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		v, err := strconv.ParseFloat(text, numericValueTypeBitSizes[valueType])
+		if err != nil || math.IsNaN(v) {
+			return fmt.Sprintf("the value '%s' is not a valid %s", text, valueType)
+		}
+		number = v
+	default:
+		return ""
+	}
+
+	if properties.Minimum != nil && number < *properties.Minimum {
+		return fmt.Sprintf("the value %s is less than the minimum %v", text, *properties.Minimum)
+	}
+	if properties.Maximum != nil && number > *properties.Maximum {
+		return fmt.Sprintf("the value %s is greater than the maximum %v", text, *properties.Maximum)
+	}
+	return ""
+}
+
+// settingValueToString converts the setting decoded from JSON to the string sent to the device service
+func settingValueToString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case nil:
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSettings(t *testing.T) {
+	minimum, maximum := float64(0), float64(100)
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "speed", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8, ReadWrite: common.ReadWrite_RW, Minimum: &minimum, Maximum: &maximum}},
+			{Name: "mode", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW}},
+			{Name: "enabled", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_W, DefaultValue: "true"}},
+			{Name: "weights", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32Array, ReadWrite: common.ReadWrite_RW, Maximum: &maximum}},
+			{Name: "serial", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_R}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{
+				Name:      "configure",
+				ReadWrite: common.ReadWrite_W,
+				ResourceOperations: []dtos.ResourceOperation{
+					{DeviceResource: "speed"},
+					{DeviceResource: "mode", Mappings: map[string]string{"eco": "1", "boost": "2"}},
+					{DeviceResource: "enabled"},
+				},
+			},
+			{
+				Name:               "status",
+				ReadWrite:          common.ReadWrite_R,
+				ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "serial"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		commandName           string
+		settings              map[string]any
+		expectedSettingErrors []string
+		expectedKind          errors.ErrKind
+	}{
+		{"Valid - device command", "configure", map[string]any{"speed": float64(50), "mode": "eco"}, nil, ""},
+		{"Valid - device resource", "weights", map[string]any{"weights": "[1.5, 99]"}, nil, ""},
+		{"Invalid - out of range", "configure", map[string]any{"speed": "101", "mode": "eco"}, []string{"speed"}, errors.KindContractInvalid},
+		{"Invalid - wrong value type", "configure", map[string]any{"speed": "fast", "mode": "eco", "enabled": "yes"}, []string{"speed", "enabled"}, errors.KindContractInvalid},
+		{"Invalid - value not in mappings", "configure", map[string]any{"speed": "1", "mode": "turbo"}, []string{"mode"}, errors.KindContractInvalid},
+		{"Invalid - missing setting without default value", "configure", map[string]any{"speed": "1"}, []string{"mode"}, errors.KindContractInvalid},
+		{"Invalid - unknown resource", "configure", map[string]any{"speed": "1", "mode": "eco", "unknown": "1"}, []string{"unknown"}, errors.KindContractInvalid},
+		{"Invalid - array element out of range", "weights", map[string]any{"weights": []any{float64(1), float64(101)}}, []string{"weights"}, errors.KindContractInvalid},
+		{"Invalid - read-only command", "status", map[string]any{"serial": "abc"}, nil, errors.KindContractInvalid},
+		{"Invalid - read-only resource", "serial", map[string]any{"serial": "abc"}, nil, errors.KindContractInvalid},
+		{"Invalid - unknown command", "unknown", map[string]any{"speed": "1"}, nil, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateSettings(profile, testCase.commandName, testCase.settings)
+			if testCase.expectedKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+			settingErrors := SettingErrorsFrom(err)
+			require.Len(t, settingErrors, len(testCase.expectedSettingErrors))
+			for i, resourceName := range testCase.expectedSettingErrors {
+				assert.Equal(t, resourceName, settingErrors[i].ResourceName)
+				assert.NotEmpty(t, settingErrors[i].Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sync"
+
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	metadataCacheHitsMetricName     = "MetadataCacheHits"
+	metadataCacheMissesMetricName   = "MetadataCacheMisses"
+	metadataCacheHitRatioMetricName = "MetadataCacheHitRatio"
+)
+
+// MetadataCache holds the devices, device profiles and device services queried from core-metadata by name. The cached
+// entries are kept fresh by the system events published by core-metadata, and the callers query core-metadata on a miss.
+//
+// Each kind of entry has a generation increased by every change applied from the system events. The entry queried from
+// core-metadata is only filled if the generation taken before the query is still current, so that an entry updated or
+// deleted by a system event during the query is not overwritten with the stale one.
+type MetadataCache struct {
+	mutex             sync.RWMutex
+	devices           map[string]dtos.Device
+	profiles          map[string]dtos.DeviceProfile
+	services          map[string]dtos.DeviceService
+	deviceGeneration  uint64
+	profileGeneration uint64
+	serviceGeneration uint64
+	hits              gometrics.Counter
+	misses            gometrics.Counter
+	hitRatio          gometrics.GaugeFloat64
+}
+
+// NewMetadataCache creates an empty MetadataCache
+func NewMetadataCache() *MetadataCache {
+	return &MetadataCache{
+		devices:  make(map[string]dtos.Device),
+		profiles: make(map[string]dtos.DeviceProfile),
+		services: make(map[string]dtos.DeviceService),
+		hits:     gometrics.NewCounter(),
+		misses:   gometrics.NewCounter(),
+		hitRatio: gometrics.NewGaugeFloat64(),
+	}
+}
+
+// RegisterMetrics registers the hit and miss counters and the hit ratio of the cache lookups to the MetricsManager
+func (c *MetadataCache) RegisterMetrics(metricsManager bootstrapInterfaces.MetricsManager, lc logger.LoggingClient) {
+	metrics := map[string]any{
+		metadataCacheHitsMetricName:     c.hits,
+		metadataCacheMissesMetricName:   c.misses,
+		metadataCacheHitRatioMetricName: c.hitRatio,
+	}
+	for name, metric := range metrics {
+		if err := metricsManager.Register(name, metric, nil); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		lc.Infof("Registered metrics %s", name)
+	}
+}
+
+// lookup returns the named entry of the items and records the hit or miss, the caller must hold the read lock
+func lookup[T any](c *MetadataCache, items map[string]T, name string) (T, bool) {
+	item, ok := items[name]
+	if ok {
+		c.hits.Inc(1)
+	} else {
+		c.misses.Inc(1)
+	}
+	hits := c.hits.Count()
+	c.hitRatio.Update(float64(hits) / float64(hits+c.misses.Count()))
+	return item, ok
+}
+
+// Device returns the cached device by name
+func (c *MetadataCache) Device(name string) (dtos.Device, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return lookup(c, c.devices, name)
+}
+
+// DeviceGeneration returns the current generation of the cached devices, which is taken before querying a device from
+// core-metadata to fill it by FillDevice
+func (c *MetadataCache) DeviceGeneration() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.deviceGeneration
+}
+
+// SetDevice adds or replaces the cached device by the change of a system event
+func (c *MetadataCache) SetDevice(device dtos.Device) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.deviceGeneration++
+	c.setDevice(device)
+}
+
+// FillDevice caches the device queried from core-metadata, unless the devices have been changed by the system events since
+// the generation. It returns false if the device is not filled.
+func (c *MetadataCache) FillDevice(device dtos.Device, generation uint64) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.deviceGeneration != generation {
+		return false
+	}
+	c.setDevice(device)
+	return true
+}
+
+// setDevice adds or replaces the cached device, the caller must hold the lock. The device cached with the same id but
+// another name is removed, since the device might be renamed.
+func (c *MetadataCache) setDevice(device dtos.Device) {
+	for name, d := range c.devices {
+		if d.Id != "" && d.Id == device.Id && name != device.Name {
+			delete(c.devices, name)
+		}
+	}
+	c.devices[device.Name] = device
+}
+
+// RemoveDevice removes the cached device by the deletion of a system event
+func (c *MetadataCache) RemoveDevice(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.deviceGeneration++
+	delete(c.devices, name)
+}
+
+// DeviceProfile returns the cached device profile by name
+func (c *MetadataCache) DeviceProfile(name string) (dtos.DeviceProfile, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return lookup(c, c.profiles, name)
+}
+
+// DeviceProfileGeneration returns the current generation of the cached device profiles, which is taken before querying
+// a device profile from core-metadata to fill it by FillDeviceProfile
+func (c *MetadataCache) DeviceProfileGeneration() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.profileGeneration
+}
+
+// SetDeviceProfile adds or replaces the cached device profile by the change of a system event
+func (c *MetadataCache) SetDeviceProfile(profile dtos.DeviceProfile) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.profileGeneration++
+	c.profiles[profile.Name] = profile
+}
+
+// FillDeviceProfile caches the device profile queried from core-metadata, unless the device profiles have been changed
+// by the system events since the generation. It returns false if the device profile is not filled.
+func (c *MetadataCache) FillDeviceProfile(profile dtos.DeviceProfile, generation uint64) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.profileGeneration != generation {
+		return false
+	}
+	c.profiles[profile.Name] = profile
+	return true
+}
+
+// RemoveDeviceProfile removes the cached device profile by the deletion of a system event
+func (c *MetadataCache) RemoveDeviceProfile(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.profileGeneration++
+	delete(c.profiles, name)
+}
+
+// DeviceService returns the cached device service by name
+func (c *MetadataCache) DeviceService(name string) (dtos.DeviceService, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return lookup(c, c.services, name)
+}
+
+// DeviceServiceGeneration returns the current generation of the cached device services, which is taken before querying
+// a device service from core-metadata to fill it by FillDeviceService
+func (c *MetadataCache) DeviceServiceGeneration() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.serviceGeneration
+}
+
+// SetDeviceService adds or replaces the cached device service by the change of a system event
+func (c *MetadataCache) SetDeviceService(service dtos.DeviceService) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.serviceGeneration++
+	c.services[service.Name] = service
+}
+
+// FillDeviceService caches the device service queried from core-metadata, unless the device services have been changed
+// by the system events since the generation. It returns false if the device service is not filled.
+func (c *MetadataCache) FillDeviceService(service dtos.DeviceService, generation uint64) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.serviceGeneration != generation {
+		return false
+	}
+	c.services[service.Name] = service
+	return true
+}
+
+// RemoveDeviceService removes the cached device service by the deletion of a system event
+func (c *MetadataCache) RemoveDeviceService(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.serviceGeneration++
+	delete(c.services, name)
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataCache_Device(t *testing.T) {
+	c := NewMetadataCache()
+	_, ok := c.Device("device1")
+	assert.False(t, ok)
+
+	c.SetDevice(dtos.Device{Id: "id1", Name: "device1"})
+	device, ok := c.Device("device1")
+	assert.True(t, ok)
+	assert.Equal(t, "id1", device.Id)
+	assert.Equal(t, int64(1), c.hits.Count())
+	assert.Equal(t, int64(1), c.misses.Count())
+	assert.Equal(t, 0.5, c.hitRatio.Value())
+
+	// the device is renamed
+	c.SetDevice(dtos.Device{Id: "id1", Name: "device2"})
+	_, ok = c.Device("device1")
+	assert.False(t, ok)
+	_, ok = c.Device("device2")
+	assert.True(t, ok)
+
+	c.RemoveDevice("device2")
+	_, ok = c.Device("device2")
+	assert.False(t, ok)
+}
+
+func TestMetadataCache_DeviceProfileAndService(t *testing.T) {
+	c := NewMetadataCache()
+	c.SetDeviceProfile(dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "profile"}})
+	c.SetDeviceService(dtos.DeviceService{Name: "service", BaseAddress: "http://localhost:59900"})
+
+	_, ok := c.DeviceProfile("profile")
+	assert.True(t, ok)
+	service, ok := c.DeviceService("service")
+	assert.True(t, ok)
+	assert.Equal(t, "http://localhost:59900", service.BaseAddress)
+
+	c.RemoveDeviceProfile("profile")
+	c.RemoveDeviceService("service")
+	_, ok = c.DeviceProfile("profile")
+	assert.False(t, ok)
+	_, ok = c.DeviceService("service")
+	assert.False(t, ok)
+}
+
+func TestMetadataCache_FillDevice(t *testing.T) {
+	c := NewMetadataCache()
+	generation := c.DeviceGeneration()
+	assert.True(t, c.FillDevice(dtos.Device{Id: "id1", Name: "device1"}, generation))
+	_, ok := c.Device("device1")
+	assert.True(t, ok)
+
+	// the device deleted by a system event during the query is not filled with the stale one
+	generation = c.DeviceGeneration()
+	c.RemoveDevice("device1")
+	assert.False(t, c.FillDevice(dtos.Device{Id: "id1", Name: "device1"}, generation))
+	_, ok = c.Device("device1")
+	assert.False(t, ok)
+
+	// the device updated by a system event during the query is not replaced with the stale one
+	generation = c.DeviceGeneration()
+	c.SetDevice(dtos.Device{Id: "id1", Name: "device1", Description: "updated"})
+	assert.False(t, c.FillDevice(dtos.Device{Id: "id1", Name: "device1"}, generation))
+	device, ok := c.Device("device1")
+	assert.True(t, ok)
+	assert.Equal(t, "updated", device.Description)
+}
+
+func TestMetadataCache_FillDeviceProfileAndService(t *testing.T) {
+	c := NewMetadataCache()
+	profileGeneration := c.DeviceProfileGeneration()
+	serviceGeneration := c.DeviceServiceGeneration()
+	c.RemoveDeviceProfile("profile")
+	c.RemoveDeviceService("service")
+
+	assert.False(t, c.FillDeviceProfile(dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "profile"}}, profileGeneration))
+	assert.False(t, c.FillDeviceService(dtos.DeviceService{Name: "service"}, serviceGeneration))
+	_, ok := c.DeviceProfile("profile")
+	assert.False(t, ok)
+	_, ok = c.DeviceService("service")
+	assert.False(t, ok)
+
+	assert.True(t, c.FillDeviceProfile(dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "profile"}}, c.DeviceProfileGeneration()))
+	assert.True(t, c.FillDeviceService(dtos.DeviceService{Name: "service"}, c.DeviceServiceGeneration()))
+	_, ok = c.DeviceProfile("profile")
+	assert.True(t, ok)
+	_, ok = c.DeviceService("service")
+	assert.True(t, ok)
+}
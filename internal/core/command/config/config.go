@@ -1,6 +1,6 @@
 /*******************************************************************************
  * Copyright 2018 Dell Inc.
- * Copyright 2022-2023 IOTech Ltd.
+ * Copyright 2022-2025 IOTech Ltd.
  * Copyright 2023 Intel Corporation
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
@@ -22,13 +22,22 @@ import (
 
 // ConfigurationStruct contains the configuration properties for the core-command service.
 type ConfigurationStruct struct {
-	Writable     WritableInfo
-	Clients      bootstrapConfig.ClientsCollection
-	Databases    map[string]bootstrapConfig.Database
-	Registry     bootstrapConfig.RegistryInfo
-	Service      bootstrapConfig.ServiceInfo
-	MessageBus   bootstrapConfig.MessageBusInfo
-	ExternalMQTT bootstrapConfig.ExternalMQTTInfo
+	Writable      WritableInfo
+	Clients       bootstrapConfig.ClientsCollection
+	Database      bootstrapConfig.Database
+	Databases     map[string]bootstrapConfig.Database
+	Registry      bootstrapConfig.RegistryInfo
+	Service       bootstrapConfig.ServiceInfo
+	MessageBus    bootstrapConfig.MessageBusInfo
+	ExternalMQTT  bootstrapConfig.ExternalMQTTInfo
+	MetadataCache MetadataCacheInfo
+	BatchCommand  BatchCommandInfo
+	// CommandRequest defines how the command requests received from the MessageBus and the external MQTT are handled
+	CommandRequest CommandRequestInfo
+	CommandJob     CommandJobInfo
+	CommandHistory CommandHistoryInfo
+	// CommandPolicies limits the commands issued to the devices, keyed by the policy name
+	CommandPolicies map[string]CommandPolicyInfo
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
@@ -38,6 +47,61 @@ type WritableInfo struct {
 	Telemetry       bootstrapConfig.TelemetryInfo
 }
 
+// MetadataCacheInfo defines whether the devices, device profiles and device services queried from core-metadata are kept
+// in memory. The cache is warmed at startup and kept fresh by the system events published by core-metadata.
+type MetadataCacheInfo struct {
+	Enabled bool
+}
+
+// BatchCommandInfo defines the limits of the batch commands issued to multiple devices
+type BatchCommandInfo struct {
+	// MaxConcurrency is the maximum number of devices the batch command is issued to at the same time
+	MaxConcurrency int
+	// MaxDevices is the maximum number of devices a batch command can select
+	MaxDevices int
+}
+
+// CommandRequestInfo defines the limits of the command requests received from the MessageBus and the external MQTT
+type CommandRequestInfo struct {
+	// MaxConcurrency is the maximum number of the command requests handled at the same time from each source, the
+	// requests beyond it wait for a request to be completed
+	MaxConcurrency int
+}
+
+// CommandJobInfo defines the commands issued to the devices in the background
+type CommandJobInfo struct {
+	// PersistJobs stores the jobs in the Database so that they survive a restart, otherwise the jobs are kept in memory
+	PersistJobs bool
+	// Retention is how long a job is kept since it is created
+	Retention string
+}
+
+// CommandHistoryInfo defines whether the commands issued to the devices are recorded for auditing, the records are stored
+// along with the command jobs, i.e. in the Database if CommandJob.PersistJobs is true
+type CommandHistoryInfo struct {
+	Enabled bool
+	// Retention is how long a command record is kept since the command is issued
+	Retention string
+}
+
+// CommandPolicyInfo defines the limits of the commands issued to the devices selected by the device name, the device
+// profile name and the command name, an empty selector matches any. The limits are applied to each device, or to each
+// command of the device if the CommandName is set, and a zero limit is unlimited.
+type CommandPolicyInfo struct {
+	DeviceName  string
+	ProfileName string
+	CommandName string
+	// MaxRequestsPerSecond is the maximum rate of the commands issued to the device
+	MaxRequestsPerSecond float64
+	// MaxInFlight is the maximum number of the commands issued to the device at the same time
+	MaxInFlight int
+	// SerializeSet issues the set commands to the device one at a time
+	SerializeSet bool
+	// QueueTimeout is how long an excess command waits for its turn before being rejected, the excess commands are
+	// rejected immediately if not set
+	QueueTimeout string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -95,7 +159,7 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 
 // GetDatabaseInfo returns a database information map.
 func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
-	return bootstrapConfig.Database{}
+	return c.Database
 }
 
 // GetInsecureSecrets returns the service's InsecureSecrets.
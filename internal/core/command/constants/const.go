@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX core-command service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiBatchCommandRoute   = common.ApiDeviceRoute + "/" + Batch + "/" + common.Command
+	ApiCommandJobRoute     = common.ApiBase + "/" + CommandJob
+	ApiAllCommandJobsRoute = ApiCommandJobRoute + "/" + common.All
+	ApiCommandJobByIdRoute = ApiCommandJobRoute + "/" + common.Id + "/:" + common.Id
+
+	ApiCommandHistoryRoute             = common.ApiBase + "/" + CommandHistory
+	ApiAllCommandHistoryRoute          = ApiCommandHistoryRoute + "/" + common.All
+	ApiCommandHistoryByDeviceNameRoute = ApiCommandHistoryRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	Batch          = "batch"
+	CommandJob     = "commandjob"
+	CommandHistory = "commandhistory"
+	Status         = "status"
+	Outcome        = "outcome"
+
+	// Async, CallbackUrl and CallbackTopic are the query parameters of the get and set commands issued in the
+	// background, they are consumed by core-command and not passed to the device service
+	Async         = "async"
+	CallbackUrl   = "callbackUrl"
+	CallbackTopic = "callbackTopic"
+
+	// Caller is the query parameter of the command requests received from the MessageBus and external MQTT which names
+	// the caller of the command for the command history, it is consumed by core-command and not passed to the device
+	// service. The caller is stated by the requester and is not verified.
+	Caller = "caller"
+)
+
+// Constants related to the MessageBus topics of core-command
+const (
+	// CoreCommandBatchRequestTopic is the topic to receive the batch command requests from the EdgeX services, the
+	// response is published to the common response topic <ResponseTopicPrefix>/core-command/<request-id>
+	CoreCommandBatchRequestTopic = "core/command/batch/request"
+)
+
+// Constants related to the methods of the commands
+const (
+	CommandMethodGet = "get"
+	CommandMethodSet = "set"
+)
+
+// Constants related to the status of the command jobs
+const (
+	CommandJobStatusPending   = "PENDING"
+	CommandJobStatusRunning   = "RUNNING"
+	CommandJobStatusSucceeded = "SUCCEEDED"
+	CommandJobStatusFailed    = "FAILED"
+)
+
+// Constants related to the outcome of the commands recorded in the command history
+const (
+	CommandOutcomeSucceeded = "SUCCEEDED"
+	CommandOutcomeFailed    = "FAILED"
+)
+
+// Constants related to where the commands recorded in the command history are received from
+const (
+	CommandSourceHTTP         = "http"
+	CommandSourceMessageBus   = "messagebus"
+	CommandSourceExternalMQTT = "external-mqtt"
+)
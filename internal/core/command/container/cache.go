@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+)
+
+// MetadataCacheName contains the name of the cache.MetadataCache instance in the DIC.
+var MetadataCacheName = di.TypeInstanceToName(cache.MetadataCache{})
+
+// MetadataCacheFrom helper function queries the DIC and returns the cache.MetadataCache instance, nil is returned if the
+// metadata cache is disabled.
+func MetadataCacheFrom(get di.Get) *cache.MetadataCache {
+	metadataCache, ok := get(MetadataCacheName).(*cache.MetadataCache)
+	if !ok {
+		return nil
+	}
+	return metadataCache
+}
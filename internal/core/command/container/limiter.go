@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/limiter"
+)
+
+// CommandLimiterName contains the name of the limiter.CommandLimiter instance in the DIC.
+var CommandLimiterName = di.TypeInstanceToName(limiter.CommandLimiter{})
+
+// CommandLimiterFrom helper function queries the DIC and returns the limiter.CommandLimiter instance, nil is returned if
+// the command limiter is not created.
+func CommandLimiterFrom(get di.Get) *limiter.CommandLimiter {
+	commandLimiter, ok := get(CommandLimiterName).(*limiter.CommandLimiter)
+	if !ok {
+		return nil
+	}
+	return commandLimiter
+}
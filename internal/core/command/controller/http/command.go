@@ -1,37 +1,47 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package http
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"net/http"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
 )
 
 type CommandController struct {
-	dic *di.Container
+	reader io.DtoReader
+	dic    *di.Container
 }
 
 // NewCommandController creates and initializes an CommandController
 func NewCommandController(dic *di.Container) *CommandController {
 	return &CommandController{
-		dic: dic,
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
 	}
 }
 
@@ -107,9 +117,13 @@ func (cc *CommandController) IssueGetCommandByName(c echo.Context) error {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
-	response, err := application.IssueGetCommandByName(deviceName, commandName, queryParams, cc.dic)
+	if isAsyncCommand(r) {
+		return cc.submitCommandJob(c, deviceName, commandName, constants.CommandMethodGet, nil)
+	}
+
+	response, err := application.IssueGetCommandByName(withCommandCaller(r), deviceName, commandName, queryParams, cc.dic)
 	if err != nil {
-		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return writeCommandErrorResponse(w, ctx, lc, err)
 	}
 	// encode and send out the response
 	if response != nil {
@@ -138,12 +152,74 @@ func (cc *CommandController) IssueSetCommandByName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	response, err := application.IssueSetCommandByName(deviceName, commandName, queryParams, settings, cc.dic)
+
+	if isAsyncCommand(r) {
+		return cc.submitCommandJob(c, deviceName, commandName, constants.CommandMethodSet, settings)
+	}
+	response, err := application.IssueSetCommandByName(withCommandCaller(r), deviceName, commandName, queryParams, settings, cc.dic)
 	if err != nil {
-		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return writeCommandErrorResponse(w, ctx, lc, err)
 	}
 
 	utils.WriteHttpHeader(w, ctx, response.StatusCode)
 	// encode and send out the response
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// withCommandCaller returns the request context carrying the caller identified by the JWT of the request, so that the
+// commands issued via REST are recorded in the command history with the caller
+func withCommandCaller(r *http.Request) context.Context {
+	return application.WithCommandCaller(r.Context(), utils.CallerFromAuthorization(r.Header.Get(echo.HeaderAuthorization)), constants.CommandSourceHTTP)
+}
+
+// writeCommandErrorResponse writes the error response of the command, the response contains the per-setting errors if
+// the settings are rejected by the device profile, and the status code is 429 if the command is rejected by the command
+// policies
+func writeCommandErrorResponse(w *echo.Response, ctx context.Context, lc logger.LoggingClient, err errors.EdgeX) error {
+	statusCode := application.CommandErrorCode(err)
+	settingErrors := application.SettingErrorsFrom(err)
+	if len(settingErrors) == 0 && statusCode == err.Code() {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	lc.Error(err.Error(), common.CorrelationHeader, correlation.FromContext(ctx))
+	var response any = commonDTO.NewBaseResponse("", err.Message(), statusCode)
+	if len(settingErrors) > 0 {
+		response = responses.NewSettingErrorsResponse("", err.Message(), statusCode, settingErrors)
+	}
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// IssueBatchCommand issues the same get or set command to the devices selected by the request, and returns the per-device
+// results
+func (cc *CommandController) IssueBatchCommand(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	var reqDTO requests.BatchCommandRequest
+	err := cc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	result, err := application.IssueBatchCommand(withCommandCaller(r), reqDTO.Command, cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	statusCode := http.StatusOK
+	if result.Failed > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+	response := responses.NewBatchCommandResponse(reqDTO.RequestId, "", statusCode, result)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
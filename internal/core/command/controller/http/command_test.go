@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -15,7 +15,12 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	commandResponses "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/limiter"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -148,6 +153,32 @@ func buildDeviceProfileResponse() responseDTO.DeviceProfileResponse {
 	return deviceResponse
 }
 
+// buildWritableDeviceProfileResponse returns the profile whose testCommandName command writes the settings built by
+// buildTestSettings
+func buildWritableDeviceProfileResponse() responseDTO.DeviceProfileResponse {
+	minimum, maximum := float64(10), float64(35)
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "AHU-TargetTemperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Minimum: &minimum, Maximum: &maximum}},
+			{Name: "AHU-TargetBand", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+			{Name: "AHU-TargetHumidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeObject, ReadWrite: common.ReadWrite_RW}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{
+				Name:      testCommandName,
+				ReadWrite: common.ReadWrite_RW,
+				ResourceOperations: []dtos.ResourceOperation{
+					{DeviceResource: "AHU-TargetTemperature"},
+					{DeviceResource: "AHU-TargetBand"},
+					{DeviceResource: "AHU-TargetHumidity"},
+				},
+			},
+		},
+	}
+	return responseDTO.NewDeviceProfileResponse("", "", http.StatusOK, profile)
+}
+
 func buildDeviceServiceResponse() responseDTO.DeviceServiceResponse {
 	service := dtos.DeviceService{
 		Name:        testDeviceServiceName,
@@ -426,6 +457,55 @@ func TestIssueGetCommand(t *testing.T) {
 	}
 }
 
+func TestIssueGetCommand_TooManyRequests(t *testing.T) {
+	expectedEventResponse := buildEventResponse()
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", context.Background(), testBaseAddress, testDeviceName, testCommandName, "").Return(&expectedEventResponse, nil)
+	commandLimiter, edgexErr := limiter.NewCommandLimiter(map[string]config.CommandPolicyInfo{
+		"device": {DeviceName: testDeviceName, MaxRequestsPerSecond: 1},
+	})
+	require.NoError(t, edgexErr)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+		commandContainer.CommandLimiterName: func(get di.Get) interface{} {
+			return commandLimiter
+		},
+	})
+	cc := NewCommandController(dic)
+
+	// the second command within a second exceeds the rate of the command policy
+	for _, expectedStatusCode := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v3/device/name/:name/:command", http.NoBody)
+		recorder := httptest.NewRecorder()
+		c := e.NewContext(req, recorder)
+		c.SetParamNames(common.Name, common.Command)
+		c.SetParamValues(testDeviceName, testCommandName)
+		err := cc.IssueGetCommandByName(c)
+		require.NoError(t, err)
+
+		var res commonDTO.BaseResponse
+		err = json.Unmarshal(recorder.Body.Bytes(), &res)
+		require.NoError(t, err)
+		assert.Equal(t, expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		assert.Equal(t, expectedStatusCode, res.StatusCode, "Response status code not as expected")
+	}
+}
+
 func TestIssueSetCommand(t *testing.T) {
 	var nonExistName = "nonExist"
 
@@ -439,9 +519,14 @@ func TestIssueSetCommand(t *testing.T) {
 
 	dscMock := &mocks.DeviceServiceClient{}
 	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(expectedDeviceServiceResponse, nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(buildWritableDeviceProfileResponse(), nil)
 
 	testSettings := buildTestSettings()
 	testSettingsJsonStr, _ := json.Marshal(testSettings)
+	outOfRangeSettings := buildTestSettings()
+	outOfRangeSettings["AHU-TargetTemperature"] = "40"
+	outOfRangeSettingsJsonStr, _ := json.Marshal(outOfRangeSettings)
 	dsccMock := &mocks.DeviceServiceCommandClient{}
 	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, testQueryStrings, testSettings).Return(expectedBaseResponse, nil)
 	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", testSettings).Return(expectedBaseResponse, nil)
@@ -456,6 +541,9 @@ func TestIssueSetCommand(t *testing.T) {
 		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
 			return dscMock
 		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
 		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
 			return dsccMock
 		},
@@ -479,6 +567,7 @@ func TestIssueSetCommand(t *testing.T) {
 		{"Invalid - empty device name", "", testCommandName, testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
 		{"Invalid - empty command name", testDeviceName, "", testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
 		{"Invalid - empty settings", testDeviceName, testCommandName, testQueryStrings, []byte{}, true, http.StatusBadRequest},
+		{"Invalid - setting out of range", testDeviceName, testCommandName, testQueryStrings, outOfRangeSettingsJsonStr, true, http.StatusBadRequest},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -513,3 +602,85 @@ func TestIssueSetCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueBatchCommand(t *testing.T) {
+	var nonExistName = "nonExist"
+	testSettings := buildTestSettings()
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(buildDeviceResponse(), nil)
+	dcMock.On("DeviceByName", context.Background(), nonExistName).Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(buildWritableDeviceProfileResponse(), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", testSettings).Return(commonDTO.NewBaseResponse("", "", http.StatusOK), nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+
+	buildRequest := func(method string, deviceNames []string, settings map[string]interface{}) []byte {
+		req := requests.BatchCommandRequest{
+			BaseRequest: commonDTO.NewBaseRequest(),
+			Command: commandDTOs.BatchCommand{
+				CommandName: testCommandName,
+				Method:      method,
+				Selector:    commandDTOs.DeviceSelector{DeviceNames: deviceNames},
+				Settings:    settings,
+			},
+		}
+		data, err := json.Marshal(req)
+		require.NoError(t, err)
+		return data
+	}
+
+	tests := []struct {
+		name               string
+		request            []byte
+		expectedSucceeded  int
+		expectedFailed     int
+		expectedStatusCode int
+	}{
+		{"Valid - all devices succeeded", buildRequest(constants.CommandMethodSet, []string{testDeviceName}, testSettings), 1, 0, http.StatusOK},
+		{"Valid - some devices failed", buildRequest(constants.CommandMethodSet, []string{testDeviceName, nonExistName}, testSettings), 1, 1, http.StatusMultiStatus},
+		{"Invalid - empty selector", buildRequest(constants.CommandMethodSet, nil, testSettings), 0, 0, http.StatusBadRequest},
+		{"Invalid - empty settings", buildRequest(constants.CommandMethodSet, []string{testDeviceName}, nil), 0, 0, http.StatusBadRequest},
+		{"Invalid - unknown method", buildRequest("post", []string{testDeviceName}, testSettings), 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, constants.ApiBatchCommandRoute, bytes.NewBuffer(testCase.request))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err := cc.IssueBatchCommand(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res commandResponses.BatchCommandResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Equal(t, testCase.expectedSucceeded, res.Result.Succeeded, "Succeeded count not as expected")
+			assert.Equal(t, testCase.expectedFailed, res.Result.Failed, "Failed count not as expected")
+		})
+	}
+}
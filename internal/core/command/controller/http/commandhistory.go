@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CommandHistoryController struct {
+	dic *di.Container
+}
+
+// NewCommandHistoryController creates and initializes an CommandHistoryController
+func NewCommandHistoryController(dic *di.Container) *CommandHistoryController {
+	return &CommandHistoryController{
+		dic: dic,
+	}
+}
+
+// AllCommandHistory returns the command records filtered by the optional time range and outcome query parameters with
+// offset and limit
+func (hc *CommandHistoryController) AllCommandHistory(c echo.Context) error {
+	return hc.commandHistory(c, "")
+}
+
+// CommandHistoryByDeviceName returns the command records of the device filtered by the optional time range and outcome
+// query parameters with offset and limit
+func (hc *CommandHistoryController) CommandHistoryByDeviceName(c echo.Context) error {
+	return hc.commandHistory(c, c.Param(common.Name))
+}
+
+func (hc *CommandHistoryController) commandHistory(c echo.Context, deviceName string) error {
+	lc := container.LoggingClientFrom(hc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(hc.dic.Get)
+
+	// parse URL query string for start, end, offset, limit and outcome
+	start, end, offset, limit, err := utils.ParseQueryStringTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	query := models.CommandRecordQuery{
+		DeviceName: deviceName,
+		Outcome:    utils.ParseQueryStringToString(r, constants.Outcome, ""),
+		Start:      start,
+		End:        end,
+		Offset:     offset,
+		Limit:      limit,
+	}
+
+	records, totalCount, err := application.CommandHistory(ctx, query, hc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiCommandRecordsResponse("", "", http.StatusOK, totalCount, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
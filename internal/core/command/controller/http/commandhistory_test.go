@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandResponses "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/memory"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+func TestCommandHistoryByDeviceName(t *testing.T) {
+	dbClient := memory.NewClient()
+	for i, record := range []models.CommandRecord{
+		{DeviceName: testDeviceName, Outcome: constants.CommandOutcomeSucceeded, Created: 1000},
+		{DeviceName: testDeviceName, Outcome: constants.CommandOutcomeFailed, Created: 2000},
+		{DeviceName: "other", Outcome: constants.CommandOutcomeFailed, Created: 3000},
+	} {
+		record.CommandName = testCommandName
+		record.Method = constants.CommandMethodGet
+		_, err := dbClient.AddCommandRecord(context.Background(), record)
+		require.NoError(t, err, "failed to add record %d", i)
+	}
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+	})
+	hc := NewCommandHistoryController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		queryParams        map[string]string
+		expectedStatusCode int
+		expectedCount      uint32
+	}{
+		{"Valid - all command history", "", nil, http.StatusOK, 3},
+		{"Valid - by device name", testDeviceName, nil, http.StatusOK, 2},
+		{"Valid - by device name and outcome", testDeviceName, map[string]string{constants.Outcome: constants.CommandOutcomeFailed}, http.StatusOK, 1},
+		{"Valid - by time range", "", map[string]string{common.Start: "1500", common.End: "2500"}, http.StatusOK, 1},
+		{"Invalid - unknown outcome", "", map[string]string{constants.Outcome: "UNKNOWN"}, http.StatusBadRequest, 0},
+		{"Invalid - end earlier than start", "", map[string]string{common.Start: "2000", common.End: "1000"}, http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiAllCommandHistoryRoute, http.NoBody)
+			query := req.URL.Query()
+			for k, v := range testCase.queryParams {
+				query.Add(k, v)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			var err error
+			if testCase.deviceName == "" {
+				err = hc.AllCommandHistory(c)
+			} else {
+				c.SetParamNames(common.Name)
+				c.SetParamValues(testCase.deviceName)
+				err = hc.CommandHistoryByDeviceName(c)
+			}
+			require.NoError(t, err)
+
+			// Assert
+			var res commandResponses.MultiCommandRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testCase.expectedCount, res.TotalCount, "Total count not as expected")
+				assert.Len(t, res.Records, int(testCase.expectedCount), "Record count not as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+// isAsyncCommand checks whether the command is requested to be issued in the background
+func isAsyncCommand(r *http.Request) bool {
+	return utils.ParseQueryStringToString(r, constants.Async, common.ValueFalse) == common.ValueTrue
+}
+
+// submitCommandJob submits the command as a job issued in the background, and responds with the job id. The query
+// parameters consumed by core-command are not passed to the device service.
+func (cc *CommandController) submitCommandJob(c echo.Context, deviceName string, commandName string, method string, settings map[string]any) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	job := models.CommandJob{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        method,
+		Settings:      settings,
+		CallbackUrl:   query.Get(constants.CallbackUrl),
+		CallbackTopic: query.Get(constants.CallbackTopic),
+	}
+	query.Del(constants.Async)
+	query.Del(constants.CallbackUrl)
+	query.Del(constants.CallbackTopic)
+	job.QueryParams = query.Encode()
+
+	job, err := application.SubmitCommandJob(withCommandCaller(r), job, cc.dic)
+	if err != nil {
+		return writeCommandErrorResponse(w, ctx, lc, err)
+	}
+
+	response := commonDTO.NewBaseWithIdResponse("", "", http.StatusAccepted, job.Id)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+type CommandJobController struct {
+	dic *di.Container
+}
+
+// NewCommandJobController creates and initializes a CommandJobController
+func NewCommandJobController(dic *di.Container) *CommandJobController {
+	return &CommandJobController{
+		dic: dic,
+	}
+}
+
+// CommandJobById returns the command job and its outcome by id
+func (jc *CommandJobController) CommandJobById(c echo.Context) error {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(common.Id)
+
+	job, err := application.CommandJobById(ctx, id, jc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewCommandJobResponse("", "", http.StatusOK, job)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// AllCommandJobs returns the command jobs filtered by the optional status query parameter with offset and limit
+func (jc *CommandJobController) AllCommandJobs(c echo.Context) error {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(jc.dic.Get)
+
+	// parse URL query string for offset, limit and status
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	status := utils.ParseQueryStringToString(r, constants.Status, "")
+
+	jobs, totalCount, err := application.AllCommandJobs(ctx, status, offset, limit, jc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiCommandJobsResponse("", "", http.StatusOK, totalCount, jobs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
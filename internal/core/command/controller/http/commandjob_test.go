@@ -0,0 +1,154 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandResponses "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/memory"
+)
+
+func TestIssueGetCommand_Async(t *testing.T) {
+	expectedEventResponse := buildEventResponse()
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(buildDeviceResponse(), nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).Return(buildDeviceServiceResponse(), nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	// the async query parameters are not forwarded to the device service
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, testQueryStrings).Return(&expectedEventResponse, nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return memory.NewClient()
+		},
+	})
+	cc := NewCommandController(dic)
+	jc := NewCommandJobController(dic)
+
+	tests := []struct {
+		name               string
+		queryStrings       string
+		expectedStatusCode int
+	}{
+		{"Valid - async command", testQueryStrings + "&async=true", http.StatusAccepted},
+		{"Invalid - relative callback url", testQueryStrings + "&async=true&callbackUrl=/callback", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v3/device/name/:name/:command", http.NoBody)
+			req.URL.RawQuery = testCase.queryStrings
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Command)
+			c.SetParamValues(testDeviceName, testCommandName)
+			err := cc.IssueGetCommandByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusAccepted {
+				return
+			}
+			require.NotEmpty(t, res.Id, "Response should contain the command job id")
+
+			// the outcome of the command is available via the job id once completed
+			assert.Eventually(t, func() bool {
+				req := httptest.NewRequest(http.MethodGet, constants.ApiCommandJobByIdRoute, http.NoBody)
+				recorder := httptest.NewRecorder()
+				c := e.NewContext(req, recorder)
+				c.SetParamNames(common.Id)
+				c.SetParamValues(res.Id)
+				if err := jc.CommandJobById(c); err != nil || recorder.Result().StatusCode != http.StatusOK {
+					return false
+				}
+				var jobRes commandResponses.CommandJobResponse
+				if err := json.Unmarshal(recorder.Body.Bytes(), &jobRes); err != nil {
+					return false
+				}
+				return jobRes.Job.Status == constants.CommandJobStatusSucceeded
+			}, time.Second, 10*time.Millisecond, "command job is not succeeded")
+		})
+	}
+}
+
+func TestAllCommandJobs(t *testing.T) {
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return memory.NewClient()
+		},
+	})
+	jc := NewCommandJobController(dic)
+
+	tests := []struct {
+		name               string
+		status             string
+		expectedStatusCode int
+	}{
+		{"Valid - all command jobs", "", http.StatusOK},
+		{"Valid - command jobs by status", constants.CommandJobStatusFailed, http.StatusOK},
+		{"Invalid - unknown status", "UNKNOWN", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiAllCommandJobsRoute, http.NoBody)
+			if testCase.status != "" {
+				query := req.URL.Query()
+				query.Add(constants.Status, testCase.status)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err := jc.AllCommandJobs(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res commandResponses.MultiCommandJobsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
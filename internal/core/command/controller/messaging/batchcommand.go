@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+)
+
+// SubscribeBatchCommandRequests subscribes batch command requests from EdgeX service (e.g., Application Service)
+// via internal MessageBus
+func SubscribeBatchCommandRequests(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	baseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+	batchRequestTopic := common.BuildTopic(baseTopic, constants.CoreCommandBatchRequestTopic)
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    batchRequestTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+
+	lc.Infof("Subscribing to internal batch command requests on topic: %s", batchRequestTopic)
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", batchRequestTopic)
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case requestEnvelope := <-messages:
+				// the batch command might take a while, so it doesn't block the following requests
+				go processBatchCommandRequest(ctx, messageBus, requestEnvelope, baseTopic, lc, dic)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func processBatchCommandRequest(
+	ctx context.Context,
+	messageBus messaging.MessageClient,
+	requestEnvelope types.MessageEnvelope,
+	baseTopic string,
+	lc logger.LoggingClient,
+	dic *di.Container,
+) {
+	lc.Debugf("Batch command request received on internal MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+
+	if len(strings.TrimSpace(requestEnvelope.RequestID)) == 0 {
+		lc.Errorf("RequestId not set in Batch command request received on internal MessageBus")
+		lc.Warn("Not publishing error message back due to insufficient information to publish on response topic")
+		return
+	}
+
+	responseEnvelope, err := getBatchCommandResponseEnvelope(ctx, requestEnvelope, dic)
+	if err != nil {
+		lc.Error(err.Error())
+		responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+	}
+
+	// internal response topic scheme: <ResponseTopicPrefix>/<service-name>/<request-id>
+	internalResponseTopic := common.BuildTopic(baseTopic, common.ResponseTopic, common.CoreCommandServiceKey, requestEnvelope.RequestID)
+	err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+	if err != nil {
+		lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		return
+	}
+
+	lc.Debugf("Batch command response sent to internal MessageBus. Topic: %s, Correlation-id: %s", internalResponseTopic, requestEnvelope.CorrelationID)
+}
+
+// getBatchCommandResponseEnvelope issues the batch command of the request and returns the MessageEnvelope containing the
+// BatchCommandResponse payload
+func getBatchCommandResponseEnvelope(ctx context.Context, requestEnvelope types.MessageEnvelope, dic *di.Container) (types.MessageEnvelope, error) {
+	request, err := types.GetMsgPayload[requests.BatchCommandRequest](requestEnvelope)
+	if err != nil {
+		return types.MessageEnvelope{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the batch command request", err)
+	}
+
+	ctx = application.WithCommandCaller(ctx, commandRequestCaller(&requestEnvelope), constants.CommandSourceMessageBus)
+	result, edgexErr := application.IssueBatchCommand(ctx, request.Command, dic)
+	if edgexErr != nil {
+		return types.MessageEnvelope{}, errors.NewCommonEdgeX(errors.Kind(edgexErr), "failed to issue the batch command", edgexErr)
+	}
+
+	statusCode := http.StatusOK
+	if result.Failed > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+	response := responses.NewBatchCommandResponse(requestEnvelope.RequestID, "", statusCode, result)
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(response, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		return types.MessageEnvelope{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to create response MessageEnvelope", err)
+	}
+	return responseEnvelope, nil
+}
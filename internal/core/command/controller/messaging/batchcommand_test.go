@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	mocks2 "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	commandResponses "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+)
+
+func TestGetBatchCommandResponseEnvelope(t *testing.T) {
+	expectedServiceName := "device-simple"
+	expectedDevice := "device1"
+	expectedBaseAddress := "http://localhost:59900"
+	expectedCommand := "temperature"
+
+	mockDeviceClient := &mocks2.DeviceClient{}
+	mockDeviceClient.On("DeviceByName", context.Background(), expectedDevice).
+		Return(responses.NewDeviceResponse("", "", http.StatusOK, dtos.Device{Name: expectedDevice, ServiceName: expectedServiceName}), nil)
+	mockDeviceServiceClient := &mocks2.DeviceServiceClient{}
+	mockDeviceServiceClient.On("DeviceServiceByName", context.Background(), expectedServiceName).
+		Return(responses.NewDeviceServiceResponse("", "", http.StatusOK, dtos.DeviceService{Name: expectedServiceName, BaseAddress: expectedBaseAddress}), nil)
+	mockCommandClient := &mocks2.DeviceServiceCommandClient{}
+	mockCommandClient.On("GetCommand", context.Background(), expectedBaseAddress, expectedDevice, expectedCommand, "").
+		Return(&responses.EventResponse{BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK)}, nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return mockDeviceClient
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return mockDeviceServiceClient
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return mockCommandClient
+		},
+	})
+
+	validRequest := requests.BatchCommandRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Command: commandDTOs.BatchCommand{
+			CommandName: expectedCommand,
+			Method:      constants.CommandMethodGet,
+			Selector:    commandDTOs.DeviceSelector{DeviceNames: []string{expectedDevice}},
+		},
+	}
+	invalidRequest := validRequest
+	invalidRequest.Command.Selector = commandDTOs.DeviceSelector{}
+
+	tests := []struct {
+		name          string
+		request       requests.BatchCommandRequest
+		errorExpected bool
+	}{
+		{"valid", validRequest, false},
+		{"invalid - empty selector", invalidRequest, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			payload, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			requestEnvelope := types.MessageEnvelope{
+				RequestID:     uuid.NewString(),
+				CorrelationID: uuid.NewString(),
+				ContentType:   common.ContentTypeJSON,
+				Payload:       payload,
+			}
+
+			responseEnvelope, err := getBatchCommandResponseEnvelope(context.Background(), requestEnvelope, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, requestEnvelope.RequestID, responseEnvelope.RequestID)
+			response, err := types.GetMsgPayload[commandResponses.BatchCommandResponse](responseEnvelope)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+			require.Len(t, response.Result.Results, 1)
+			assert.Equal(t, expectedDevice, response.Result.Results[0].DeviceName)
+		})
+	}
+}
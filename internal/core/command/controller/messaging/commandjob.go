@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// isAsyncCommandRequest checks whether the command request is requested to be issued in the background
+func isAsyncCommandRequest(requestEnvelope types.MessageEnvelope) bool {
+	return requestEnvelope.QueryParams[constants.Async] == common.ValueTrue
+}
+
+// getCommandJobResponseEnvelope submits the command request as a job issued in the background, and returns the
+// MessageEnvelope containing the job id. The query parameters consumed by core-command are not passed to the device service.
+func getCommandJobResponseEnvelope(requestEnvelope types.MessageEnvelope, caller string, deviceName string, commandName string, method string, dic *di.Container) (types.MessageEnvelope, error) {
+	job := models.CommandJob{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        method,
+		CallbackUrl:   requestEnvelope.QueryParams[constants.CallbackUrl],
+		CallbackTopic: requestEnvelope.QueryParams[constants.CallbackTopic],
+	}
+	queryParams := url.Values{}
+	for k, v := range requestEnvelope.QueryParams {
+		if k != constants.Async && k != constants.CallbackUrl && k != constants.CallbackTopic {
+			queryParams.Set(k, v)
+		}
+	}
+	job.QueryParams = queryParams.Encode()
+	if method == constants.CommandMethodSet {
+		settings, err := types.GetMsgPayload[map[string]any](requestEnvelope)
+		if err != nil {
+			return types.MessageEnvelope{}, fmt.Errorf("failed to decode the settings of the set command: %s", err.Error())
+		}
+		job.Settings = settings
+	}
+
+	job, edgexErr := application.SubmitCommandJob(application.WithCommandCaller(context.Background(), caller, constants.CommandSourceMessageBus), job, dic)
+	if edgexErr != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to submit the command job: %s", edgexErr.Error())
+	}
+
+	response := commonDTO.NewBaseWithIdResponse(requestEnvelope.RequestID, "", http.StatusAccepted, job.Id)
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(response, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to create response MessageEnvelope: %s", err.Error())
+	}
+	return responseEnvelope, nil
+}
@@ -7,6 +7,7 @@
 package messaging
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -21,10 +22,13 @@ import (
 
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 )
 
 func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnConnectHandler {
+	// the pool is shared by the subscriptions made on each reconnection
+	pool := newCommandRequestPool(dic)
 	return func(client mqtt.Client) {
 		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 		config := container.ConfigurationFrom(dic.Get)
@@ -39,7 +43,7 @@ func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnCo
 		}
 
 		requestCommandTopic := externalTopics[common.CommandRequestTopicKey]
-		if token := client.Subscribe(requestCommandTopic, qos, commandRequestHandler(requestTimeout, dic)); token.Wait() && token.Error() != nil {
+		if token := client.Subscribe(requestCommandTopic, qos, pooledHandler(pool, commandRequestHandler(requestTimeout, dic))); token.Wait() && token.Error() != nil {
 			lc.Errorf("could not subscribe to topic '%s': %s", requestCommandTopic, token.Error().Error())
 		} else {
 			lc.Debugf("Subscribed to topic '%s' on external MQTT broker", requestCommandTopic)
@@ -92,6 +96,16 @@ func commandQueryHandler(dic *di.Container) mqtt.MessageHandler {
 	}
 }
 
+// pooledHandler returns the handler handling the messages with the pool, the MQTT client waits for a worker of the pool
+// to be available before the message is handled
+func pooledHandler(pool *commandRequestPool, handler mqtt.MessageHandler) mqtt.MessageHandler {
+	return func(client mqtt.Client, message mqtt.Message) {
+		pool.submit(context.Background(), func() {
+			handler(client, message)
+		})
+	}
+}
+
 func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt.MessageHandler {
 	return func(client mqtt.Client, message mqtt.Message) {
 		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -156,6 +170,18 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 			return
 		}
 
+		caller := commandRequestCaller(&requestEnvelope)
+		start := time.Now()
+		if strings.EqualFold(method, "set") {
+			err = validateSetCommandRequest(requestEnvelope, deviceName, commandName, dic)
+			if err != nil {
+				recordCommandRequest(requestEnvelope, caller, deviceName, commandName, method, constants.CommandSourceExternalMQTT, start, nil, err, dic)
+				responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+				publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
+				return
+			}
+		}
+
 		deviceRequestTopic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
 			SetPath(topicPrefix).SetNameFieldPath(deviceServiceName).SetNameFieldPath(deviceName).SetNameFieldPath(commandName).SetPath(method).BuildPath()
 		deviceResponseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
@@ -167,7 +193,16 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 		internalMessageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
 
 		// Request waits for the response and returns it.
+		release, err := acquireCommandRequest(deviceName, commandName, method, dic)
+		if err != nil {
+			recordCommandRequest(requestEnvelope, caller, deviceName, commandName, method, constants.CommandSourceExternalMQTT, start, nil, err, dic)
+			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
+			return
+		}
 		response, err := internalMessageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+		release()
+		recordCommandRequest(requestEnvelope, caller, deviceName, commandName, method, constants.CommandSourceExternalMQTT, start, response, err, dic)
 		if err != nil {
 			errorMessage := fmt.Sprintf("Failed to send DeviceCommand request with internal MessageBus: %v", err)
 			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, errorMessage)
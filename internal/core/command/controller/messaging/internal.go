@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2022-2023 IOTech Ltd
+// Copyright (C) 2022-2025 IOTech Ltd
 // Copyright (C) 2023 Intel Inc.
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -23,6 +23,7 @@ import (
 
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 )
 
@@ -48,6 +49,7 @@ func SubscribeCommandRequests(ctx context.Context, requestTimeout time.Duration,
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	pool := newCommandRequestPool(dic)
 	go func() {
 		for {
 			select {
@@ -57,7 +59,12 @@ func SubscribeCommandRequests(ctx context.Context, requestTimeout time.Duration,
 			case err = <-messageErrors:
 				lc.Error(err.Error())
 			case requestEnvelope := <-messages:
-				processDeviceCommandRequest(messageBus, requestEnvelope, baseTopic, requestTimeout, lc, dic)
+				if !pool.submit(ctx, func() {
+					processDeviceCommandRequest(messageBus, requestEnvelope, baseTopic, requestTimeout, lc, dic)
+				}) {
+					lc.Infof("Exiting waiting for MessageBus '%s' topic messages", requestCommandTopic)
+					return
+				}
 			}
 		}
 	}()
@@ -153,6 +160,35 @@ func processDeviceCommandRequest(
 		return
 	}
 
+	caller := commandRequestCaller(&requestEnvelope)
+	if isAsyncCommandRequest(requestEnvelope) {
+		responseEnvelope, err := getCommandJobResponseEnvelope(requestEnvelope, caller, deviceName, commandName, strings.ToLower(method), dic)
+		if err != nil {
+			lc.Error(err.Error())
+			responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		}
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
+
+	start := time.Now()
+	if strings.EqualFold(method, "set") {
+		err = validateSetCommandRequest(requestEnvelope, deviceName, commandName, dic)
+		if err != nil {
+			recordCommandRequest(requestEnvelope, caller, deviceName, commandName, method, constants.CommandSourceMessageBus, start, nil, err, dic)
+			lc.Error(err.Error())
+			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+			err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+			if err != nil {
+				lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+			}
+			return
+		}
+	}
+
 	deviceRequestTopic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
 		SetPath(topicPrefix).SetNameFieldPath(deviceServiceName).SetNameFieldPath(deviceName).SetNameFieldPath(commandName).SetPath(method).BuildPath()
 	deviceResponseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
@@ -161,7 +197,20 @@ func processDeviceCommandRequest(
 	lc.Debugf("Sending Command Device Request to internal MessageBus. Topic: %s, Correlation-id: %s", deviceRequestTopic, requestEnvelope.CorrelationID)
 	lc.Debugf("Expecting response on topic: %s/%s", deviceResponseTopicPrefix, requestEnvelope.RequestID)
 
+	release, err := acquireCommandRequest(deviceName, commandName, method, dic)
+	if err != nil {
+		recordCommandRequest(requestEnvelope, caller, deviceName, commandName, method, constants.CommandSourceMessageBus, start, nil, err, dic)
+		lc.Error(err.Error())
+		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
 	response, err := messageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+	release()
+	recordCommandRequest(requestEnvelope, caller, deviceName, commandName, method, constants.CommandSourceMessageBus, start, response, err, dic)
 	if err != nil {
 		lc.Errorf("Request to topic '%s' failed: %s", deviceRequestTopic, err.Error())
 		return
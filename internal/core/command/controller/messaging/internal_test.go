@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 )
 
@@ -255,3 +256,39 @@ func TestSubscribeCommandQueryRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandRequestPool(t *testing.T) {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{CommandRequest: config.CommandRequestInfo{MaxConcurrency: 2}}
+		},
+	})
+	pool := newCommandRequestPool(dic)
+
+	// a blocked request doesn't hold up the next one while a worker is available
+	blocked := make(chan struct{})
+	handled := make(chan struct{})
+	require.True(t, pool.submit(context.Background(), func() { <-blocked }))
+	require.True(t, pool.submit(context.Background(), func() { close(handled) }))
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		require.Fail(t, "the request is held up by the blocked request")
+	}
+
+	// the request waits for a worker once all workers are busy
+	require.True(t, pool.submit(context.Background(), func() { <-blocked }))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.False(t, pool.submit(ctx, func() {}))
+	close(blocked)
+}
+
+func TestCommandRequestCaller(t *testing.T) {
+	requestEnvelope := types.MessageEnvelope{QueryParams: map[string]string{constants.Caller: "app-rules-engine", common.PushEvent: common.ValueTrue}}
+	assert.Equal(t, "app-rules-engine", commandRequestCaller(&requestEnvelope))
+	// the caller is not passed to the device service
+	assert.Equal(t, map[string]string{common.PushEvent: common.ValueTrue}, requestEnvelope.QueryParams)
+
+	assert.Empty(t, commandRequestCaller(&types.MessageEnvelope{}))
+}
@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// SubscribeMetadataSystemEvents subscribes the system events published by core-metadata to keep the metadata cache fresh
+func SubscribeMetadataSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	metadataCache := container.MetadataCacheFrom(dic.Get)
+	if metadataCache == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataCache returned", nil)
+	}
+
+	// edgex/system-events/core-metadata/<type>/<action>/<owner>/...
+	systemEventTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(configuration.MessageBus.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).
+		SetPath(common.CoreMetaDataServiceKey).SetPath("#").BuildPath()
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    systemEventTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+
+	lc.Infof("Subscribing to core-metadata system events on topic: %s", systemEventTopic)
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", systemEventTopic)
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case msgEnvelope := <-messages:
+				systemEvent, err := types.GetMsgPayload[dtos.SystemEvent](msgEnvelope)
+				if err != nil {
+					lc.Errorf("failed to decode system event: %v", err)
+					continue
+				}
+				if err = updateMetadataCache(systemEvent, metadataCache); err != nil {
+					lc.Error(err.Error(), common.CorrelationHeader, msgEnvelope.CorrelationID)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// updateMetadataCache applies the device, device profile or device service change of the system event to the cache
+func updateMetadataCache(systemEvent dtos.SystemEvent, metadataCache *cache.MetadataCache) error {
+	remove := systemEvent.Action == common.SystemEventActionDelete
+	switch systemEvent.Type {
+	case common.DeviceSystemEventType:
+		var device dtos.Device
+		if err := systemEvent.DecodeDetails(&device); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %v", systemEvent.Type, err)
+		}
+		if remove {
+			metadataCache.RemoveDevice(device.Name)
+		} else {
+			metadataCache.SetDevice(device)
+		}
+	case common.DeviceProfileSystemEventType:
+		var profile dtos.DeviceProfile
+		if err := systemEvent.DecodeDetails(&profile); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %v", systemEvent.Type, err)
+		}
+		if remove {
+			metadataCache.RemoveDeviceProfile(profile.Name)
+		} else {
+			metadataCache.SetDeviceProfile(profile)
+		}
+	case common.DeviceServiceSystemEventType:
+		var service dtos.DeviceService
+		if err := systemEvent.DecodeDetails(&service); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %v", systemEvent.Type, err)
+		}
+		if remove {
+			metadataCache.RemoveDeviceService(service.Name)
+		} else {
+			metadataCache.SetDeviceService(service)
+		}
+	}
+	return nil
+}
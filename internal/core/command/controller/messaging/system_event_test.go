@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+)
+
+// newSystemEvent builds the system event as it is received from the message bus, where the details are decoded from JSON
+func newSystemEvent(t *testing.T, eventType string, action string, details any) dtos.SystemEvent {
+	data, err := json.Marshal(dtos.NewSystemEvent(eventType, action, common.CoreMetaDataServiceKey, "owner", nil, details))
+	require.NoError(t, err)
+	var systemEvent dtos.SystemEvent
+	require.NoError(t, json.Unmarshal(data, &systemEvent))
+	return systemEvent
+}
+
+func TestUpdateMetadataCache(t *testing.T) {
+	metadataCache := cache.NewMetadataCache()
+	device := dtos.Device{Id: "id1", Name: "device1", ServiceName: "service1", ProfileName: "profile1"}
+	profile := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "profile1"}}
+	service := dtos.DeviceService{Name: "service1", BaseAddress: "http://localhost:59900"}
+
+	tests := []struct {
+		name        string
+		systemEvent dtos.SystemEvent
+		expectedOk  bool
+		lookup      func() bool
+	}{
+		{"add device", newSystemEvent(t, common.DeviceSystemEventType, common.SystemEventActionAdd, device), true,
+			func() bool { _, ok := metadataCache.Device(device.Name); return ok }},
+		{"delete device", newSystemEvent(t, common.DeviceSystemEventType, common.SystemEventActionDelete, device), false,
+			func() bool { _, ok := metadataCache.Device(device.Name); return ok }},
+		{"update device profile", newSystemEvent(t, common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, profile), true,
+			func() bool { _, ok := metadataCache.DeviceProfile(profile.Name); return ok }},
+		{"delete device profile", newSystemEvent(t, common.DeviceProfileSystemEventType, common.SystemEventActionDelete, profile), false,
+			func() bool { _, ok := metadataCache.DeviceProfile(profile.Name); return ok }},
+		{"add device service", newSystemEvent(t, common.DeviceServiceSystemEventType, common.SystemEventActionAdd, service), true,
+			func() bool { _, ok := metadataCache.DeviceService(service.Name); return ok }},
+		{"delete device service", newSystemEvent(t, common.DeviceServiceSystemEventType, common.SystemEventActionDelete, service), false,
+			func() bool { _, ok := metadataCache.DeviceService(service.Name); return ok }},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := updateMetadataCache(testCase.systemEvent, metadataCache)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOk, testCase.lookup())
+		})
+	}
+}
@@ -8,12 +8,13 @@ package messaging
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
-	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -21,31 +22,50 @@ import (
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
 )
 
+// commandRequestPool handles the command requests in their own goroutines, so that a request waiting for the device or
+// for its turn under the command policies doesn't hold up the requests to the other devices. The number of the requests
+// handled at the same time is bounded by CommandRequest.MaxConcurrency.
+type commandRequestPool struct {
+	workers chan struct{}
+}
+
+func newCommandRequestPool(dic *di.Container) *commandRequestPool {
+	maxConcurrency := container.ConfigurationFrom(dic.Get).CommandRequest.MaxConcurrency
+	return &commandRequestPool{workers: make(chan struct{}, max(maxConcurrency, 1))}
+}
+
+// submit handles the request in a goroutine once a worker is available, and returns false without handling the request
+// if the ctx is done before then
+func (p *commandRequestPool) submit(ctx context.Context, handle func()) bool {
+	select {
+	case p.workers <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+	go func() {
+		defer func() { <-p.workers }()
+		handle()
+	}()
+	return true
+}
+
 // retrieveServiceNameByDevice validates the existence of device and device service,
 // returns the service name to which the command request will be sent.
 func retrieveServiceNameByDevice(deviceName string, dic *di.Container) (string, error) {
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return "", errors.New("nil Device Client")
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	device, err := application.DeviceByName(context.Background(), deviceName, dic)
 	if err != nil {
 		return "", fmt.Errorf("failed to get Device by name %s: %v", deviceName, err)
 	}
-
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return "", errors.New("nil DeviceService Client")
-	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := application.DeviceServiceByName(context.Background(), device.ServiceName, dic)
 	if err != nil {
-		return "", fmt.Errorf("failed to get DeviceService by name %s: %v", deviceResponse.Device.ServiceName, err)
+		return "", fmt.Errorf("failed to get DeviceService by name %s: %v", device.ServiceName, err)
 	}
-	return deviceServiceResponse.Service.Name, nil
+	return deviceService.Name, nil
 }
 
 // validateGetCommandQueryParameters validates the value is valid for device service's reserved query parameters
@@ -64,6 +84,82 @@ func validateGetCommandQueryParameters(queryParams map[string]string) error {
 	return nil
 }
 
+// validateSetCommandRequest validates the settings in the payload of the set command request against the device profile
+func validateSetCommandRequest(requestEnvelope types.MessageEnvelope, deviceName string, commandName string, dic *di.Container) error {
+	settings, err := types.GetMsgPayload[map[string]any](requestEnvelope)
+	if err != nil {
+		return fmt.Errorf("failed to decode the settings of the set command: %s", err.Error())
+	}
+	device, edgexErr := application.DeviceByName(context.Background(), deviceName, dic)
+	if edgexErr != nil {
+		return fmt.Errorf("failed to get Device by name %s: %v", deviceName, edgexErr)
+	}
+	if edgexErr = application.ValidateSetCommand(context.Background(), device, commandName, settings, dic); edgexErr != nil {
+		return edgexErr
+	}
+	return nil
+}
+
+// acquireCommandRequest waits for the turn of the command request according to the command policies, and returns the
+// function to be called once the response is received
+func acquireCommandRequest(deviceName string, commandName string, method string, dic *di.Container) (func(), error) {
+	device, edgexErr := application.DeviceByName(context.Background(), deviceName, dic)
+	if edgexErr != nil {
+		return nil, fmt.Errorf("failed to get Device by name %s: %v", deviceName, edgexErr)
+	}
+	release, edgexErr := application.AcquireCommand(context.Background(), device, commandName, strings.EqualFold(method, "set"), dic)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+	return release, nil
+}
+
+// commandRequestCaller removes the caller query parameter from the command request and returns it, so that the caller
+// stated by the requester is recorded in the command history instead of being passed to the device service
+func commandRequestCaller(requestEnvelope *types.MessageEnvelope) string {
+	caller := requestEnvelope.QueryParams[constants.Caller]
+	delete(requestEnvelope.QueryParams, constants.Caller)
+	return caller
+}
+
+// recordCommandRequest records the command request received from the source in the command history, the outcome is
+// determined by the error or the response MessageEnvelope of the device service
+func recordCommandRequest(requestEnvelope types.MessageEnvelope, caller, deviceName, commandName, method, source string, start time.Time, response *types.MessageEnvelope, err error, dic *di.Container) {
+	record := models.CommandRecord{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        strings.ToLower(method),
+		Caller:        caller,
+		Source:        source,
+		CorrelationId: requestEnvelope.CorrelationID,
+		StatusCode:    http.StatusOK,
+		Latency:       time.Since(start).Milliseconds(),
+		Created:       start.UnixMilli(),
+	}
+	queryParams := url.Values{}
+	for k, v := range requestEnvelope.QueryParams {
+		queryParams.Set(k, v)
+	}
+	record.QueryParams = queryParams.Encode()
+	if strings.EqualFold(method, "set") {
+		// the settings which can't be decoded are recorded as empty
+		record.Settings, _ = types.GetMsgPayload[map[string]any](requestEnvelope)
+	}
+	switch {
+	case err != nil:
+		record.StatusCode, record.Message = application.CommandErrorCode(err), err.Error()
+	case response != nil && response.ErrorCode != 0:
+		record.StatusCode = http.StatusInternalServerError
+		switch payload := response.Payload.(type) {
+		case string:
+			record.Message = payload
+		case []byte:
+			record.Message = string(payload)
+		}
+	}
+	application.RecordCommand(context.Background(), record, dic)
+}
+
 // getCommandQueryResponseEnvelope returns the MessageEnvelope containing the DeviceCoreCommand payload bytes
 func getCommandQueryResponseEnvelope(requestEnvelope types.MessageEnvelope, deviceName string, dic *di.Container) (types.MessageEnvelope, error) {
 	var commandsResponse any
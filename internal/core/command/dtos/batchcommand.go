@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+// DeviceSelector defines the devices targeted by a batch command. The explicit DeviceNames take precedence, otherwise the
+// devices having all the Labels and using the ProfileName are selected.
+type DeviceSelector struct {
+	DeviceNames []string `json:"deviceNames,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	Labels      []string `json:"labels,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	ProfileName string   `json:"profileName,omitempty"`
+}
+
+// IsEmpty checks whether the selector selects no device at all
+func (s DeviceSelector) IsEmpty() bool {
+	return len(s.DeviceNames) == 0 && len(s.Labels) == 0 && s.ProfileName == ""
+}
+
+// BatchCommand defines the get or set command issued to every selected device
+type BatchCommand struct {
+	CommandName string            `json:"commandName" validate:"required,edgex-dto-none-empty-string"`
+	Method      string            `json:"method" validate:"required,oneof='get' 'set'"`
+	Selector    DeviceSelector    `json:"selector"`
+	QueryParams map[string]string `json:"queryParams,omitempty"`
+	Settings    map[string]any    `json:"settings,omitempty"`
+}
+
+// DeviceCommandResult defines the result of the batch command issued to a device, the Event is only returned by a get
+// command
+type DeviceCommandResult struct {
+	DeviceName string      `json:"deviceName"`
+	StatusCode int         `json:"statusCode"`
+	Message    string      `json:"message,omitempty"`
+	Event      *dtos.Event `json:"event,omitempty"`
+}
+
+// BatchCommandResult defines the per-device results of a batch command in the order of the selected devices
+type BatchCommandResult struct {
+	Succeeded int                   `json:"succeeded"`
+	Failed    int                   `json:"failed"`
+	Results   []DeviceCommandResult `json:"results"`
+}
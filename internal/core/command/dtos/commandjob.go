@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// CommandJob defines a command issued to the device in the background and its outcome
+type CommandJob struct {
+	Id            string          `json:"id"`
+	DeviceName    string          `json:"deviceName"`
+	CommandName   string          `json:"commandName"`
+	Method        string          `json:"method"`
+	QueryParams   string          `json:"queryParams,omitempty"`
+	Settings      map[string]any  `json:"settings,omitempty"`
+	CallbackUrl   string          `json:"callbackUrl,omitempty"`
+	CallbackTopic string          `json:"callbackTopic,omitempty"`
+	Status        string          `json:"status"`
+	StatusCode    int             `json:"statusCode,omitempty"`
+	Message       string          `json:"message,omitempty"`
+	Response      json.RawMessage `json:"response,omitempty"`
+	Created       int64           `json:"created"`
+	Modified      int64           `json:"modified"`
+}
+
+// FromCommandJobModelToDTO transforms the CommandJob Model to the CommandJob DTO
+func FromCommandJobModelToDTO(job models.CommandJob) CommandJob {
+	return CommandJob{
+		Id:            job.Id,
+		DeviceName:    job.DeviceName,
+		CommandName:   job.CommandName,
+		Method:        job.Method,
+		QueryParams:   job.QueryParams,
+		Settings:      job.Settings,
+		CallbackUrl:   job.CallbackUrl,
+		CallbackTopic: job.CallbackTopic,
+		Status:        job.Status,
+		StatusCode:    job.StatusCode,
+		Message:       job.Message,
+		Response:      job.Response,
+		Created:       job.Created,
+		Modified:      job.Modified,
+	}
+}
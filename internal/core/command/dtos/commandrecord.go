@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// CommandRecord defines the audit record of a command issued to the device
+type CommandRecord struct {
+	Id            string         `json:"id"`
+	DeviceName    string         `json:"deviceName"`
+	CommandName   string         `json:"commandName"`
+	Method        string         `json:"method"`
+	QueryParams   string         `json:"queryParams,omitempty"`
+	Settings      map[string]any `json:"settings,omitempty"`
+	Caller        string         `json:"caller,omitempty"`
+	Source        string         `json:"source"`
+	CorrelationId string         `json:"correlationId,omitempty"`
+	Outcome       string         `json:"outcome"`
+	StatusCode    int            `json:"statusCode"`
+	Message       string         `json:"message,omitempty"`
+	Latency       int64          `json:"latency"`
+	Created       int64          `json:"created"`
+}
+
+// FromCommandRecordModelToDTO transforms the CommandRecord Model to the CommandRecord DTO
+func FromCommandRecordModelToDTO(record models.CommandRecord) CommandRecord {
+	return CommandRecord{
+		Id:            record.Id,
+		DeviceName:    record.DeviceName,
+		CommandName:   record.CommandName,
+		Method:        record.Method,
+		QueryParams:   record.QueryParams,
+		Settings:      record.Settings,
+		Caller:        record.Caller,
+		Source:        record.Source,
+		CorrelationId: record.CorrelationId,
+		Outcome:       record.Outcome,
+		StatusCode:    record.StatusCode,
+		Message:       record.Message,
+		Latency:       record.Latency,
+		Created:       record.Created,
+	}
+}
@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// BatchCommandRequest defines the Request Content for POST batch command
+type BatchCommandRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Command               dtos.BatchCommand `json:"command"`
+}
+
+// Validate satisfies the Validator interface
+func (r BatchCommandRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid BatchCommandRequest", err)
+	}
+	if r.Command.Selector.IsEmpty() {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the selector should specify the deviceNames, labels or profileName", nil)
+	}
+	if r.Command.Method == constants.CommandMethodSet && len(r.Command.Settings) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the settings of the set command should not be empty", nil)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the BatchCommandRequest type
+func (r *BatchCommandRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Command dtos.BatchCommand
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = BatchCommandRequest(alias)
+
+	// validate BatchCommandRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// BatchCommandResponse defines the Response Content for POST batch command
+type BatchCommandResponse struct {
+	common.BaseResponse `json:",inline"`
+	Result              dtos.BatchCommandResult `json:"result"`
+}
+
+func NewBatchCommandResponse(requestId string, message string, statusCode int, result dtos.BatchCommandResult) BatchCommandResponse {
+	return BatchCommandResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Result:       result,
+	}
+}
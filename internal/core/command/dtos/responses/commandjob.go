@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// CommandJobResponse defines the Response Content for GET CommandJob DTO.
+type CommandJobResponse struct {
+	common.BaseResponse `json:",inline"`
+	Job                 dtos.CommandJob `json:"job"`
+}
+
+func NewCommandJobResponse(requestId string, message string, statusCode int, job dtos.CommandJob) CommandJobResponse {
+	return CommandJobResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Job:          job,
+	}
+}
+
+// MultiCommandJobsResponse defines the Response Content for GET multiple CommandJob DTOs.
+type MultiCommandJobsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Jobs                              []dtos.CommandJob `json:"jobs"`
+}
+
+func NewMultiCommandJobsResponse(requestId string, message string, statusCode int, totalCount uint32, jobs []dtos.CommandJob) MultiCommandJobsResponse {
+	return MultiCommandJobsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Jobs:                       jobs,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// MultiCommandRecordsResponse defines the Response Content for GET multiple CommandRecord DTOs.
+type MultiCommandRecordsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Records                           []dtos.CommandRecord `json:"records"`
+}
+
+func NewMultiCommandRecordsResponse(requestId string, message string, statusCode int, totalCount uint32, records []dtos.CommandRecord) MultiCommandRecordsResponse {
+	return MultiCommandRecordsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Records:                    records,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// SettingErrorsResponse defines the Response Content for the set command rejected by the validation of its settings.
+type SettingErrorsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Errors              []dtos.SettingError `json:"errors"`
+}
+
+func NewSettingErrorsResponse(requestId string, message string, statusCode int, settingErrors []dtos.SettingError) SettingErrorsResponse {
+	return SettingErrorsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Errors:       settingErrors,
+	}
+}
@@ -0,0 +1,13 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// SettingError describes why a setting of the set command is rejected by the device profile
+type SettingError struct {
+	ResourceName string `json:"resourceName"`
+	Value        any    `json:"value,omitempty"`
+	Message      string `json:"message"`
+}
@@ -0,0 +1,8 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package embed
+
+const SchemaName = "core_command"
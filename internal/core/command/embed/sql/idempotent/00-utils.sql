@@ -0,0 +1,7 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- schema for core_command related tables
+CREATE SCHEMA IF NOT EXISTS core_command;
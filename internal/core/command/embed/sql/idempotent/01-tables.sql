@@ -0,0 +1,16 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_command.job is used to store the asynchronous command jobs and their outcome
+CREATE TABLE IF NOT EXISTS core_command.job (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- core_command.record is used to store the command history for auditing
+CREATE TABLE IF NOT EXISTS core_command.record (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
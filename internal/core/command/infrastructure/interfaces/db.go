@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+type DBClient interface {
+	CloseSession()
+
+	AddCommandJob(ctx context.Context, job models.CommandJob) (models.CommandJob, errors.EdgeX)
+	UpdateCommandJob(ctx context.Context, job models.CommandJob) errors.EdgeX
+	CommandJobById(ctx context.Context, id string) (models.CommandJob, errors.EdgeX)
+	AllCommandJobs(ctx context.Context, offset, limit int) ([]models.CommandJob, errors.EdgeX)
+	CommandJobsByStatus(ctx context.Context, status string, offset, limit int) ([]models.CommandJob, errors.EdgeX)
+	CommandJobTotalCount(ctx context.Context) (uint32, errors.EdgeX)
+	CommandJobCountByStatus(ctx context.Context, status string) (uint32, errors.EdgeX)
+	DeleteCommandJobsByAge(ctx context.Context, age int64) errors.EdgeX
+
+	AddCommandRecord(ctx context.Context, record models.CommandRecord) (models.CommandRecord, errors.EdgeX)
+	CommandRecords(ctx context.Context, query models.CommandRecordQuery) ([]models.CommandRecord, errors.EdgeX)
+	CommandRecordCount(ctx context.Context, query models.CommandRecordQuery) (uint32, errors.EdgeX)
+	DeleteCommandRecordsByAge(ctx context.Context, age int64) errors.EdgeX
+}
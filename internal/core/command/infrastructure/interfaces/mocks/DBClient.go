@@ -0,0 +1,373 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// DBClient is an autogenerated mock type for the DBClient type
+type DBClient struct {
+	mock.Mock
+}
+
+// AddCommandJob provides a mock function with given fields: ctx, job
+func (_m *DBClient) AddCommandJob(ctx context.Context, job models.CommandJob) (models.CommandJob, errors.EdgeX) {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCommandJob")
+	}
+
+	var r0 models.CommandJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandJob) (models.CommandJob, errors.EdgeX)); ok {
+		return rf(ctx, job)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandJob) models.CommandJob); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Get(0).(models.CommandJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CommandJob) errors.EdgeX); ok {
+		r1 = rf(ctx, job)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddCommandRecord provides a mock function with given fields: ctx, record
+func (_m *DBClient) AddCommandRecord(ctx context.Context, record models.CommandRecord) (models.CommandRecord, errors.EdgeX) {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCommandRecord")
+	}
+
+	var r0 models.CommandRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandRecord) (models.CommandRecord, errors.EdgeX)); ok {
+		return rf(ctx, record)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandRecord) models.CommandRecord); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Get(0).(models.CommandRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CommandRecord) errors.EdgeX); ok {
+		r1 = rf(ctx, record)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllCommandJobs provides a mock function with given fields: ctx, offset, limit
+func (_m *DBClient) AllCommandJobs(ctx context.Context, offset int, limit int) ([]models.CommandJob, errors.EdgeX) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllCommandJobs")
+	}
+
+	var r0 []models.CommandJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]models.CommandJob, errors.EdgeX)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []models.CommandJob); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommandJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CloseSession provides a mock function with no fields
+func (_m *DBClient) CloseSession() {
+	_m.Called()
+}
+
+// CommandJobById provides a mock function with given fields: ctx, id
+func (_m *DBClient) CommandJobById(ctx context.Context, id string) (models.CommandJob, errors.EdgeX) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandJobById")
+	}
+
+	var r0 models.CommandJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.CommandJob, errors.EdgeX)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.CommandJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.CommandJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandJobCountByStatus provides a mock function with given fields: ctx, status
+func (_m *DBClient) CommandJobCountByStatus(ctx context.Context, status string) (uint32, errors.EdgeX) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandJobCountByStatus")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uint32, errors.EdgeX)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uint32); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandJobTotalCount provides a mock function with given fields: ctx
+func (_m *DBClient) CommandJobTotalCount(ctx context.Context) (uint32, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandJobTotalCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context) (uint32, errors.EdgeX)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint32); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandJobsByStatus provides a mock function with given fields: ctx, status, offset, limit
+func (_m *DBClient) CommandJobsByStatus(ctx context.Context, status string, offset int, limit int) ([]models.CommandJob, errors.EdgeX) {
+	ret := _m.Called(ctx, status, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandJobsByStatus")
+	}
+
+	var r0 []models.CommandJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]models.CommandJob, errors.EdgeX)); ok {
+		return rf(ctx, status, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []models.CommandJob); ok {
+		r0 = rf(ctx, status, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommandJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, status, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandRecordCount provides a mock function with given fields: ctx, query
+func (_m *DBClient) CommandRecordCount(ctx context.Context, query models.CommandRecordQuery) (uint32, errors.EdgeX) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandRecordCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandRecordQuery) (uint32, errors.EdgeX)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandRecordQuery) uint32); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CommandRecordQuery) errors.EdgeX); ok {
+		r1 = rf(ctx, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandRecords provides a mock function with given fields: ctx, query
+func (_m *DBClient) CommandRecords(ctx context.Context, query models.CommandRecordQuery) ([]models.CommandRecord, errors.EdgeX) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommandRecords")
+	}
+
+	var r0 []models.CommandRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandRecordQuery) ([]models.CommandRecord, errors.EdgeX)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandRecordQuery) []models.CommandRecord); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommandRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CommandRecordQuery) errors.EdgeX); ok {
+		r1 = rf(ctx, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteCommandJobsByAge provides a mock function with given fields: ctx, age
+func (_m *DBClient) DeleteCommandJobsByAge(ctx context.Context, age int64) errors.EdgeX {
+	ret := _m.Called(ctx, age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCommandJobsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, int64) errors.EdgeX); ok {
+		r0 = rf(ctx, age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteCommandRecordsByAge provides a mock function with given fields: ctx, age
+func (_m *DBClient) DeleteCommandRecordsByAge(ctx context.Context, age int64) errors.EdgeX {
+	ret := _m.Called(ctx, age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCommandRecordsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, int64) errors.EdgeX); ok {
+		r0 = rf(ctx, age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateCommandJob provides a mock function with given fields: ctx, job
+func (_m *DBClient) UpdateCommandJob(ctx context.Context, job models.CommandJob) errors.EdgeX {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCommandJob")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, models.CommandJob) errors.EdgeX); ok {
+		r0 = rf(ctx, job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDBClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DBClient {
+	mock := &DBClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
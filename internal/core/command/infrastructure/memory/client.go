@@ -0,0 +1,207 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// Client keeps the command jobs and the command records in memory, it is used when they are not persisted to the
+// database so they are lost on restart
+type Client struct {
+	mutex   sync.RWMutex
+	jobs    map[string]models.CommandJob
+	records map[string]models.CommandRecord
+}
+
+// NewClient creates an empty in-memory Client
+func NewClient() *Client {
+	return &Client{
+		jobs:    make(map[string]models.CommandJob),
+		records: make(map[string]models.CommandRecord),
+	}
+}
+
+// CloseSession does nothing since there is no connection to close
+func (c *Client) CloseSession() {}
+
+// AddCommandJob adds a new command job
+func (c *Client) AddCommandJob(_ context.Context, job models.CommandJob) (models.CommandJob, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.jobs[job.Id]; ok {
+		return job, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command job id '%s' already exists", job.Id), nil)
+	}
+	timestamp := time.Now().UnixMilli()
+	job.Created = timestamp
+	job.Modified = timestamp
+	c.jobs[job.Id] = job
+	return job, nil
+}
+
+// UpdateCommandJob updates the command job
+func (c *Client) UpdateCommandJob(_ context.Context, job models.CommandJob) errors.EdgeX {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.jobs[job.Id]; !ok {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("command job id '%s' does not exist", job.Id), nil)
+	}
+	job.Modified = time.Now().UnixMilli()
+	c.jobs[job.Id] = job
+	return nil
+}
+
+// CommandJobById queries the command job by id
+func (c *Client) CommandJobById(_ context.Context, id string) (models.CommandJob, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	job, ok := c.jobs[id]
+	if !ok {
+		return job, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("command job id '%s' does not exist", id), nil)
+	}
+	return job, nil
+}
+
+// AllCommandJobs queries the command jobs in the order of creation with offset and limit
+func (c *Client) AllCommandJobs(_ context.Context, offset, limit int) ([]models.CommandJob, errors.EdgeX) {
+	return c.queryCommandJobs(func(models.CommandJob) bool { return true }, offset, limit), nil
+}
+
+// CommandJobsByStatus queries the command jobs of the status in the order of creation with offset and limit
+func (c *Client) CommandJobsByStatus(_ context.Context, status string, offset, limit int) ([]models.CommandJob, errors.EdgeX) {
+	return c.queryCommandJobs(func(job models.CommandJob) bool { return job.Status == status }, offset, limit), nil
+}
+
+// CommandJobTotalCount returns the total count of the command jobs
+func (c *Client) CommandJobTotalCount(_ context.Context) (uint32, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return uint32(len(c.jobs)), nil
+}
+
+// CommandJobCountByStatus returns the count of the command jobs of the status
+func (c *Client) CommandJobCountByStatus(_ context.Context, status string) (uint32, errors.EdgeX) {
+	return uint32(len(c.queryCommandJobs(func(job models.CommandJob) bool { return job.Status == status }, 0, -1))), nil
+}
+
+// DeleteCommandJobsByAge deletes the command jobs created before the age in milliseconds
+func (c *Client) DeleteCommandJobsByAge(_ context.Context, age int64) errors.EdgeX {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	expired := time.Now().UnixMilli() - age
+	for id, job := range c.jobs {
+		if job.Created < expired {
+			delete(c.jobs, id)
+		}
+	}
+	return nil
+}
+
+// queryCommandJobs returns the command jobs matching the filter in the order of creation, a negative limit returns all
+// the remaining jobs after the offset
+func (c *Client) queryCommandJobs(filter func(models.CommandJob) bool, offset, limit int) []models.CommandJob {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	jobs := make([]models.CommandJob, 0, len(c.jobs))
+	for _, job := range c.jobs {
+		if filter(job) {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].Created == jobs[j].Created {
+			return jobs[i].Id < jobs[j].Id
+		}
+		return jobs[i].Created < jobs[j].Created
+	})
+
+	if offset >= len(jobs) {
+		return []models.CommandJob{}
+	}
+	jobs = jobs[max(offset, 0):]
+	if limit >= 0 && limit < len(jobs) {
+		jobs = jobs[:limit]
+	}
+	return jobs
+}
+
+// AddCommandRecord adds a new command record, the Created time is kept if it is set
+func (c *Client) AddCommandRecord(_ context.Context, record models.CommandRecord) (models.CommandRecord, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if record.Id == "" {
+		record.Id = uuid.NewString()
+	}
+	if _, ok := c.records[record.Id]; ok {
+		return record, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command record id '%s' already exists", record.Id), nil)
+	}
+	if record.Created == 0 {
+		record.Created = time.Now().UnixMilli()
+	}
+	c.records[record.Id] = record
+	return record, nil
+}
+
+// CommandRecords queries the command records matching the query in descending order of creation
+func (c *Client) CommandRecords(_ context.Context, query models.CommandRecordQuery) ([]models.CommandRecord, errors.EdgeX) {
+	records := c.queryCommandRecords(query)
+	if query.Offset >= len(records) {
+		return []models.CommandRecord{}, nil
+	}
+	records = records[max(query.Offset, 0):]
+	if query.Limit >= 0 && query.Limit < len(records) {
+		records = records[:query.Limit]
+	}
+	return records, nil
+}
+
+// CommandRecordCount returns the count of the command records matching the query, the offset and limit are ignored
+func (c *Client) CommandRecordCount(_ context.Context, query models.CommandRecordQuery) (uint32, errors.EdgeX) {
+	return uint32(len(c.queryCommandRecords(query))), nil
+}
+
+// DeleteCommandRecordsByAge deletes the command records created before the age in milliseconds
+func (c *Client) DeleteCommandRecordsByAge(_ context.Context, age int64) errors.EdgeX {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	expired := time.Now().UnixMilli() - age
+	for id, record := range c.records {
+		if record.Created < expired {
+			delete(c.records, id)
+		}
+	}
+	return nil
+}
+
+// queryCommandRecords returns all the command records matching the query in descending order of creation
+func (c *Client) queryCommandRecords(query models.CommandRecordQuery) []models.CommandRecord {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	records := make([]models.CommandRecord, 0, len(c.records))
+	for _, record := range c.records {
+		if (query.DeviceName != "" && record.DeviceName != query.DeviceName) || (query.Outcome != "" && record.Outcome != query.Outcome) ||
+			record.Created < query.Start || record.Created > query.End {
+			continue
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Created == records[j].Created {
+			return records[i].Id > records[j].Id
+		}
+		return records[i].Created > records[j].Created
+	})
+	return records
+}
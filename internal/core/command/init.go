@@ -1,7 +1,7 @@
 /*******************************************************************************
  * Copyright 2017 Dell Inc.
  * Copyright (c) 2019-2023 Intel Corporation
- * Copyright (C) 2021 IOTech Ltd
+ * Copyright (C) 2021-2025 IOTech Ltd
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
  * in compliance with the License. You may obtain a copy of the License at
@@ -20,7 +20,11 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/limiter"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
@@ -67,5 +71,49 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		},
 	})
 
+	commandLimiter, err := limiter.NewCommandLimiter(config.CommandPolicies)
+	if err != nil {
+		lc.Errorf("Failed to create the command limiter, %v", err)
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.CommandLimiterName: func(get di.Get) interface{} {
+			return commandLimiter
+		},
+	})
+
+	if err := application.RecoverCommandJobs(ctx, dic); err != nil {
+		lc.Errorf("Failed to recover the command jobs interrupted by the restart, %v", err)
+		return false
+	}
+	if err := application.AsyncPurgeCommandJobs(ctx, dic); err != nil {
+		lc.Errorf("Failed to purge the command jobs periodically, %v", err)
+		return false
+	}
+	if err := application.AsyncPurgeCommandHistory(ctx, dic); err != nil {
+		lc.Errorf("Failed to purge the command history periodically, %v", err)
+		return false
+	}
+
+	if config.MetadataCache.Enabled {
+		metadataCache := cache.NewMetadataCache()
+		if metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get); metricsManager != nil {
+			metadataCache.RegisterMetrics(metricsManager, lc)
+		}
+		dic.Update(di.ServiceConstructorMap{
+			container.MetadataCacheName: func(get di.Get) interface{} {
+				return metadataCache
+			},
+		})
+		// subscribe the system events before loading the cache so that no metadata change is missed in between
+		if err := messaging.SubscribeMetadataSystemEvents(ctx, dic); err != nil {
+			lc.Errorf("Failed to subscribe core-metadata system events from internal message bus, %v", err)
+			return false
+		}
+		if err := application.WarmMetadataCache(ctx, dic); err != nil {
+			lc.Warnf("Unable to load the metadata cache, the metadata will be cached on demand: %v", err)
+		}
+	}
+
 	return true
 }
@@ -0,0 +1,249 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+// ErrTooManyCommands is wrapped by the errors returned when a command is rejected by the command policy
+var ErrTooManyCommands = stdErrors.New("too many commands")
+
+// minStatesToEvict is the number of the device states kept before the idle states are evicted
+const minStatesToEvict = 1024
+
+// policy is a command policy with its parsed queue timeout
+type policy struct {
+	name         string
+	info         config.CommandPolicyInfo
+	queueTimeout time.Duration
+}
+
+// specificity ranks the policies matching the same command, a policy selecting the device is more specific than the one
+// selecting the device profile, and a policy selecting the command is more specific than the one selecting any command
+func (p policy) specificity() int {
+	score := 0
+	if p.info.DeviceName != "" {
+		score += 4
+	}
+	if p.info.ProfileName != "" {
+		score += 2
+	}
+	if p.info.CommandName != "" {
+		score++
+	}
+	return score
+}
+
+func (p policy) matches(deviceName, profileName, commandName string) bool {
+	return (p.info.DeviceName == "" || p.info.DeviceName == deviceName) &&
+		(p.info.ProfileName == "" || p.info.ProfileName == profileName) &&
+		(p.info.CommandName == "" || p.info.CommandName == commandName)
+}
+
+// deviceState holds the limits of the commands issued to a device under a policy, the nil limits are unlimited
+type deviceState struct {
+	rate     *rate.Limiter
+	inFlight chan struct{}
+	set      chan struct{}
+	// users is the number of the commands acquiring or holding the turn of the state
+	users int
+}
+
+// idle reports whether the state can be evicted without changing the limits, which is true if no command is using the
+// state and its rate limiter is refilled as a newly created state
+func (s *deviceState) idle() bool {
+	return s.users == 0 && (s.rate == nil || s.rate.Tokens() >= float64(s.rate.Burst()))
+}
+
+// CommandLimiter applies the command policies to the commands issued to the devices. Only the most specific policy
+// matching a command is applied, and its limits are accounted for each device, or for each command of the device if the
+// policy selects a command.
+type CommandLimiter struct {
+	mutex    sync.Mutex
+	policies []policy
+	states   map[string]*deviceState
+	// evictAt is the number of the states at which the idle states are evicted
+	evictAt int
+}
+
+// NewCommandLimiter creates the CommandLimiter applying the command policies keyed by the policy name
+func NewCommandLimiter(policies map[string]config.CommandPolicyInfo) (*CommandLimiter, errors.EdgeX) {
+	l := &CommandLimiter{
+		policies: make([]policy, 0, len(policies)),
+		states:   make(map[string]*deviceState),
+		evictAt:  minStatesToEvict,
+	}
+	for name, info := range policies {
+		p := policy{name: name, info: info}
+		if info.MaxRequestsPerSecond < 0 || info.MaxInFlight < 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command policy %s: MaxRequestsPerSecond and MaxInFlight should not be negative", name), nil)
+		}
+		if info.QueueTimeout != "" {
+			timeout, err := time.ParseDuration(info.QueueTimeout)
+			if err != nil || timeout < 0 {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command policy %s: invalid QueueTimeout '%s'", name, info.QueueTimeout), err)
+			}
+			p.queueTimeout = timeout
+		}
+		l.policies = append(l.policies, p)
+	}
+	// the most specific policy comes first, the policies of the same specificity are ordered by name
+	slices.SortFunc(l.policies, func(a, b policy) int {
+		if a.specificity() != b.specificity() {
+			return b.specificity() - a.specificity()
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	return l, nil
+}
+
+// Acquire waits for the turn of the command according to the policy matching the command, and returns the function
+// releasing the turn once the command is completed. The command waits up to the QueueTimeout of the policy and is
+// rejected with an error wrapping ErrTooManyCommands if its turn doesn't come in time.
+func (l *CommandLimiter) Acquire(ctx context.Context, deviceName, profileName, commandName string, isSet bool) (release func(), err errors.EdgeX) {
+	p, found := l.policyFor(deviceName, profileName, commandName)
+	if !found {
+		return func() {}, nil
+	}
+	state := l.stateFor(p, deviceName, commandName)
+
+	if p.queueTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.queueTimeout)
+		defer cancel()
+	}
+
+	var releases []chan struct{}
+	release = func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			<-releases[i]
+		}
+		l.releaseState(state)
+	}
+	// the set commands are serialized before taking the in-flight slot, so that a queued set doesn't hold a slot the
+	// get commands could use meanwhile
+	slots := []chan struct{}{state.inFlight}
+	if isSet {
+		slots = []chan struct{}{state.set, state.inFlight}
+	}
+	for _, slot := range slots {
+		if slot == nil {
+			continue
+		}
+		if !l.take(ctx, slot, p.queueTimeout > 0) {
+			release()
+			return nil, tooManyCommandsError(p, deviceName, commandName)
+		}
+		releases = append(releases, slot)
+	}
+	if state.rate != nil {
+		if p.queueTimeout > 0 {
+			err := state.rate.Wait(ctx)
+			if err != nil {
+				release()
+				return nil, tooManyCommandsError(p, deviceName, commandName)
+			}
+		} else if !state.rate.Allow() {
+			release()
+			return nil, tooManyCommandsError(p, deviceName, commandName)
+		}
+	}
+	return release, nil
+}
+
+// take takes the slot, and waits for it until the ctx is done if wait is true
+func (l *CommandLimiter) take(ctx context.Context, slot chan struct{}, wait bool) bool {
+	if !wait {
+		select {
+		case slot <- struct{}{}:
+			return true
+		default:
+			return false
+		}
+	}
+	select {
+	case slot <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (l *CommandLimiter) policyFor(deviceName, profileName, commandName string) (policy, bool) {
+	for _, p := range l.policies {
+		if p.matches(deviceName, profileName, commandName) {
+			return p, true
+		}
+	}
+	return policy{}, false
+}
+
+// stateFor returns the state of the device under the policy, which is used by the command until releaseState is called
+func (l *CommandLimiter) stateFor(p policy, deviceName, commandName string) *deviceState {
+	key := p.name + "/" + deviceName
+	if p.info.CommandName != "" {
+		key += "/" + commandName
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	state, ok := l.states[key]
+	if ok {
+		state.users++
+		return state
+	}
+	if len(l.states) >= l.evictAt {
+		l.evictIdleStates()
+	}
+	state = &deviceState{users: 1}
+	if p.info.MaxRequestsPerSecond > 0 {
+		burst := max(1, int(math.Floor(p.info.MaxRequestsPerSecond)))
+		state.rate = rate.NewLimiter(rate.Limit(p.info.MaxRequestsPerSecond), burst)
+	}
+	if p.info.MaxInFlight > 0 {
+		state.inFlight = make(chan struct{}, p.info.MaxInFlight)
+	}
+	if p.info.SerializeSet {
+		state.set = make(chan struct{}, 1)
+	}
+	l.states[key] = state
+	return state
+}
+
+func (l *CommandLimiter) releaseState(state *deviceState) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	state.users--
+}
+
+// evictIdleStates evicts the idle states of the devices no longer commanded, so that the states don't grow with every
+// device ever commanded. The next eviction happens once the remaining states are doubled, so the cost of the evictions
+// is spread over the states created in between. The caller must hold the mutex.
+func (l *CommandLimiter) evictIdleStates() {
+	for key, state := range l.states {
+		if state.idle() {
+			delete(l.states, key)
+		}
+	}
+	l.evictAt = max(minStatesToEvict, 2*len(l.states))
+}
+
+func tooManyCommandsError(p policy, deviceName, commandName string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("command %s of device %s is rejected by the command policy %s", commandName, deviceName, p.name), ErrTooManyCommands)
+}
@@ -0,0 +1,184 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter
+
+import (
+	"context"
+	stdErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+const (
+	testDeviceName  = "plc1"
+	testProfileName = "plc"
+	testCommandName = "setpoint"
+)
+
+func TestNewCommandLimiter(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      config.CommandPolicyInfo
+		expectedErr bool
+	}{
+		{"Valid", config.CommandPolicyInfo{MaxInFlight: 1, QueueTimeout: "1s"}, false},
+		{"Invalid - negative MaxInFlight", config.CommandPolicyInfo{MaxInFlight: -1}, true},
+		{"Invalid - negative MaxRequestsPerSecond", config.CommandPolicyInfo{MaxRequestsPerSecond: -1}, true},
+		{"Invalid - QueueTimeout", config.CommandPolicyInfo{QueueTimeout: "soon"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewCommandLimiter(map[string]config.CommandPolicyInfo{"policy": testCase.policy})
+			if testCase.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandLimiter_MostSpecificPolicy(t *testing.T) {
+	l, err := NewCommandLimiter(map[string]config.CommandPolicyInfo{
+		"profile": {ProfileName: testProfileName, MaxInFlight: 1},
+		"device":  {DeviceName: testDeviceName, MaxInFlight: 2},
+	})
+	require.NoError(t, err)
+
+	// the device policy allows two commands to plc1 at the same time
+	release1, err := l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.NoError(t, err)
+	release2, err := l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.NoError(t, err)
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.Error(t, err)
+	assert.True(t, stdErrors.Is(err, ErrTooManyCommands))
+
+	// the profile policy applies to the other devices, each device has its own limit
+	release3, err := l.Acquire(context.Background(), "plc2", testProfileName, testCommandName, false)
+	require.NoError(t, err)
+	_, err = l.Acquire(context.Background(), "plc2", testProfileName, testCommandName, false)
+	require.Error(t, err)
+	release4, err := l.Acquire(context.Background(), "plc3", testProfileName, testCommandName, false)
+	require.NoError(t, err)
+
+	// the devices of the other profiles are unlimited
+	release5, err := l.Acquire(context.Background(), "sensor", "sensor", testCommandName, false)
+	require.NoError(t, err)
+
+	for _, release := range []func(){release1, release2, release3, release4, release5} {
+		release()
+	}
+	release1, err = l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.NoError(t, err)
+	release1()
+}
+
+func TestCommandLimiter_SerializeSet(t *testing.T) {
+	l, err := NewCommandLimiter(map[string]config.CommandPolicyInfo{
+		"plc": {ProfileName: testProfileName, SerializeSet: true},
+	})
+	require.NoError(t, err)
+
+	release, err := l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, true)
+	require.NoError(t, err)
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName, "mode", true)
+	require.Error(t, err, "the set commands to the same device should be serialized")
+	releaseGet, err := l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.NoError(t, err, "the get commands should not be serialized")
+	releaseGet()
+	releaseOther, err := l.Acquire(context.Background(), "plc2", testProfileName, testCommandName, true)
+	require.NoError(t, err, "the set commands to the other devices should not be serialized")
+	releaseOther()
+
+	release()
+	release, err = l.Acquire(context.Background(), testDeviceName, testProfileName, "mode", true)
+	require.NoError(t, err)
+	release()
+}
+
+func TestCommandLimiter_QueueTimeout(t *testing.T) {
+	l, err := NewCommandLimiter(map[string]config.CommandPolicyInfo{
+		"plc": {DeviceName: testDeviceName, MaxInFlight: 1, QueueTimeout: "200ms"},
+	})
+	require.NoError(t, err)
+
+	release, err := l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.NoError(t, err)
+
+	// the queued command gets its turn once the command in flight is released
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		release()
+	}()
+	release, err = l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.NoError(t, err)
+
+	// the queued command is rejected if its turn doesn't come in time
+	start := time.Now()
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.Error(t, err)
+	assert.True(t, stdErrors.Is(err, ErrTooManyCommands))
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	release()
+}
+
+func TestCommandLimiter_MaxRequestsPerSecond(t *testing.T) {
+	l, err := NewCommandLimiter(map[string]config.CommandPolicyInfo{
+		"setpoint": {CommandName: testCommandName, MaxRequestsPerSecond: 1},
+	})
+	require.NoError(t, err)
+
+	release, err := l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, true)
+	require.NoError(t, err)
+	release()
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, true)
+	require.Error(t, err)
+	assert.True(t, stdErrors.Is(err, ErrTooManyCommands))
+
+	// the policy limits each device separately, and the other commands are unlimited
+	release, err = l.Acquire(context.Background(), "plc2", testProfileName, testCommandName, true)
+	require.NoError(t, err)
+	release()
+	release, err = l.Acquire(context.Background(), testDeviceName, testProfileName, "mode", true)
+	require.NoError(t, err)
+	release()
+}
+
+func TestCommandLimiter_EvictIdleStates(t *testing.T) {
+	l, err := NewCommandLimiter(map[string]config.CommandPolicyInfo{
+		"device": {MaxInFlight: 1},
+	})
+	require.NoError(t, err)
+	l.evictAt = 2
+
+	// the state of the device holding the turn is kept, the idle one is evicted once the states reach evictAt
+	release, err := l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.NoError(t, err)
+	idleRelease, err := l.Acquire(context.Background(), "plc2", testProfileName, testCommandName, false)
+	require.NoError(t, err)
+	idleRelease()
+	otherRelease, err := l.Acquire(context.Background(), "plc3", testProfileName, testCommandName, false)
+	require.NoError(t, err)
+	defer otherRelease()
+
+	assert.Len(t, l.states, 2)
+	assert.Contains(t, l.states, "device/"+testDeviceName)
+	assert.NotContains(t, l.states, "device/plc2")
+
+	// the kept state still limits the device
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName, testCommandName, false)
+	require.Error(t, err)
+	assert.True(t, stdErrors.Is(err, ErrTooManyCommands))
+	release()
+}
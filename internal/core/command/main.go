@@ -1,6 +1,6 @@
 /*******************************************************************************
  * Copyright 2020 Dell Inc.
- * Copyright 2022-2023 IOTech Ltd.
+ * Copyright 2022-2025 IOTech Ltd.
  * Copyright 2023 Intel Corporation
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
@@ -34,6 +34,10 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/embed"
+	commandInterfaces "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/memory"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
@@ -61,6 +65,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 	})
 
 	httpServer := handlers.NewHttpServer(router, true, common.CoreCommandServiceKey)
+	dbHandler := pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName, embed.SchemaName,
+		common.CoreCommandServiceKey, edgex.Version, embed.SQLFiles)
 
 	bootstrap.Run(
 		ctx,
@@ -74,6 +80,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 		true,
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
+			CommandJobDatabaseBootstrapHandler(dbHandler),
 			handlers.NewClientsBootstrap().BootstrapHandler,
 			MessagingBootstrapHandler,
 			handlers.NewServiceMetrics(common.CoreCommandServiceKey).BootstrapHandler, // Must be after Messaging
@@ -85,6 +92,32 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 	// code here!
 }
 
+// CommandJobDatabaseBootstrapHandler returns the BootstrapHandler which connects the database to store the command jobs
+// and the command history if CommandJob.PersistJobs is true, otherwise they are kept in memory and lost on restart
+func CommandJobDatabaseBootstrapHandler(dbHandler pkgHandlers.Database) interfaces.BootstrapHandler {
+	return func(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+		configuration := container.ConfigurationFrom(dic.Get)
+		if !configuration.CommandJob.PersistJobs {
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return memory.NewClient()
+				},
+			})
+			return true
+		}
+
+		if !dbHandler.BootstrapHandler(ctx, wg, startupTimer, dic) {
+			return false
+		}
+		if _, ok := dic.Get(container.DBClientInterfaceName).(commandInterfaces.DBClient); !ok {
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			lc.Errorf("The %s database doesn't support storing the command jobs and the command history", configuration.Database.Type)
+			return false
+		}
+		return true
+	}
+}
+
 // MessagingBootstrapHandler sets up the MessageBus and External MQTT connections as well as subscriptions
 func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -120,5 +153,10 @@ func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupT
 		return false
 	}
 
+	if err := messaging.SubscribeBatchCommandRequests(ctx, dic); err != nil {
+		lc.Errorf("Failed to subscribe batch command request from internal message bus, %v", err)
+		return false
+	}
+
 	return true
 }
@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import "encoding/json"
+
+// CommandJob represents a command issued to the device in the background and its outcome
+type CommandJob struct {
+	Id          string
+	DeviceName  string
+	CommandName string
+	Method      string
+	// QueryParams is the raw query string passed to the device service
+	QueryParams string
+	Settings    map[string]any
+	// CallbackUrl and CallbackTopic are the REST address and the MessageBus topic the completed job is delivered to
+	CallbackUrl   string
+	CallbackTopic string
+	Status        string
+	StatusCode    int
+	Message       string
+	// Response is the JSON encoded response of the device service, i.e. the EventResponse of a get command or the
+	// BaseResponse of a set command
+	Response json.RawMessage
+	Created  int64
+	Modified int64
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// CommandRecord is the audit record of a command issued to the device
+type CommandRecord struct {
+	Id          string
+	DeviceName  string
+	CommandName string
+	Method      string
+	QueryParams string
+	Settings    map[string]any
+	// Caller is the identity in the JWT of the HTTP request or the caller query parameter of the MessageBus and external
+	// MQTT request, it is taken as stated by the requester and not verified, so it is informational only
+	Caller        string
+	Source        string
+	CorrelationId string
+	Outcome       string
+	StatusCode    int
+	Message       string
+	// Latency is how long the command took in milliseconds
+	Latency int64
+	// Created is the time the command is issued in milliseconds
+	Created int64
+}
+
+// CommandRecordQuery represents a page of the command records sorted in descending order of creation
+type CommandRecordQuery struct {
+	// DeviceName and Outcome narrow the records to query, an empty value matches any device or outcome
+	DeviceName string
+	Outcome    string
+	// Start and End are the inclusive creation time range of the records to query in milliseconds
+	Start  int64
+	End    int64
+	Offset int
+	Limit  int
+}
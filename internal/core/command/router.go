@@ -8,6 +8,7 @@ package command
 
 import (
 	"github.com/edgexfoundry/edgex-go"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	commandController "github.com/edgexfoundry/edgex-go/internal/core/command/controller/http"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/controller"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/handlers"
@@ -29,4 +30,15 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiDeviceByNameRoute, cmd.CommandsByDeviceName, authenticationHook)
 	r.GET(common.ApiDeviceNameCommandNameRoute, cmd.IssueGetCommandByName, authenticationHook)
 	r.PUT(common.ApiDeviceNameCommandNameRoute, cmd.IssueSetCommandByName, authenticationHook)
+	r.POST(constants.ApiBatchCommandRoute, cmd.IssueBatchCommand, authenticationHook)
+
+	// Command Job
+	job := commandController.NewCommandJobController(dic)
+	r.GET(constants.ApiAllCommandJobsRoute, job.AllCommandJobs, authenticationHook)
+	r.GET(constants.ApiCommandJobByIdRoute, job.CommandJobById, authenticationHook)
+
+	// Command History
+	history := commandController.NewCommandHistoryController(dic)
+	r.GET(constants.ApiAllCommandHistoryRoute, history.AllCommandHistory, authenticationHook)
+	r.GET(constants.ApiCommandHistoryByDeviceNameRoute, history.CommandHistoryByDeviceName, authenticationHook)
 }
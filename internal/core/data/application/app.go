@@ -1,5 +1,6 @@
 /*******************************************************************************
  * Copyright 2022 Intel Corp.
+ * Copyright (C) 2025 IOTech Ltd
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
  * in compliance with the License. You may obtain a copy of the License at
@@ -20,6 +21,8 @@ import (
 
 	gometrics "github.com/rcrowley/go-metrics"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -37,6 +40,12 @@ type CoreDataApp struct {
 	lc                       logger.LoggingClient
 	eventsPersistedCounter   gometrics.Counter
 	readingsPersistedCounter gometrics.Counter
+	readingStreamHub         *ReadingStreamHub
+
+	eventValidationFailuresCounter gometrics.Counter
+	eventsPublishDroppedCounter    gometrics.Counter
+	eventPersistLatencyTimer       gometrics.Timer
+	ingestionMetrics               *ingestionMetrics
 }
 
 // NewCoreDataApp create a new initialized Core Data application
@@ -45,9 +54,23 @@ func NewCoreDataApp(dic *di.Container) *CoreDataApp {
 		lc: bootstrapContainer.LoggingClientFrom(dic.Get),
 	}
 
+	configuration := container.ConfigurationFrom(dic.Get)
+	if configuration.ReadingStream.Enabled {
+		hub, err := NewReadingStreamHub(configuration.ReadingStream)
+		if err != nil {
+			app.lc.Errorf("Reading stream will not be available: %v", err)
+		} else {
+			app.readingStreamHub = hub
+		}
+	}
+
 	app.eventsPersistedCounter = gometrics.NewCounter()
 	app.readingsPersistedCounter = gometrics.NewCounter()
+	app.eventValidationFailuresCounter = gometrics.NewCounter()
+	app.eventsPublishDroppedCounter = gometrics.NewCounter()
+	app.eventPersistLatencyTimer = gometrics.NewTimer()
 	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	app.ingestionMetrics = newIngestionMetrics(app.lc, metricsManager)
 	if metricsManager == nil {
 		app.lc.Error("Metric Manager not available. Events and Readings metrics will not be collected.")
 		return app
@@ -63,6 +86,21 @@ func NewCoreDataApp(dic *di.Container) *CoreDataApp {
 	}
 	app.lc.Infof("Registered metrics counter %s", readingsPersistedMetricName)
 
+	if err := metricsManager.Register(eventValidationFailuresMetricName, app.eventValidationFailuresCounter, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", eventValidationFailuresMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics counter %s", eventValidationFailuresMetricName)
+
+	if err := metricsManager.Register(eventsPublishDroppedMetricName, app.eventsPublishDroppedCounter, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", eventsPublishDroppedMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics counter %s", eventsPublishDroppedMetricName)
+
+	if err := metricsManager.Register(eventPersistLatencyMetricName, app.eventPersistLatencyTimer, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", eventPersistLatencyMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics timer %s", eventPersistLatencyMetricName)
+
 	return app
 }
 
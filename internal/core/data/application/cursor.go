@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// EventsByCursor query a page of events in descending order of origin after the cursor of the query, an empty cursor begins from
+// the latest event. The total count is not calculated, and the returned next cursor is empty if there are no more events.
+func (a *CoreDataApp) EventsByCursor(query dataModels.CursorQuery, dic *di.Container) (events []dtos.Event, nextCursor string, err errors.EdgeX) {
+	query, err = decodeCursorQuery(query)
+	if err != nil {
+		return events, "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	eventModels, nextCursor, err := container.DBClientFrom(dic.Get).EventsByCursor(query)
+	if err != nil {
+		return events, "", errors.NewCommonEdgeXWrapper(err)
+	}
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(e)
+	}
+	return events, encodeCursor(nextCursor), nil
+}
+
+// ReadingsByCursor query a page of readings in descending order of origin after the cursor of the query, an empty cursor begins
+// from the latest reading. The total count is not calculated, and the returned next cursor is empty if there are no more readings.
+func ReadingsByCursor(query dataModels.CursorQuery, dic *di.Container) (readings []dtos.BaseReading, nextCursor string, err errors.EdgeX) {
+	query, err = decodeCursorQuery(query)
+	if err != nil {
+		return readings, "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	readingModels, nextCursor, err := container.DBClientFrom(dic.Get).ReadingsByCursor(query)
+	if err != nil {
+		return readings, "", errors.NewCommonEdgeXWrapper(err)
+	}
+	readings, err = convertReadingModelsToDTOs(readingModels)
+	return readings, encodeCursor(nextCursor), err
+}
+
+// decodeCursorQuery validates the limit of the query and decodes the opaque cursor token to the database specific cursor
+func decodeCursorQuery(query dataModels.CursorQuery) (dataModels.CursorQuery, errors.EdgeX) {
+	if query.Limit <= 0 {
+		return query, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("limit %d should be greater than zero for the cursor pagination", query.Limit), nil)
+	}
+	cursor, err := base64.RawURLEncoding.DecodeString(query.Cursor)
+	if err != nil {
+		return query, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cursor '%s'", query.Cursor), err)
+	}
+	query.Cursor = string(cursor)
+	return query, nil
+}
+
+// encodeCursor encodes the database specific cursor to an opaque cursor token which is safe in the URL query string
+func encodeCursor(cursor string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
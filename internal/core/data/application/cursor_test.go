@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestReadingsByCursor(t *testing.T) {
+	readings := buildReadings()
+	firstPage := dataModels.CursorQuery{DeviceName: testDeviceName, Start: 0, End: 100, Limit: 2}
+	secondPage := firstPage
+	secondPage.Cursor = "10_reading2"
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByCursor", firstPage).Return(readings[:2], secondPage.Cursor, nil)
+	dbClientMock.On("ReadingsByCursor", secondPage).Return(readings[2:], "", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	result, nextCursor, err := ReadingsByCursor(firstPage, dic)
+	require.NoError(t, err)
+	assert.Len(t, result, 2, "Readings not as expected")
+	require.NotEmpty(t, nextCursor, "Next cursor should be returned when there are more readings")
+	assert.NotEqual(t, secondPage.Cursor, nextCursor, "Next cursor should be opaque")
+
+	query := firstPage
+	query.Cursor = nextCursor
+	result, nextCursor, err = ReadingsByCursor(query, dic)
+	require.NoError(t, err)
+	assert.Len(t, result, len(readings)-2, "Readings not as expected")
+	assert.Empty(t, nextCursor, "Next cursor should be empty when there are no more readings")
+
+	tests := []struct {
+		name  string
+		query dataModels.CursorQuery
+	}{
+		{"Invalid - zero limit", dataModels.CursorQuery{Limit: 0}},
+		{"Invalid - malformed cursor", dataModels.CursorQuery{Cursor: "!invalid", Limit: 2}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, _, err := ReadingsByCursor(testCase.query, dic)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err), "Error kind not as expected")
+		})
+	}
+}
+
+func TestEventsByCursor(t *testing.T) {
+	query := dataModels.CursorQuery{Start: 0, End: 100, Limit: 1}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsByCursor", query).Return([]models.Event{persistedEvent}, "", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	app := NewCoreDataApp(dic)
+
+	events, nextCursor, err := app.EventsByCursor(query, dic)
+	require.NoError(t, err)
+	require.Len(t, events, 1, "Events not as expected")
+	assert.Equal(t, persistedEvent.Id, events[0].Id, "Event not as expected")
+	assert.Empty(t, nextCursor, "Next cursor should be empty when there are no more events")
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	msgTypes "github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
@@ -32,16 +33,18 @@ const CoreDataEventTopicPrefix = "core"
 // ValidateEvent validates if e is a valid event with corresponding device profile name and device name and source name
 // ValidateEvent throws error when profileName or deviceName doesn't match to e
 func (a *CoreDataApp) ValidateEvent(e models.Event, profileName string, deviceName string, sourceName string, _ context.Context, _ *di.Container) errors.EdgeX {
+	var err errors.EdgeX
 	if e.ProfileName != profileName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's profileName %s mismatches %s", e.ProfileName, profileName), nil)
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's profileName %s mismatches %s", e.ProfileName, profileName), nil)
+	} else if e.DeviceName != deviceName {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's deviceName %s mismatches %s", e.DeviceName, deviceName), nil)
+	} else if e.SourceName != sourceName {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's sourceName %s mismatches %s", e.SourceName, sourceName), nil)
 	}
-	if e.DeviceName != deviceName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's deviceName %s mismatches %s", e.DeviceName, deviceName), nil)
-	}
-	if e.SourceName != sourceName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's sourceName %s mismatches %s", e.SourceName, sourceName), nil)
+	if err != nil {
+		a.EventValidationFailed()
 	}
-	return nil
+	return err
 }
 
 // The AddEvent function accepts the new event model from the controller functions
@@ -57,7 +60,9 @@ func (a *CoreDataApp) AddEvent(e models.Event, ctx context.Context, dic *di.Cont
 	// Add the event and readings to the database
 	if configuration.Writable.PersistData {
 		correlationId := correlation.FromContext(ctx)
+		start := time.Now()
 		addedEvent, err := dbClient.AddEvent(e)
+		a.eventPersistLatencyTimer.UpdateSince(start)
 		if err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		}
@@ -71,11 +76,49 @@ func (a *CoreDataApp) AddEvent(e models.Event, ctx context.Context, dic *di.Cont
 
 		a.eventsPersistedCounter.Inc(1)
 		a.readingsPersistedCounter.Inc(int64(len(addedEvent.Readings)))
+		a.ingestionMetrics.eventsPersisted([]models.Event{addedEvent})
 	}
 
 	return nil
 }
 
+// AddEvents persists the events in batch, and falls back to persisting the events one by one when the batch fails so that
+// an invalid event won't prevent the others from being persisted. The batch is written in a single transaction, so none of
+// the events is persisted when it fails and no event is retried after being stored. The returned errors correspond to the
+// events by index, and a nil error means the event is persisted.
+func (a *CoreDataApp) AddEvents(events []models.Event, ctx context.Context, dic *di.Container) []errors.EdgeX {
+	errs := make([]errors.EdgeX, len(events))
+	configuration := container.ConfigurationFrom(dic.Get)
+	if !configuration.Writable.PersistData || len(events) == 0 {
+		return errs
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+	start := time.Now()
+	addedEvents, err := dbClient.AddEvents(events)
+	a.eventPersistLatencyTimer.UpdateSince(start)
+	if err == nil {
+		a.lc.Debugf("%d events created on DB successfully. Correlation-id: %s ", len(addedEvents), correlationId)
+		a.eventsPersistedCounter.Inc(int64(len(addedEvents)))
+		for _, e := range addedEvents {
+			a.readingsPersistedCounter.Inc(int64(len(e.Readings)))
+		}
+		a.ingestionMetrics.eventsPersisted(addedEvents)
+		return errs
+	}
+	if len(events) == 1 {
+		errs[0] = errors.NewCommonEdgeXWrapper(err)
+		return errs
+	}
+
+	a.lc.Warnf("failed to persist %d events in batch, persist the events one by one instead. Correlation-id: %s, err: %v", len(events), correlationId, err)
+	for i, e := range events {
+		errs[i] = a.AddEvent(e, ctx, dic)
+	}
+	return errs
+}
+
 // PublishEvent publishes incoming AddEventRequest in the format of []byte through MessageClient
 func (a *CoreDataApp) PublishEvent(data requestDTO.AddEventRequest, serviceName string, profileName string, deviceName string, sourceName string, ctx context.Context, dic *di.Container) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -91,6 +134,7 @@ func (a *CoreDataApp) PublishEvent(data requestDTO.AddEventRequest, serviceName
 	msgEnvelope := msgTypes.NewMessageEnvelope(data, ctx)
 	err := msgClient.PublishWithSizeLimit(msgEnvelope, publishTopic, configuration.MaxEventSize)
 	if err != nil {
+		a.eventsPublishDroppedCounter.Inc(1)
 		lc.Errorf("Unable to send message for API event. Correlation-id: %s, Profile Name: %s, "+
 			"Device Name: %s, Source Name: %s, Error: %v", correlationId, profileName, deviceName, sourceName, err)
 	} else {
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2021 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -235,6 +235,73 @@ func TestAddEvent(t *testing.T) {
 	}
 }
 
+func TestAddEvents(t *testing.T) {
+	validEvent := persistedEvent
+	invalidEvent := persistedEvent
+	invalidEvent.Id = nonexistentEventID
+	events := []models.Event{validEvent, invalidEvent}
+	batchErr := errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to insert events in batch", nil)
+
+	tests := []struct {
+		Name           string
+		Persistence    bool
+		batchFailed    bool
+		expectedErrors []bool
+	}{
+		{"Valid - Add Events in batch", true, false, []bool{false, false}},
+		{"Valid - Add Events one by one once the batch fails", true, true, []bool{false, true}},
+		{"Valid - Add Events without persistence", false, false, []bool{false, false}},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			if testCase.batchFailed {
+				dbClientMock.On("AddEvents", events).Return(nil, batchErr)
+			} else {
+				dbClientMock.On("AddEvents", events).Return(events, nil)
+			}
+			dbClientMock.On("AddEvent", validEvent).Return(validEvent, nil)
+			dbClientMock.On("AddEvent", invalidEvent).Return(models.Event{}, batchErr)
+
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{
+						Writable: config.WritableInfo{
+							PersistData: testCase.Persistence,
+						},
+					}
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			app := NewCoreDataApp(dic)
+			errs := app.AddEvents(events, context.Background(), dic)
+			require.Len(t, errs, len(events))
+			for i, errorExpected := range testCase.expectedErrors {
+				if errorExpected {
+					assert.Error(t, errs[i])
+				} else {
+					assert.NoError(t, errs[i])
+				}
+			}
+
+			if !testCase.Persistence {
+				dbClientMock.AssertNotCalled(t, "AddEvents", mock.Anything)
+				return
+			}
+			if testCase.batchFailed {
+				dbClientMock.AssertNumberOfCalls(t, "AddEvent", len(events))
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddEvent", mock.Anything)
+			}
+		})
+	}
+}
+
 func TestEventById(t *testing.T) {
 	validEventId := testUUIDString
 	emptyEventId := ""
@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+)
+
+// EventBatcher groups the events into batches bounded by size and time, and persists each batch with a single database write
+type EventBatcher struct {
+	app     *CoreDataApp
+	dic     *di.Container
+	maxSize int
+	maxWait time.Duration
+	events  chan models.Event
+}
+
+// NewEventBatcher creates an EventBatcher with the specified batch configuration
+func NewEventBatcher(app *CoreDataApp, batchInfo config.EventBatchInfo, dic *di.Container) (*EventBatcher, errors.EdgeX) {
+	if batchInfo.MaxSize <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event batch MaxSize '%d' should be greater than zero", batchInfo.MaxSize), nil)
+	}
+	maxWait, err := time.ParseDuration(batchInfo.MaxWait)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "event batch MaxWait parse failed", err)
+	}
+	if maxWait <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event batch MaxWait '%s' should be greater than zero", batchInfo.MaxWait), nil)
+	}
+
+	return &EventBatcher{
+		app:     app,
+		dic:     dic,
+		maxSize: batchInfo.MaxSize,
+		maxWait: maxWait,
+		// buffer the next batch while the current one is being persisted
+		events: make(chan models.Event, batchInfo.MaxSize),
+	}, nil
+}
+
+// Add adds the event into the pending batch, it blocks when the buffer is full until the batch being persisted is done, or
+// drops the event when the ctx is done
+func (b *EventBatcher) Add(ctx context.Context, e models.Event) {
+	select {
+	case b.events <- e:
+	case <-ctx.Done():
+		bootstrapContainer.LoggingClientFrom(b.dic.Get).Warnf("event batcher is stopped, drop the event %s", e.Id)
+	}
+}
+
+// Run groups the added events into batches and persists them until the ctx is done, the pending events are persisted
+// before returning
+func (b *EventBatcher) Run(ctx context.Context) {
+	lc := bootstrapContainer.LoggingClientFrom(b.dic.Get)
+
+	batch := make([]models.Event, 0, b.maxSize)
+	timer := time.NewTimer(b.maxWait)
+	timer.Stop()
+	flush := func() {
+		timer.Stop()
+		if len(batch) == 0 {
+			return
+		}
+		b.persist(batch)
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			// persist the events which have been added but not yet received
+			for len(b.events) > 0 {
+				batch = append(batch, <-b.events)
+			}
+			flush()
+			lc.Info("Exiting event batcher")
+			return
+		case e := <-b.events:
+			batch = append(batch, e)
+			if len(batch) == 1 {
+				// the batch is time-bounded since its first event arrived
+				timer.Reset(b.maxWait)
+			}
+			if len(batch) >= b.maxSize {
+				flush()
+			}
+		case <-timer.C:
+			flush()
+		}
+	}
+}
+
+func (b *EventBatcher) persist(batch []models.Event) {
+	lc := bootstrapContainer.LoggingClientFrom(b.dic.Get)
+	lc.Debugf("Persisting a batch of %d events", len(batch))
+
+	errs := b.app.AddEvents(batch, context.Background(), b.dic)
+	for i, err := range errs {
+		if err != nil {
+			lc.Errorf("fail to persist the event %s, %v", batch[i].Id, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestNewEventBatcher(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	app := NewCoreDataApp(dic)
+
+	tests := []struct {
+		name          string
+		batchInfo     config.EventBatchInfo
+		errorExpected bool
+	}{
+		{"Valid", config.EventBatchInfo{Enabled: true, MaxSize: 100, MaxWait: "100ms"}, false},
+		{"Invalid - zero MaxSize", config.EventBatchInfo{Enabled: true, MaxSize: 0, MaxWait: "100ms"}, true},
+		{"Invalid - MaxWait parse failed", config.EventBatchInfo{Enabled: true, MaxSize: 100, MaxWait: "invalid"}, true},
+		{"Invalid - zero MaxWait", config.EventBatchInfo{Enabled: true, MaxSize: 100, MaxWait: "0s"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			batcher, err := NewEventBatcher(app, testCase.batchInfo, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err), "Error kind not as expected")
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, batcher)
+			}
+		})
+	}
+}
+
+func TestEventBatcherRun(t *testing.T) {
+	event := persistedEvent
+
+	tests := []struct {
+		name               string
+		batchInfo          config.EventBatchInfo
+		eventCount         int
+		expectedBatchSizes []int
+	}{
+		{"Valid - size-bounded batches", config.EventBatchInfo{Enabled: true, MaxSize: 2, MaxWait: "1h"}, 4, []int{2, 2}},
+		{"Valid - time-bounded batch", config.EventBatchInfo{Enabled: true, MaxSize: 10, MaxWait: "10ms"}, 3, []int{3}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			batchSizes := make(chan int, testCase.eventCount)
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddEvents", mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+				batchSizes <- len(args.Get(0).([]models.Event))
+			})
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			batcher, err := NewEventBatcher(NewCoreDataApp(dic), testCase.batchInfo, dic)
+			require.NoError(t, err)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go batcher.Run(ctx)
+
+			for i := 0; i < testCase.eventCount; i++ {
+				batcher.Add(ctx, event)
+			}
+			for _, expected := range testCase.expectedBatchSizes {
+				select {
+				case size := <-batchSizes:
+					assert.Equal(t, expected, size, "Batch size not as expected")
+				case <-time.After(time.Second):
+					require.Fail(t, "timed out waiting for the batch to be persisted")
+				}
+			}
+		})
+	}
+}
+
+func TestEventBatcherRunFlushOnExit(t *testing.T) {
+	persisted := make(chan int, 1)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvents", mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+		persisted <- len(args.Get(0).([]models.Event))
+	})
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	batcher, err := NewEventBatcher(NewCoreDataApp(dic), config.EventBatchInfo{Enabled: true, MaxSize: 10, MaxWait: "1h"}, dic)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	batcher.Add(ctx, persistedEvent)
+	batcher.Add(ctx, persistedEvent)
+	cancel()
+	batcher.Run(ctx)
+
+	require.Len(t, persisted, 1)
+	assert.Equal(t, 2, <-persisted, "the pending events should be persisted before exiting")
+}
@@ -0,0 +1,183 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	gometrics "github.com/rcrowley/go-metrics"
+
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+const (
+	deviceEventsPersistedMetricName   = "DeviceEventsPersisted"
+	deviceReadingsPersistedMetricName = "DeviceReadingsPersisted"
+	serviceEventsReceivedMetricName   = "ServiceEventsReceived"
+	eventValidationFailuresMetricName = "EventValidationFailures"
+	eventPersistLatencyMetricName     = "EventPersistLatency"
+	eventsPublishDroppedMetricName    = "EventsPublishDropped"
+
+	// labelledMetricNameSeparator separates the metric name and the labels in the registered name of a labelled metric,
+	// the reporter matches the registered name to the configured metric name by prefix and reports the labels as tags
+	labelledMetricNameSeparator = "/"
+)
+
+// ingestionMetrics collects the metrics labelled by device, source and device service, and tracks the latest event
+// received from each device. The labelled metrics are registered on demand since the devices are unknown in advance.
+type ingestionMetrics struct {
+	lc             logger.LoggingClient
+	metricsManager bootstrapInterfaces.MetricsManager
+	mutex          sync.Mutex
+	counters       map[string]gometrics.Counter
+	// deviceMetrics holds the registered names of the labelled metrics of each device, so that they can be
+	// unregistered once the device is removed
+	deviceMetrics map[string][]string
+	lastSeen      map[string]dataModels.DeviceLastSeen
+}
+
+func newIngestionMetrics(lc logger.LoggingClient, metricsManager bootstrapInterfaces.MetricsManager) *ingestionMetrics {
+	return &ingestionMetrics{
+		lc:             lc,
+		metricsManager: metricsManager,
+		counters:       make(map[string]gometrics.Counter),
+		deviceMetrics:  make(map[string][]string),
+		lastSeen:       make(map[string]dataModels.DeviceLastSeen),
+	}
+}
+
+// counter returns the counter of the metric with the labels, and registers it to the MetricsManager at the first use.
+// The caller must hold the mutex.
+func (m *ingestionMetrics) counter(metricName string, tags map[string]string, labels ...string) (gometrics.Counter, string) {
+	name := strings.Join(append([]string{metricName}, labels...), labelledMetricNameSeparator)
+	if counter, ok := m.counters[name]; ok {
+		return counter, name
+	}
+
+	counter := gometrics.NewCounter()
+	m.counters[name] = counter
+	if m.metricsManager != nil {
+		if err := m.metricsManager.Register(name, counter, tags); err != nil {
+			m.lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+		}
+	}
+	return counter, name
+}
+
+// deviceCounter returns the counter of the metric labelled by the device and source name
+func (m *ingestionMetrics) deviceCounter(metricName string, deviceName string, sourceName string) gometrics.Counter {
+	tags := map[string]string{common.DeviceName: deviceName, common.SourceName: sourceName}
+	counter, name := m.counter(metricName, tags, deviceName, sourceName)
+	if !slices.Contains(m.deviceMetrics[deviceName], name) {
+		m.deviceMetrics[deviceName] = append(m.deviceMetrics[deviceName], name)
+	}
+	return counter
+}
+
+// eventReceived updates the latest event of the device and counts the event received from the device service
+func (m *ingestionMetrics) eventReceived(serviceName string, e models.Event) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.lastSeen[e.DeviceName] = dataModels.DeviceLastSeen{
+		DeviceName:  e.DeviceName,
+		ServiceName: serviceName,
+		SourceName:  e.SourceName,
+		Origin:      e.Origin,
+		LastSeen:    time.Now().UnixNano(),
+	}
+	if serviceName != "" {
+		counter, _ := m.counter(serviceEventsReceivedMetricName, map[string]string{common.ServiceName: serviceName}, serviceName)
+		counter.Inc(1)
+	}
+}
+
+// eventsPersisted counts the persisted events and readings of each device and source
+func (m *ingestionMetrics) eventsPersisted(events []models.Event) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, e := range events {
+		m.deviceCounter(deviceEventsPersistedMetricName, e.DeviceName, e.SourceName).Inc(1)
+		m.deviceCounter(deviceReadingsPersistedMetricName, e.DeviceName, e.SourceName).Inc(int64(len(e.Readings)))
+	}
+}
+
+// removeDevice unregisters the labelled metrics and removes the latest event of the device
+func (m *ingestionMetrics) removeDevice(deviceName string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, name := range m.deviceMetrics[deviceName] {
+		delete(m.counters, name)
+		if m.metricsManager != nil {
+			m.metricsManager.Unregister(name)
+		}
+	}
+	delete(m.deviceMetrics, deviceName)
+	delete(m.lastSeen, deviceName)
+}
+
+// EventReceived records the event received from the device service, the serviceName is empty if the service is unknown
+func (a *CoreDataApp) EventReceived(serviceName string, e models.Event) {
+	a.ingestionMetrics.eventReceived(serviceName, e)
+}
+
+// EventValidationFailed counts the received event which fails the validation
+func (a *CoreDataApp) EventValidationFailed() {
+	a.eventValidationFailuresCounter.Inc(1)
+}
+
+// RemoveDeviceMetrics removes the labelled metrics and the latest event of the removed device
+func (a *CoreDataApp) RemoveDeviceMetrics(deviceName string) {
+	a.ingestionMetrics.removeDevice(deviceName)
+}
+
+// DeviceLastSeen returns the latest event received from the device since core-data started
+func (a *CoreDataApp) DeviceLastSeen(deviceName string) (dataModels.DeviceLastSeen, errors.EdgeX) {
+	a.ingestionMetrics.mutex.Lock()
+	defer a.ingestionMetrics.mutex.Unlock()
+
+	lastSeen, ok := a.ingestionMetrics.lastSeen[deviceName]
+	if !ok {
+		return lastSeen, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no event received from device %s", deviceName), nil)
+	}
+	return lastSeen, nil
+}
+
+// AllDeviceLastSeen returns the latest events received from the devices sorted by device name with the pagination, and
+// the total count of the devices
+func (a *CoreDataApp) AllDeviceLastSeen(offset int, limit int) ([]dataModels.DeviceLastSeen, uint32, errors.EdgeX) {
+	a.ingestionMetrics.mutex.Lock()
+	all := make([]dataModels.DeviceLastSeen, 0, len(a.ingestionMetrics.lastSeen))
+	for _, lastSeen := range a.ingestionMetrics.lastSeen {
+		all = append(all, lastSeen)
+	}
+	a.ingestionMetrics.mutex.Unlock()
+
+	slices.SortFunc(all, func(x, y dataModels.DeviceLastSeen) int {
+		return strings.Compare(x.DeviceName, y.DeviceName)
+	})
+	totalCount := uint32(len(all))
+	if offset > len(all) {
+		return nil, totalCount, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v offset:%v", len(all), offset), nil)
+	}
+	all = all[offset:]
+	if limit >= 0 && limit < len(all) {
+		all = all[:limit]
+	}
+	return all, totalCount, nil
+}
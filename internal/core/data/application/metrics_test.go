@@ -0,0 +1,90 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/metrics"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceName = "testService"
+
+func TestIngestionMetrics(t *testing.T) {
+	manager := metrics.NewManager(logger.NewMockClient(), time.Minute, nil)
+	m := newIngestionMetrics(logger.NewMockClient(), manager)
+
+	event := persistedEvent
+	m.eventReceived(testServiceName, event)
+	m.eventsPersisted([]models.Event{event, event})
+
+	deviceEventsName := deviceEventsPersistedMetricName + "/" + event.DeviceName + "/" + event.SourceName
+	deviceReadingsName := deviceReadingsPersistedMetricName + "/" + event.DeviceName + "/" + event.SourceName
+	serviceEventsName := serviceEventsReceivedMetricName + "/" + testServiceName
+	require.NotNil(t, manager.GetCounter(deviceEventsName), "the device metric should be registered")
+	assert.Equal(t, int64(2), manager.GetCounter(deviceEventsName).Count(), "Persisted events not as expected")
+	assert.Equal(t, int64(2*len(event.Readings)), manager.GetCounter(deviceReadingsName).Count(), "Persisted readings not as expected")
+	assert.Equal(t, int64(1), manager.GetCounter(serviceEventsName).Count(), "Received events not as expected")
+
+	lastSeen, ok := m.lastSeen[event.DeviceName]
+	require.True(t, ok, "the device should be seen")
+	assert.Equal(t, testServiceName, lastSeen.ServiceName, "Service name not as expected")
+	assert.Equal(t, event.Origin, lastSeen.Origin, "Origin not as expected")
+	assert.NotZero(t, lastSeen.LastSeen, "LastSeen not as expected")
+
+	m.removeDevice(event.DeviceName)
+	assert.False(t, manager.IsRegistered(deviceEventsName), "the device metric should be unregistered")
+	assert.False(t, manager.IsRegistered(deviceReadingsName), "the device metric should be unregistered")
+	assert.True(t, manager.IsRegistered(serviceEventsName), "the service metric should be kept")
+	assert.NotContains(t, m.lastSeen, event.DeviceName, "the device should be removed")
+}
+
+func TestAllDeviceLastSeen(t *testing.T) {
+	app := NewCoreDataApp(mocks.NewMockDIC())
+	for _, deviceName := range []string{"device-c", "device-a", "device-b"} {
+		app.EventReceived(testServiceName, models.Event{DeviceName: deviceName, SourceName: "source"})
+	}
+
+	tests := []struct {
+		name               string
+		offset             int
+		limit              int
+		errorExpected      bool
+		expectedDeviceName []string
+	}{
+		{"Valid - all", 0, -1, false, []string{"device-a", "device-b", "device-c"}},
+		{"Valid - offset and limit", 1, 1, false, []string{"device-b"}},
+		{"Invalid - offset out of range", 4, 1, true, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			lastSeen, totalCount, err := app.AllDeviceLastSeen(testCase.offset, testCase.limit)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindRangeNotSatisfiable, errors.Kind(err), "Error kind not as expected")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uint32(3), totalCount, "Total count not as expected")
+			var deviceNames []string
+			for _, l := range lastSeen {
+				deviceNames = append(deviceNames, l.DeviceName)
+			}
+			assert.Equal(t, testCase.expectedDeviceName, deviceNames, "Device names not as expected")
+		})
+	}
+
+	_, err := app.DeviceLastSeen("unknown")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err), "Error kind not as expected")
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
@@ -287,6 +289,83 @@ func ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourc
 	return readings, totalCount, err
 }
 
+// ReadingsByValueFilter query the numeric readings whose value satisfies the filter with offset and limit. Readings are sorted in
+// descending order of origin time.
+func ReadingsByValueFilter(filter dataModels.ReadingValueFilter, offset int, limit int, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
+	if err = validateReadingValueFilter(filter); err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the total count is queried along with the readings, and it's skipped with the negative offset
+	totalCount, readingModels, err := container.DBClientFrom(dic.Get).ReadingsByValueFilter(filter, offset, limit)
+	if err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	if offset >= 0 {
+		if cont, err := utils.CheckCountRange(totalCount, offset, limit); !cont {
+			return []dtos.BaseReading{}, totalCount, err
+		}
+	}
+	readings, err = convertReadingModelsToDTOs(readingModels)
+	return readings, totalCount, err
+}
+
+// ReadingCountByValueFilter returns the count of the numeric readings whose value satisfies the filter and error if any
+func ReadingCountByValueFilter(filter dataModels.ReadingValueFilter, dic *di.Container) (uint32, errors.EdgeX) {
+	if err := validateReadingValueFilter(filter); err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	count, err := dbClient.ReadingCountByValueFilter(filter)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return count, nil
+}
+
+func validateReadingValueFilter(filter dataModels.ReadingValueFilter) errors.EdgeX {
+	if !dataModels.IsValidValueOperator(filter.Operator) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid value operator '%s'", filter.Operator), nil)
+	}
+	if filter.Operator == dataModels.ValueOperatorBetween && filter.Value > filter.UpperValue {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the lower value %v of between should not be greater than the upper value %v", filter.Value, filter.UpperValue), nil)
+	}
+	return nil
+}
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange returns the count/min/max/avg/first/last of the numeric readings by device name,
+// resource name and specified time range. The readings are grouped into the buckets of the interval starting from the start time.
+func ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval time.Duration, dic *di.Container) (aggregates []dataDTOs.ReadingAggregate, err errors.EdgeX) {
+	if deviceName == "" {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
+	}
+	if resourceName == "" {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+	if interval <= 0 {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("interval '%s' should be greater than zero", interval), nil)
+	}
+	config := container.ConfigurationFrom(dic.Get)
+	if buckets := (end-start)/interval.Nanoseconds() + 1; config.Service.MaxResultCount > 0 && buckets > int64(config.Service.MaxResultCount) {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the number of buckets %d exceeds the MaxResultCount %d, please use a larger interval or a shorter time range", buckets, config.Service.MaxResultCount), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	aggregateModels, err := dbClient.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, interval.Nanoseconds())
+	if err != nil {
+		return aggregates, errors.NewCommonEdgeXWrapper(err)
+	}
+	aggregates = make([]dataDTOs.ReadingAggregate, len(aggregateModels))
+	for i, a := range aggregateModels {
+		aggregates[i] = dataDTOs.FromReadingAggregateModelToDTO(a)
+	}
+	return aggregates, nil
+}
+
 // AsyncPurgeEvent purge events and related readings according to the retention capability.
 func AsyncPurgeEvent(ctx context.Context, dic *di.Container) errors.EdgeX {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -316,6 +395,11 @@ func AsyncPurgeEvent(ctx context.Context, dic *di.Container) errors.EdgeX {
 						lc.Errorf("Failed to purge events and readings, %v", err)
 						break
 					}
+					err = purgeReadingRollups(dic)
+					if err != nil {
+						lc.Errorf("Failed to purge reading rollups, %v", err)
+						break
+					}
 				}
 			}
 		}()
@@ -403,7 +487,7 @@ func timeBasedEventRetention(deviceName string, autoEvent models.AutoEvent, dura
 
 	if autoEvent.Retention.MinCap <= 0 {
 		lc.Debugf("MinCap is disabled, purge events by duration '%d' and deviceName '%s', and sourceName '%s'", duration, deviceName, autoEvent.SourceName)
-		err := dbClient.DeleteEventsByAgeAndDeviceNameAndSourceName(duration.Nanoseconds(), deviceName, autoEvent.SourceName)
+		err := rollupAndDeleteEventsByAge(duration.Nanoseconds(), deviceName, autoEvent.SourceName, dic)
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.Kind(err),
 				fmt.Sprintf("failed to delete events and readings with specific deviceName '%s', sourceName '%s', and duration '%s'",
@@ -425,7 +509,7 @@ func timeBasedEventRetention(deviceName string, autoEvent models.AutoEvent, dura
 		}
 
 		age := time.Now().UnixNano() - event.Origin
-		err = dbClient.DeleteEventsByAgeAndDeviceNameAndSourceName(age, deviceName, autoEvent.SourceName)
+		err = rollupAndDeleteEventsByAge(age, deviceName, autoEvent.SourceName, dic)
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete events and readings with specific deviceName '%s', sourceName '%s', and minCap '%d'",
 				deviceName, autoEvent.SourceName, autoEvent.Retention.MinCap), err)
@@ -437,10 +521,17 @@ func timeBasedEventRetention(deviceName string, autoEvent models.AutoEvent, dura
 func countBasedEventRetention(deviceName string, autoEvent models.AutoEvent, dic *di.Container) errors.EdgeX {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
 
 	if autoEvent.Retention.MinCap <= 0 {
 		lc.Debugf("MinCap is disabled, purge events by deviceName '%s' and sourceName '%s'", deviceName, autoEvent.SourceName)
-		err := dbClient.DeleteEventsByDeviceNameAndSourceName(deviceName, autoEvent.SourceName)
+		var err errors.EdgeX
+		if config.Retention.Rollup.Enabled {
+			// compact all the readings into the rollup tiers before deleting the events
+			err = rollupAndDeleteEventsByAge(0, deviceName, autoEvent.SourceName, dic)
+		} else {
+			err = dbClient.DeleteEventsByDeviceNameAndSourceName(deviceName, autoEvent.SourceName)
+		}
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete events and readings with specific deviceName '%s', sourceName '%s', and minCap '%d'",
 				deviceName, autoEvent.SourceName, autoEvent.Retention.MinCap), err)
@@ -457,7 +548,7 @@ func countBasedEventRetention(deviceName string, autoEvent models.AutoEvent, dic
 				deviceName, autoEvent.SourceName, autoEvent.Retention.MinCap), err)
 		}
 		age := time.Now().UnixNano() - event.Origin
-		err = dbClient.DeleteEventsByAgeAndDeviceNameAndSourceName(age, deviceName, autoEvent.SourceName)
+		err = rollupAndDeleteEventsByAge(age, deviceName, autoEvent.SourceName, dic)
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete events and readings with specific deviceName '%s', sourceName '%s', and minCap '%d'",
 				deviceName, autoEvent.SourceName, autoEvent.Retention.MinCap), err)
@@ -19,6 +19,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -253,6 +254,60 @@ func TestReadingCountByDeviceName(t *testing.T) {
 	}
 }
 
+func TestReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	testResourceName := "testResource"
+	start := int64(0)
+	end := int64(time.Minute * 3)
+	interval := time.Minute
+	aggregates := []dataModels.ReadingAggregate{
+		{DeviceName: testDeviceName, ResourceName: testResourceName, Start: 0, Interval: interval.Nanoseconds(), Count: 2, Min: 1, Max: 3, Avg: 2, First: 1, Last: 3},
+		{DeviceName: testDeviceName, ResourceName: testResourceName, Start: interval.Nanoseconds(), Interval: interval.Nanoseconds(), Count: 1, Min: 5, Max: 5, Avg: 5, First: 5, Last: 5},
+	}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange", testDeviceName, testResourceName, start, end, interval.Nanoseconds()).Return(aggregates, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		end                int64
+		interval           time.Duration
+		errorExpected      bool
+		ExpectedErrKind    errors.ErrKind
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - reading aggregates", testDeviceName, testResourceName, end, interval, false, "", len(aggregates), http.StatusOK},
+		{"Invalid - empty device name", "", testResourceName, end, interval, true, errors.KindContractInvalid, 0, http.StatusBadRequest},
+		{"Invalid - empty resource name", testDeviceName, "", end, interval, true, errors.KindContractInvalid, 0, http.StatusBadRequest},
+		{"Invalid - zero interval", testDeviceName, testResourceName, end, 0, true, errors.KindContractInvalid, 0, http.StatusBadRequest},
+		{"Invalid - buckets exceed MaxResultCount", testDeviceName, testResourceName, int64(time.Hour), interval, true, errors.KindContractInvalid, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(testCase.deviceName, testCase.resourceName, start, testCase.end, testCase.interval, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.NotEmpty(t, err.Error(), "Error message is empty")
+				assert.Equal(t, testCase.ExpectedErrKind, errors.Kind(err), "Error kind not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, err.Code(), "Status code not as expected")
+			} else {
+				require.NoError(t, err)
+				require.Len(t, result, testCase.expectedCount, "Aggregate count is not expected")
+				assert.Equal(t, aggregates[0].Avg, result[0].Avg, "Aggregate avg is not expected")
+				assert.Equal(t, aggregates[1].Start, result[1].Start, "Aggregate start is not expected")
+			}
+		})
+	}
+}
+
 func TestPurgeEvent(t *testing.T) {
 	dic := mocks.NewMockDIC()
 	deviceName := "testDevice"
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+)
+
+// rollupTier is the parsed RollupTier, a zero duration means the summary rows are kept forever
+type rollupTier struct {
+	name     string
+	interval time.Duration
+	duration time.Duration
+}
+
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange query the reading rollups of the interval by the specified device and resource,
+// bucket within the time range, offset, and limit
+func ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, interval time.Duration, start int64, end int64, offset int, limit int, dic *di.Container) (rollups []dataDTOs.ReadingAggregate, err errors.EdgeX) {
+	if deviceName == "" {
+		return rollups, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
+	}
+	if resourceName == "" {
+		return rollups, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+	if interval <= 0 {
+		return rollups, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("interval '%s' should be greater than zero", interval), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	rollupModels, err := dbClient.ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, interval.Nanoseconds(), start, end, offset, limit)
+	if err != nil {
+		return rollups, errors.NewCommonEdgeXWrapper(err)
+	}
+	rollups = make([]dataDTOs.ReadingAggregate, len(rollupModels))
+	for i, r := range rollupModels {
+		rollups[i] = dataDTOs.FromReadingAggregateModelToDTO(r)
+	}
+	return rollups, nil
+}
+
+// parseRollupTiers parses the tiers of the rollup retention sorted by the interval, and returns nil if the rollup retention is disabled
+func parseRollupTiers(rollup config.RollupRetention) ([]rollupTier, errors.EdgeX) {
+	if !rollup.Enabled {
+		return nil, nil
+	}
+	tiers := make([]rollupTier, 0, len(rollup.Tiers))
+	for name, t := range rollup.Tiers {
+		interval, err := time.ParseDuration(t.Interval)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("rollup tier '%s' interval parse failed", name), err)
+		}
+		if interval <= 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("rollup tier '%s' interval '%s' should be greater than zero", name, t.Interval), nil)
+		}
+		var duration time.Duration
+		if t.Duration != "" {
+			duration, err = time.ParseDuration(t.Duration)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("rollup tier '%s' duration parse failed", name), err)
+			}
+		}
+		tiers = append(tiers, rollupTier{name: name, interval: interval, duration: duration})
+	}
+	slices.SortFunc(tiers, func(a, b rollupTier) int {
+		return cmp.Compare(a.interval, b.interval)
+	})
+	return tiers, nil
+}
+
+// rollupAndDeleteEventsByAge deletes the events and readings older than age by the deviceName and sourceName, the numeric
+// readings are compacted into the summary rows of each rollup tier before being deleted if the rollup retention is enabled
+func rollupAndDeleteEventsByAge(age int64, deviceName string, sourceName string, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	tiers, err := parseRollupTiers(container.ConfigurationFrom(dic.Get).Retention.Rollup)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(tiers) == 0 {
+		return dbClient.DeleteEventsByAgeAndDeviceNameAndSourceName(age, deviceName, sourceName)
+	}
+
+	intervals := make([]int64, len(tiers))
+	for i, t := range tiers {
+		intervals[i] = t.interval.Nanoseconds()
+	}
+	return dbClient.RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName(age, intervals, deviceName, sourceName)
+}
+
+// purgeReadingRollups deletes the expired summary rows of each rollup tier
+func purgeReadingRollups(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	tiers, err := parseRollupTiers(container.ConfigurationFrom(dic.Get).Retention.Rollup)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	for _, t := range tiers {
+		if t.duration <= 0 {
+			continue
+		}
+		lc.Debugf("purge reading rollups of tier '%s' by interval '%s' and duration '%s'", t.name, t.interval, t.duration)
+		err = dbClient.DeleteReadingRollupsByAgeAndInterval(t.duration.Nanoseconds(), t.interval.Nanoseconds())
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete reading rollups of tier '%s'", t.name), err)
+		}
+	}
+	return nil
+}
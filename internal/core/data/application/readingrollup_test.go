@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func mockRollupRetention() config.EventRetention {
+	return config.EventRetention{
+		Interval:        "10m",
+		DefaultMaxCap:   -1,
+		DefaultMinCap:   1,
+		DefaultDuration: "30m",
+		Rollup: config.RollupRetention{
+			Enabled: true,
+			Tiers: map[string]config.RollupTier{
+				"Daily":  {Interval: "24h", Duration: "8760h"},
+				"Hourly": {Interval: "1h", Duration: "720h"},
+			},
+		},
+	}
+}
+
+func TestReadingRollupsByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	testResourceName := "testResource"
+	start := int64(0)
+	end := int64(time.Hour * 3)
+	interval := time.Hour
+	rollups := []dataModels.ReadingAggregate{
+		{DeviceName: testDeviceName, ResourceName: testResourceName, Start: 0, Interval: interval.Nanoseconds(), Count: 2, Min: 1, Max: 3, Avg: 2, First: 1, Last: 3},
+		{DeviceName: testDeviceName, ResourceName: testResourceName, Start: interval.Nanoseconds(), Interval: interval.Nanoseconds(), Count: 1, Min: 5, Max: 5, Avg: 5, First: 5, Last: 5},
+	}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingRollupsByDeviceNameAndResourceNameAndTimeRange", testDeviceName, testResourceName, interval.Nanoseconds(), start, end, 0, 20).Return(rollups, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		interval           time.Duration
+		errorExpected      bool
+		ExpectedErrKind    errors.ErrKind
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - reading rollups", testDeviceName, testResourceName, interval, false, "", len(rollups), http.StatusOK},
+		{"Invalid - empty device name", "", testResourceName, interval, true, errors.KindContractInvalid, 0, http.StatusBadRequest},
+		{"Invalid - empty resource name", testDeviceName, "", interval, true, errors.KindContractInvalid, 0, http.StatusBadRequest},
+		{"Invalid - zero interval", testDeviceName, testResourceName, 0, true, errors.KindContractInvalid, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(testCase.deviceName, testCase.resourceName, testCase.interval, start, end, 0, 20, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.NotEmpty(t, err.Error(), "Error message is empty")
+				assert.Equal(t, testCase.ExpectedErrKind, errors.Kind(err), "Error kind not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, err.Code(), "Status code not as expected")
+			} else {
+				require.NoError(t, err)
+				require.Len(t, result, testCase.expectedCount, "Rollup count is not expected")
+				assert.Equal(t, rollups[1].Start, result[1].Start, "Rollup start is not expected")
+			}
+		})
+	}
+}
+
+func TestPurgeEventWithRollup(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	deviceName := "testDevice"
+	sourceName := "testSource"
+	// the intervals are sorted in ascending order regardless of the tier names
+	intervals := []int64{time.Hour.Nanoseconds(), (24 * time.Hour).Nanoseconds()}
+	coreDataConfig := container.ConfigurationFrom(dic.Get)
+	coreDataConfig.Retention = mockRollupRetention()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return coreDataConfig
+		},
+	})
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("LatestEventByDeviceNameAndSourceNameAndOffset", deviceName, sourceName, mock.Anything).Return(models.Event{}, nil)
+	dbClientMock.On("RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName", mock.Anything, intervals, deviceName, sourceName).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name        string
+		retention   models.Retention
+		expectedAge any
+	}{
+		{"time-based event retention", models.Retention{MaxCap: -1, MinCap: -1, Duration: "10m"}, (10 * time.Minute).Nanoseconds()},
+		{"count-based event retention", models.Retention{MaxCap: -1, MinCap: -1, Duration: "0s"}, int64(0)},
+		{"count-based event retention with miniCap", models.Retention{MaxCap: -1, MinCap: 1, Duration: "0s"}, mock.Anything},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := purgeEvent(deviceName, models.AutoEvent{SourceName: sourceName, Retention: testCase.retention}, dic)
+			require.NoError(t, err)
+			dbClientMock.AssertCalled(t, "RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName", testCase.expectedAge, intervals, deviceName, sourceName)
+			dbClientMock.AssertNotCalled(t, "DeleteEventsByAgeAndDeviceNameAndSourceName", mock.Anything, mock.Anything, mock.Anything)
+			dbClientMock.AssertNotCalled(t, "DeleteEventsByDeviceNameAndSourceName", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPurgeReadingRollups(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	coreDataConfig := container.ConfigurationFrom(dic.Get)
+	coreDataConfig.Retention = mockRollupRetention()
+	coreDataConfig.Retention.Rollup.Tiers["Forever"] = config.RollupTier{Interval: "168h"}
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return coreDataConfig
+		},
+	})
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteReadingRollupsByAgeAndInterval", mock.Anything, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	err := purgeReadingRollups(dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "DeleteReadingRollupsByAgeAndInterval", (720 * time.Hour).Nanoseconds(), time.Hour.Nanoseconds())
+	dbClientMock.AssertCalled(t, "DeleteReadingRollupsByAgeAndInterval", (8760 * time.Hour).Nanoseconds(), (24 * time.Hour).Nanoseconds())
+	dbClientMock.AssertNumberOfCalls(t, "DeleteReadingRollupsByAgeAndInterval", 2)
+}
+
+func TestParseRollupTiers(t *testing.T) {
+	tests := []struct {
+		name          string
+		rollup        config.RollupRetention
+		expectedCount int
+		errorExpected bool
+	}{
+		{"Valid - disabled", config.RollupRetention{Enabled: false, Tiers: map[string]config.RollupTier{"Hourly": {Interval: "invalid"}}}, 0, false},
+		{"Valid - enabled", mockRollupRetention().Rollup, 2, false},
+		{"Invalid - interval parse failed", config.RollupRetention{Enabled: true, Tiers: map[string]config.RollupTier{"Hourly": {Interval: "invalid"}}}, 0, true},
+		{"Invalid - zero interval", config.RollupRetention{Enabled: true, Tiers: map[string]config.RollupTier{"Hourly": {Interval: "0s"}}}, 0, true},
+		{"Invalid - duration parse failed", config.RollupRetention{Enabled: true, Tiers: map[string]config.RollupTier{"Hourly": {Interval: "1h", Duration: "invalid"}}}, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			tiers, err := parseRollupTiers(testCase.rollup)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err), "Error kind not as expected")
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, tiers, testCase.expectedCount, "Tier count is not expected")
+			}
+		})
+	}
+}
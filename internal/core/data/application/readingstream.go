@@ -0,0 +1,174 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+)
+
+// ReadingStreamFilter defines which readings are streamed to a subscription, an empty field matches any reading
+type ReadingStreamFilter struct {
+	DeviceNames   []string
+	ProfileNames  []string
+	ResourceNames []string
+	// Tags matches the readings carrying all the tags, the reading tags take precedence over the event tags
+	Tags map[string]string
+}
+
+// ReadingStreamSubscription receives the readings matching its filter
+type ReadingStreamSubscription struct {
+	filter   ReadingStreamFilter
+	readings chan dtos.BaseReading
+	dropped  atomic.Uint64
+}
+
+// Readings returns the channel of the readings matching the filter of the subscription
+func (s *ReadingStreamSubscription) Readings() <-chan dtos.BaseReading {
+	return s.readings
+}
+
+// Dropped returns the number of the readings dropped since the last call because the subscription fell behind
+func (s *ReadingStreamSubscription) Dropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// ReadingStreamHub dispatches the readings of the received events to the subscriptions without blocking, so that a slow
+// subscription only loses its own readings instead of stalling the message bus subscriber
+type ReadingStreamHub struct {
+	mutex          sync.RWMutex
+	subscriptions  map[*ReadingStreamSubscription]struct{}
+	maxConnections int
+	bufferSize     int
+	keepAlive      time.Duration
+}
+
+// NewReadingStreamHub creates a ReadingStreamHub with the specified connection limits
+func NewReadingStreamHub(streamInfo config.ReadingStreamInfo) (*ReadingStreamHub, errors.EdgeX) {
+	if streamInfo.MaxConnections <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("reading stream MaxConnections '%d' should be greater than zero", streamInfo.MaxConnections), nil)
+	}
+	if streamInfo.BufferSize <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("reading stream BufferSize '%d' should be greater than zero", streamInfo.BufferSize), nil)
+	}
+	keepAlive, err := time.ParseDuration(streamInfo.KeepAlive)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "reading stream KeepAlive parse failed", err)
+	}
+	if keepAlive <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("reading stream KeepAlive '%s' should be greater than zero", streamInfo.KeepAlive), nil)
+	}
+
+	return &ReadingStreamHub{
+		subscriptions:  make(map[*ReadingStreamSubscription]struct{}),
+		maxConnections: streamInfo.MaxConnections,
+		bufferSize:     streamInfo.BufferSize,
+		keepAlive:      keepAlive,
+	}, nil
+}
+
+// Subscribe adds a subscription with the filter, or returns an error if the maximum connections are reached
+func (h *ReadingStreamHub) Subscribe(filter ReadingStreamFilter) (*ReadingStreamSubscription, errors.EdgeX) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if len(h.subscriptions) >= h.maxConnections {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("the maximum %d reading stream connections are reached", h.maxConnections), nil)
+	}
+	sub := &ReadingStreamSubscription{
+		filter:   filter,
+		readings: make(chan dtos.BaseReading, h.bufferSize),
+	}
+	h.subscriptions[sub] = struct{}{}
+	return sub, nil
+}
+
+// Unsubscribe removes the subscription
+func (h *ReadingStreamHub) Unsubscribe(sub *ReadingStreamSubscription) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	delete(h.subscriptions, sub)
+}
+
+// KeepAlive returns the interval to send the keep-alive messages to the idle connections
+func (h *ReadingStreamHub) KeepAlive() time.Duration {
+	return h.keepAlive
+}
+
+// Connections returns the number of the current subscriptions
+func (h *ReadingStreamHub) Connections() int {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return len(h.subscriptions)
+}
+
+// Publish dispatches the readings of the event to the matching subscriptions, the readings are dropped for the subscriptions
+// whose buffer is full
+func (h *ReadingStreamHub) Publish(e dtos.Event) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	for sub := range h.subscriptions {
+		for _, r := range e.Readings {
+			if !sub.filter.matches(e, r) {
+				continue
+			}
+			select {
+			case sub.readings <- r:
+			default:
+				sub.dropped.Add(1)
+			}
+		}
+	}
+}
+
+func (f ReadingStreamFilter) matches(e dtos.Event, r dtos.BaseReading) bool {
+	if len(f.DeviceNames) > 0 && !slices.Contains(f.DeviceNames, r.DeviceName) {
+		return false
+	}
+	if len(f.ProfileNames) > 0 && !slices.Contains(f.ProfileNames, r.ProfileName) {
+		return false
+	}
+	if len(f.ResourceNames) > 0 && !slices.Contains(f.ResourceNames, r.ResourceName) {
+		return false
+	}
+	for key, value := range f.Tags {
+		tag, ok := r.Tags[key]
+		if !ok {
+			tag, ok = e.Tags[key]
+		}
+		if !ok || fmt.Sprint(tag) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// PublishReadings streams the readings of the event to the matching subscriptions if the reading stream is enabled
+func (a *CoreDataApp) PublishReadings(e dtos.Event) {
+	if a.readingStreamHub == nil {
+		return
+	}
+	a.readingStreamHub.Publish(e)
+}
+
+// ReadingStreamHub returns the ReadingStreamHub, or an error if the reading stream is not enabled
+func (a *CoreDataApp) ReadingStreamHub() (*ReadingStreamHub, errors.EdgeX) {
+	if a.readingStreamHub == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "reading stream is not enabled", nil)
+	}
+	return a.readingStreamHub, nil
+}
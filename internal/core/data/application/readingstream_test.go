@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	testStreamProfileName = "TestProfile"
+	testStreamResource1   = "Resource1"
+	testStreamResource2   = "Resource2"
+)
+
+func mockReadingStreamInfo() config.ReadingStreamInfo {
+	return config.ReadingStreamInfo{Enabled: true, MaxConnections: 2, BufferSize: 2, KeepAlive: "15s"}
+}
+
+func buildTestStreamEvent() dtos.Event {
+	event := dtos.NewEvent(testStreamProfileName, testDeviceName, testStreamProfileName)
+	event.Tags = dtos.Tags{"site": "A", "floor": 1}
+	_ = event.AddSimpleReading(testStreamResource1, common.ValueTypeInt32, int32(1))
+	_ = event.AddSimpleReading(testStreamResource2, common.ValueTypeInt32, int32(2))
+	event.Readings[1].Tags = dtos.Tags{"site": "B"}
+	return event
+}
+
+func TestNewReadingStreamHub(t *testing.T) {
+	tests := []struct {
+		name          string
+		streamInfo    config.ReadingStreamInfo
+		errorExpected bool
+	}{
+		{"Valid", mockReadingStreamInfo(), false},
+		{"Invalid - zero MaxConnections", config.ReadingStreamInfo{MaxConnections: 0, BufferSize: 2, KeepAlive: "15s"}, true},
+		{"Invalid - zero BufferSize", config.ReadingStreamInfo{MaxConnections: 2, BufferSize: 0, KeepAlive: "15s"}, true},
+		{"Invalid - KeepAlive parse failed", config.ReadingStreamInfo{MaxConnections: 2, BufferSize: 2, KeepAlive: "invalid"}, true},
+		{"Invalid - zero KeepAlive", config.ReadingStreamInfo{MaxConnections: 2, BufferSize: 2, KeepAlive: "0s"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			hub, err := NewReadingStreamHub(testCase.streamInfo)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err), "Error kind not as expected")
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 15*time.Second, hub.KeepAlive(), "KeepAlive not as expected")
+			}
+		})
+	}
+}
+
+func TestReadingStreamHubPublish(t *testing.T) {
+	tests := []struct {
+		name              string
+		filter            ReadingStreamFilter
+		expectedResources []string
+	}{
+		{"no filter", ReadingStreamFilter{}, []string{testStreamResource1, testStreamResource2}},
+		{"device name", ReadingStreamFilter{DeviceNames: []string{"other", testDeviceName}}, []string{testStreamResource1, testStreamResource2}},
+		{"unmatched device name", ReadingStreamFilter{DeviceNames: []string{"other"}}, nil},
+		{"profile name", ReadingStreamFilter{ProfileNames: []string{testStreamProfileName}}, []string{testStreamResource1, testStreamResource2}},
+		{"resource name", ReadingStreamFilter{ResourceNames: []string{testStreamResource2}}, []string{testStreamResource2}},
+		{"event tag", ReadingStreamFilter{Tags: map[string]string{"floor": "1"}}, []string{testStreamResource1, testStreamResource2}},
+		{"reading tag overrides event tag", ReadingStreamFilter{Tags: map[string]string{"site": "B"}}, []string{testStreamResource2}},
+		{"missing tag", ReadingStreamFilter{Tags: map[string]string{"zone": "1"}}, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			hub, err := NewReadingStreamHub(mockReadingStreamInfo())
+			require.NoError(t, err)
+			sub, err := hub.Subscribe(testCase.filter)
+			require.NoError(t, err)
+
+			hub.Publish(buildTestStreamEvent())
+
+			var resources []string
+			for len(sub.Readings()) > 0 {
+				resources = append(resources, (<-sub.Readings()).ResourceName)
+			}
+			assert.Equal(t, testCase.expectedResources, resources, "Streamed readings not as expected")
+		})
+	}
+}
+
+func TestReadingStreamHubLimits(t *testing.T) {
+	hub, err := NewReadingStreamHub(mockReadingStreamInfo())
+	require.NoError(t, err)
+
+	slow, err := hub.Subscribe(ReadingStreamFilter{})
+	require.NoError(t, err)
+	_, err = hub.Subscribe(ReadingStreamFilter{})
+	require.NoError(t, err)
+	_, err = hub.Subscribe(ReadingStreamFilter{})
+	require.Error(t, err, "the subscription should be rejected when the maximum connections are reached")
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err), "Error kind not as expected")
+
+	// the buffer of 2 readings is full after the first event, so the readings of the next events are dropped without blocking
+	for i := 0; i < 3; i++ {
+		hub.Publish(buildTestStreamEvent())
+	}
+	assert.Len(t, slow.Readings(), 2, "Buffered readings not as expected")
+	assert.Equal(t, uint64(4), slow.Dropped(), "Dropped readings not as expected")
+	assert.Equal(t, uint64(0), slow.Dropped(), "Dropped readings should be reset once reported")
+
+	hub.Unsubscribe(slow)
+	assert.Equal(t, 1, hub.Connections(), "Connections not as expected")
+	_, err = hub.Subscribe(ReadingStreamFilter{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,220 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// staleDeviceMonitor tracks which active devices stopped reporting, a device is only reported when it turns stale or
+// recovers
+type staleDeviceMonitor struct {
+	app             *CoreDataApp
+	dic             *di.Container
+	missedIntervals int
+	// observed holds the time each device is first monitored, which is the baseline of the device if no event is received
+	// from it since core-data started
+	observed map[string]int64
+	stale    map[string]bool
+}
+
+func newStaleDeviceMonitor(app *CoreDataApp, missedIntervals int, dic *di.Container) *staleDeviceMonitor {
+	return &staleDeviceMonitor{
+		app:             app,
+		dic:             dic,
+		missedIntervals: missedIntervals,
+		observed:        make(map[string]int64),
+		stale:           make(map[string]bool),
+	}
+}
+
+// AsyncMonitorStaleDevices checks the active devices periodically, and reports the devices which stop producing events
+// for the configured number of their auto event intervals and the devices which recover.
+func AsyncMonitorStaleDevices(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	staleDeviceInfo := container.ConfigurationFrom(dic.Get).StaleDevice
+	if !staleDeviceInfo.Enabled {
+		return nil
+	}
+	checkInterval, err := time.ParseDuration(staleDeviceInfo.CheckInterval)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "stale device CheckInterval parse failed", err)
+	}
+	if checkInterval <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("stale device CheckInterval '%s' should be greater than zero", staleDeviceInfo.CheckInterval), nil)
+	}
+	if staleDeviceInfo.MissedIntervals <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("stale device MissedIntervals '%d' should be greater than zero", staleDeviceInfo.MissedIntervals), nil)
+	}
+
+	monitor := newStaleDeviceMonitor(CoreDataAppFrom(dic.Get), staleDeviceInfo.MissedIntervals, dic)
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting stale device detection")
+				return
+			case now := <-ticker.C:
+				monitor.check(ctx, now)
+			}
+		}
+	}()
+	lc.Infof("Checking the stale devices every %s", checkInterval)
+
+	return nil
+}
+
+// shortestAutoEventInterval returns the shortest interval of the auto events which read the device periodically, the
+// on-change auto events are excluded since they don't publish the unchanged readings. Zero is returned if there is none.
+func shortestAutoEventInterval(autoEvents []models.AutoEvent) time.Duration {
+	var shortest time.Duration
+	for _, autoEvent := range autoEvents {
+		if autoEvent.OnChange {
+			continue
+		}
+		interval, err := time.ParseDuration(autoEvent.Interval)
+		if err != nil || interval <= 0 {
+			continue
+		}
+		if shortest == 0 || interval < shortest {
+			shortest = interval
+		}
+	}
+	return shortest
+}
+
+// check updates the stale state of the active devices at the time now, and reports the devices whose state changes
+func (m *staleDeviceMonitor) check(ctx context.Context, now time.Time) {
+	devices := container.DeviceStoreFrom(m.dic.Get).Devices()
+	for name := range m.observed {
+		if _, ok := devices[name]; !ok {
+			delete(m.observed, name)
+			delete(m.stale, name)
+		}
+	}
+
+	for _, device := range devices {
+		interval := shortestAutoEventInterval(device.AutoEvents)
+		if interval == 0 || device.AdminState == models.Locked {
+			// the device is not expected to produce events, so start a new baseline once it is expected again
+			delete(m.observed, device.Name)
+			delete(m.stale, device.Name)
+			continue
+		}
+
+		observed, ok := m.observed[device.Name]
+		if !ok {
+			observed = now.UnixNano()
+			m.observed[device.Name] = observed
+		}
+		var lastSeen int64
+		if l, err := m.app.DeviceLastSeen(device.Name); err == nil {
+			lastSeen = l.LastSeen
+		}
+
+		stale := now.Sub(time.Unix(0, max(observed, lastSeen))) >= time.Duration(m.missedIntervals)*interval
+		if stale == m.stale[device.Name] {
+			continue
+		}
+		action := constants.SystemEventActionRecovered
+		if stale {
+			action = constants.SystemEventActionStale
+			m.stale[device.Name] = true
+		} else {
+			delete(m.stale, device.Name)
+		}
+		m.report(ctx, action, device, dataDTOs.StaleDevice{
+			DeviceName:      device.Name,
+			ServiceName:     device.ServiceName,
+			ProfileName:     device.ProfileName,
+			Interval:        interval.String(),
+			MissedIntervals: m.missedIntervals,
+			LastSeen:        lastSeen,
+		})
+	}
+}
+
+// report publishes the stale or recovered system event of the device, and sends the notification if enabled
+func (m *staleDeviceMonitor) report(ctx context.Context, action string, device models.Device, details dataDTOs.StaleDevice) {
+	lc := bootstrapContainer.LoggingClientFrom(m.dic.Get)
+	configuration := container.ConfigurationFrom(m.dic.Get)
+	if action == constants.SystemEventActionStale {
+		lc.Warnf("Device '%s' is stale, no event is received for %d intervals of %s", device.Name, details.MissedIntervals, details.Interval)
+	} else {
+		lc.Infof("Device '%s' is recovered from stale", device.Name)
+	}
+
+	ctx, _ = correlation.FromContextOrNew(ctx)
+	publishDeviceSystemEvent(ctx, action, device, details, m.dic)
+
+	if !configuration.StaleDevice.Notify {
+		return
+	}
+	client := bootstrapContainer.NotificationClientFrom(m.dic.Get)
+	if client == nil {
+		lc.Errorf("unable to send the '%s' notification of device '%s': support-notifications client is not configured", action, device.Name)
+		return
+	}
+	content := fmt.Sprintf("Device '%s' of device service '%s' has not produced any event for %d intervals of %s", device.Name, device.ServiceName, details.MissedIntervals, details.Interval)
+	severity := models.Critical
+	if action == constants.SystemEventActionRecovered {
+		content = fmt.Sprintf("Device '%s' of device service '%s' is producing events again", device.Name, device.ServiceName)
+		severity = models.Normal
+	}
+	notification := dtos.NewNotification([]string{device.Name, action}, configuration.StaleDevice.NotificationCategory, content, common.CoreDataServiceKey, severity)
+	res, err := client.SendNotification(ctx, []requests.AddNotificationRequest{requests.NewAddNotificationRequest(notification)})
+	if err == nil && len(res) > 0 && res[0].StatusCode >= 300 {
+		err = errors.NewCommonEdgeX(errors.KindMapping(res[0].StatusCode), res[0].Message, nil)
+	}
+	if err != nil {
+		lc.Errorf("unable to send the '%s' notification of device '%s': %v", action, device.Name, err)
+	}
+}
+
+// publishDeviceSystemEvent publishes the system event of the device to the topic
+// edgex/system-events/core-data/device/<action>/<device service name>/<device profile name>
+func publishDeviceSystemEvent(ctx context.Context, action string, device models.Device, details any, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messagingClient == nil {
+		lc.Errorf("unable to publish the '%s' System Event of device '%s': messaging client is not available", action, device.Name)
+		return
+	}
+
+	systemEvent := dtos.NewSystemEvent(common.DeviceSystemEventType, action, common.CoreDataServiceKey, device.ServiceName, nil, details)
+	publishTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(configuration.MessageBus.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).
+		SetPath(systemEvent.Source).SetPath(systemEvent.Type).SetPath(systemEvent.Action).
+		SetNameFieldPath(systemEvent.Owner).SetNameFieldPath(device.ProfileName).BuildPath()
+
+	// make sure the Content Type is set appropriate if payload is required to be encoded
+	ctx = context.WithValue(ctx, common.ContentType, common.ContentTypeJSON) //nolint: staticcheck
+	envelope := types.NewMessageEnvelope(systemEvent, ctx)
+	if err := messagingClient.Publish(envelope, publishTopic); err != nil {
+		lc.Errorf("unable to publish the '%s' System Event of device '%s' to topic '%s': %v", action, device.Name, publishTopic, err)
+		return
+	}
+	lc.Debugf("Published the '%s' System Event of device '%s' to topic '%s'", action, device.Name, publishTopic)
+}
@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/cache"
+)
+
+const (
+	testStaleServiceName = "testService"
+	testStaleProfileName = "testProfile"
+	testStaleTopic       = "edgex/system-events/core-data/device/stale/" + testStaleServiceName + "/" + testStaleProfileName
+	testRecoveredTopic   = "edgex/system-events/core-data/device/recovered/" + testStaleServiceName + "/" + testStaleProfileName
+)
+
+func TestShortestAutoEventInterval(t *testing.T) {
+	tests := []struct {
+		name       string
+		autoEvents []models.AutoEvent
+		expected   time.Duration
+	}{
+		{"no auto events", nil, 0},
+		{"shortest interval", []models.AutoEvent{{Interval: "1m"}, {Interval: "10s"}, {Interval: "1h"}}, 10 * time.Second},
+		{"on-change auto event excluded", []models.AutoEvent{{Interval: "1s", OnChange: true}, {Interval: "1m"}}, time.Minute},
+		{"invalid interval excluded", []models.AutoEvent{{Interval: "invalid"}, {Interval: "0s"}}, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, shortestAutoEventInterval(testCase.autoEvents))
+		})
+	}
+}
+
+func TestStaleDeviceMonitorCheck(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	container.ConfigurationFrom(dic.Get).StaleDevice = config.StaleDeviceInfo{Enabled: true, CheckInterval: "1s", MissedIntervals: 3, Notify: true, NotificationCategory: "device-health"}
+	msgClient := &msgMocks.MessageClient{}
+	msgClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	notificationClient := &clientMocks.NotificationClient{}
+	notificationClient.On("SendNotification", mock.Anything, mock.Anything).Return([]dtoCommon.BaseWithIdResponse{}, nil)
+	deviceStore := cache.DeviceStore(dic)
+	deviceStore.Add(models.Device{
+		Name:        testDeviceName,
+		ServiceName: testStaleServiceName,
+		ProfileName: testStaleProfileName,
+		AdminState:  models.Unlocked,
+		AutoEvents:  []models.AutoEvent{{Interval: "1s", SourceName: testSourceName}},
+	})
+	deviceStore.Add(models.Device{Name: "locked", AdminState: models.Locked, AutoEvents: []models.AutoEvent{{Interval: "1s"}}})
+	deviceStore.Add(models.Device{Name: "no-auto-events", AdminState: models.Unlocked})
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClient
+		},
+		bootstrapContainer.NotificationClientName: func(get di.Get) interface{} {
+			return notificationClient
+		},
+		container.DeviceStoreInterfaceName: func(get di.Get) interface{} {
+			return deviceStore
+		},
+	})
+	app := NewCoreDataApp(dic)
+	monitor := newStaleDeviceMonitor(app, 3, dic)
+
+	start := time.Now()
+	monitor.check(context.Background(), start)
+	monitor.check(context.Background(), start.Add(2*time.Second))
+	msgClient.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+
+	// the device is stale once no event is received for 3 intervals since it is first monitored
+	monitor.check(context.Background(), start.Add(3*time.Second))
+	msgClient.AssertCalled(t, "Publish", mock.Anything, testStaleTopic)
+	notificationClient.AssertNumberOfCalls(t, "SendNotification", 1)
+	require.True(t, monitor.stale[testDeviceName], "the device should be stale")
+	assert.Len(t, monitor.stale, 1, "only the device with the auto events should be monitored")
+
+	// the stale device is only reported once
+	monitor.check(context.Background(), start.Add(4*time.Second))
+	msgClient.AssertNumberOfCalls(t, "Publish", 1)
+
+	app.EventReceived(testStaleServiceName, models.Event{DeviceName: testDeviceName, SourceName: testSourceName})
+	monitor.check(context.Background(), time.Now())
+	msgClient.AssertCalled(t, "Publish", mock.Anything, testRecoveredTopic)
+	notificationClient.AssertNumberOfCalls(t, "SendNotification", 2)
+	assert.False(t, monitor.stale[testDeviceName], "the device should be recovered")
+
+	// the removed device is no longer monitored
+	deviceStore.Remove(testDeviceName)
+	monitor.check(context.Background(), time.Now().Add(time.Hour))
+	assert.Empty(t, monitor.observed, "the removed device should not be monitored")
+	msgClient.AssertNumberOfCalls(t, "Publish", 2)
+}
@@ -28,7 +28,13 @@ type ConfigurationStruct struct {
 	Registry     bootstrapConfig.RegistryInfo
 	Service      bootstrapConfig.ServiceInfo
 	MaxEventSize int64
-	Retention    EventRetention
+	// MaxEventBatchSize is the maximum size in kilobytes of the request body of adding the events in batch, there is no limit
+	// if it's not greater than 0
+	MaxEventBatchSize int64
+	Retention         EventRetention
+	EventBatch        EventBatchInfo
+	ReadingStream     ReadingStreamInfo
+	StaleDevice       StaleDeviceInfo
 }
 
 type WritableInfo struct {
@@ -44,6 +50,53 @@ type EventRetention struct {
 	DefaultMaxCap   int64
 	DefaultMinCap   int64
 	DefaultDuration string
+	Rollup          RollupRetention
+}
+
+// RollupRetention defines the tiers of the summary rows which the numeric readings are compacted into before the raw events
+// are purged by the event retention. The rollups are only supported by the PostgreSQL database.
+type RollupRetention struct {
+	Enabled bool
+	Tiers   map[string]RollupTier
+}
+
+// RollupTier defines the bucket interval of the summary rows and the duration to keep them, the summary rows are kept
+// forever when the duration is empty or zero
+type RollupTier struct {
+	Interval string
+	Duration string
+}
+
+// EventBatchInfo defines how the events received from the message bus are grouped into batches, a batch is persisted once
+// it contains MaxSize events or MaxWait elapses since its first event arrived
+type EventBatchInfo struct {
+	Enabled bool
+	MaxSize int
+	MaxWait string
+}
+
+// ReadingStreamInfo defines the Server-Sent Events endpoint which streams the readings received from the message bus. The
+// endpoint is served on its own port since the streaming responses can't pass through the request timeout of the service's
+// web server. Each connection buffers up to BufferSize readings, and the readings are dropped instead of blocking the
+// message bus subscriber when a slow client falls behind.
+type ReadingStreamInfo struct {
+	Enabled        bool
+	Port           int
+	MaxConnections int
+	BufferSize     int
+	KeepAlive      string
+}
+
+// StaleDeviceInfo defines how the devices which stopped reporting are detected. Every CheckInterval, a device is reported
+// as stale once no event is received from it for MissedIntervals of its shortest auto event interval, and reported as
+// recovered once the events are received again. The reports are published as system events, and also sent to
+// support-notifications with the NotificationCategory when Notify is enabled.
+type StaleDeviceInfo struct {
+	Enabled              bool
+	CheckInterval        string
+	MissedIntervals      int
+	Notify               bool
+	NotificationCategory string
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX core-data service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiEventBatchRoute                                              = common.ApiEventRoute + "/" + Batch
+	ApiAllDeviceLastSeenRoute                                       = common.ApiEventRoute + "/" + LastSeen + "/" + common.All
+	ApiDeviceLastSeenByDeviceNameRoute                              = common.ApiEventRoute + "/" + LastSeen + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+	ApiReadingAggregateRoute                                        = common.ApiReadingRoute + "/" + Aggregate
+	ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute = ApiReadingAggregateRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name + "/" + common.ResourceName + "/:" + common.ResourceName + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+	ApiReadingRollupRoute                                           = common.ApiReadingRoute + "/" + Rollup
+	ApiReadingStreamRoute                                           = common.ApiReadingRoute + "/" + Stream
+	ApiReadingRollupByDeviceNameAndResourceNameAndTimeRangeRoute    = ApiReadingRollupRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name + "/" + common.ResourceName + "/:" + common.ResourceName + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	Aggregate = "aggregate"
+	Batch     = "batch"
+	Cursor    = "cursor"
+	LastSeen  = "lastseen"
+	Rollup    = "rollup"
+	Stream    = "stream"
+	Tags      = "tags"
+)
+
+// Constants related to the query parameters of the numeric reading value filters
+const (
+	ValueGreaterThan = "valueGt"
+	ValueLessThan    = "valueLt"
+	ValueEqual       = "valueEq"
+	ValueBetween     = "valueBetween"
+)
+
+// Constants related to the system events published by core-data
+const (
+	SystemEventActionStale     = "stale"
+	SystemEventActionRecovered = "recovered"
+)
@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// parseCursor returns the cursor query string and whether the cursor pagination is requested. The cursor pagination is requested
+// by specifying the cursor query string, and an empty cursor requests the first page.
+func parseCursor(c echo.Context) (string, bool) {
+	cursor, ok := c.QueryParams()[constants.Cursor]
+	if !ok {
+		return "", false
+	}
+	return cursor[0], true
+}
+
+// eventsByCursor writes a page of the events by the cursor query, the offset query string is ignored and the total count is not
+// calculated in the cursor pagination
+func (ec *EventController) eventsByCursor(c echo.Context, query dataModels.CursorQuery) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	events, nextCursor, err := ec.app.EventsByCursor(query, ec.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := dataResponses.NewMultiEventsCursorResponse("", "", http.StatusOK, events, nextCursor)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// readingsByCursor writes a page of the readings by the cursor query, the offset query string is ignored and the total count is
+// not calculated in the cursor pagination
+func (rc *ReadingController) readingsByCursor(c echo.Context, valueFilter *dataModels.ReadingValueFilter, query dataModels.CursorQuery) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	if valueFilter != nil {
+		err := errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the value filters are not supported with the %s query string", constants.Cursor), nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	readings, nextCursor, err := application.ReadingsByCursor(query, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := dataResponses.NewMultiReadingsCursorResponse("", "", http.StatusOK, readings, nextCursor)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestEventsByTimeRange_Cursor(t *testing.T) {
+	dbCursor := "10_" + ExampleUUID
+	token := base64.RawURLEncoding.EncodeToString([]byte(dbCursor))
+	firstPage := dataModels.CursorQuery{Start: 0, End: 100, Limit: 1}
+	secondPage := firstPage
+	secondPage.Cursor = dbCursor
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsByCursor", firstPage).Return([]models.Event{persistedEvent}, dbCursor, nil)
+	dbClientMock.On("EventsByCursor", secondPage).Return([]models.Event{persistedEvent}, "", nil)
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	controller := NewEventController(dic)
+
+	tests := []struct {
+		name               string
+		cursor             string
+		limit              string
+		expectedNextCursor string
+		expectedStatusCode int
+	}{
+		{"Valid - first page", "", "1", token, http.StatusOK},
+		{"Valid - last page", token, "1", "", http.StatusOK},
+		{"Invalid - malformed cursor", "!invalid", "1", "", http.StatusBadRequest},
+		{"Invalid - zero limit", "", "0", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiEventByTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.Cursor, testCase.cursor)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Start, common.End)
+			c.SetParamValues("0", "100")
+			err = controller.EventsByTimeRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res dataResponses.MultiEventsCursorResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Len(t, res.Events, 1, "Events not as expected")
+				assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+			}
+		})
+	}
+}
+
+func TestReadingsByDeviceNameAndResourceName_Cursor(t *testing.T) {
+	cursorQuery := dataModels.CursorQuery{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 0, End: math.MaxInt64, Limit: 20}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByCursor", cursorQuery).Return([]models.Reading{}, "", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+
+	tests := []struct {
+		name               string
+		valueFilter        string
+		expectedStatusCode int
+	}{
+		{"Valid", "", http.StatusOK},
+		{"Invalid - value filter with cursor", "80", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiReadingByDeviceNameAndResourceNameRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.Cursor, "")
+			if testCase.valueFilter != "" {
+				query.Add(constants.ValueGreaterThan, testCase.valueFilter)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.ResourceName)
+			c.SetParamValues(TestDeviceName, TestDeviceResourceName)
+			err = rc.ReadingsByDeviceNameAndResourceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res dataResponses.MultiReadingsCursorResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Empty(t, res.NextCursor, "Next cursor should be empty when there are no more readings")
+		})
+	}
+}
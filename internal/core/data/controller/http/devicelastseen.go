@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AllDeviceLastSeen returns the latest event received from each device since core-data started
+func (ec *EventController) AllDeviceLastSeen(c echo.Context) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	lastSeen, totalCount, err := ec.app.AllDeviceLastSeen(offset, limit)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	lastSeenDTOs := make([]dtos.DeviceLastSeen, len(lastSeen))
+	for i, l := range lastSeen {
+		lastSeenDTOs[i] = dtos.FromDeviceLastSeenModelToDTO(l)
+	}
+	response := responses.NewMultiDeviceLastSeenResponse("", "", http.StatusOK, totalCount, lastSeenDTOs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeviceLastSeenByDeviceName returns the latest event received from the device since core-data started
+func (ec *EventController) DeviceLastSeenByDeviceName(c echo.Context) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	lastSeen, err := ec.app.DeviceLastSeen(name)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewDeviceLastSeenResponse("", "", http.StatusOK, dtos.FromDeviceLastSeenModelToDTO(lastSeen))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestDeviceLastSeenByDeviceName(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	app.EventReceived(TestServiceName, persistedEvent)
+	dic.Update(di.ServiceConstructorMap{
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	controller := NewEventController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, http.StatusOK},
+		{"Invalid - device not seen", "unknown", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiDeviceLastSeenByDeviceNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceName)
+			err = controller.DeviceLastSeenByDeviceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res dataResponses.DeviceLastSeenResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, TestDeviceName, res.LastSeen.DeviceName, "Device name not as expected")
+				assert.Equal(t, TestServiceName, res.LastSeen.ServiceName, "Service name not as expected")
+				assert.Equal(t, persistedEvent.Origin, res.LastSeen.Origin, "Origin not as expected")
+			}
+		})
+	}
+}
+
+func TestAllDeviceLastSeen(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	app.EventReceived(TestServiceName, persistedEvent)
+	dic.Update(di.ServiceConstructorMap{
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	controller := NewEventController(dic)
+
+	tests := []struct {
+		name               string
+		offset             string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid", "0", 1, http.StatusOK},
+		{"Invalid - offset out of range", "2", 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - offset parse error", "invalid", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiAllDeviceLastSeenRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AllDeviceLastSeen(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res dataResponses.MultiDeviceLastSeenResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Len(t, res.LastSeen, testCase.expectedCount, "Last seen devices not as expected")
+		})
+	}
+}
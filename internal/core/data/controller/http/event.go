@@ -4,6 +4,8 @@ package http
 
 import (
 	"bytes"
+	"encoding/json"
+	stdErrs "errors"
 	"fmt"
 	"io"
 	"math"
@@ -14,8 +16,10 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	edgexIO "github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -24,7 +28,9 @@ import (
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/labstack/echo/v4"
 )
 
@@ -113,6 +119,7 @@ func (ec *EventController) AddEvent(c echo.Context) error {
 	event := requestDTO.AddEventReqToEventModel(addEventReqDTO)
 	err = ec.app.ValidateEvent(event, profileName, deviceName, sourceName, ctx, ec.dic)
 	if err == nil {
+		ec.app.EventReceived(serviceName, event)
 		err = ec.app.AddEvent(event, ctx, ec.dic)
 	}
 	if err != nil {
@@ -125,6 +132,115 @@ func (ec *EventController) AddEvent(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// AddEvents adds the events in batch and responds with the result of each event. Each event is decoded and validated on
+// its own, so that an invalid event is responded with its own error without rejecting the others.
+func (ec *EventController) AddEvents(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	// retrieve all the service injections from bootstrap
+	lc := container.LoggingClientFrom(ec.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	if config.MaxEventBatchSize > 0 {
+		if r.ContentLength > config.MaxEventBatchSize*1024 {
+			err := errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request size exceed %d KB", config.MaxEventBatchSize), nil)
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		// the body without the content length, e.g. the chunked one, stops being read once it exceeds the limit
+		r.Body = http.MaxBytesReader(w, r.Body, config.MaxEventBatchSize*1024)
+	}
+	rawEvents, err := readRawEvents(r, config.MaxEventBatchSize)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// publish the events with the service name of the devices, since the request doesn't carry the service name
+	devices := dataContainer.DeviceStoreFrom(ec.dic.Get).Devices()
+	reader := ec.getReader(r)
+	addResponses := make([]interface{}, len(rawEvents))
+	var events []models.Event
+	var reqIds []string
+	var responseIndexes []int
+	for i, rawEvent := range rawEvents {
+		var reqDTO requestDTO.AddEventRequest
+		if config.MaxEventSize > 0 && int64(len(rawEvent)) > config.MaxEventSize*1024 {
+			err = errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("event size exceed %d KB", config.MaxEventSize), nil)
+		} else {
+			err = reader.Read(bytes.NewReader(rawEvent), &reqDTO)
+		}
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			addResponses[i] = commonDTO.NewBaseResponse(reqDTO.RequestId, err.Message(), err.Code())
+			continue
+		}
+
+		event := requestDTO.AddEventReqToEventModel(reqDTO)
+		events = append(events, event)
+		reqIds = append(reqIds, reqDTO.RequestId)
+		responseIndexes = append(responseIndexes, i)
+		device, ok := devices[reqDTO.Event.DeviceName]
+		if !ok {
+			lc.Warnf("device %s is not found in the active devices, skip publishing the event %s. Correlation-id: %s", reqDTO.Event.DeviceName, reqDTO.Event.Id, correlationId)
+			continue
+		}
+		ec.app.EventReceived(device.ServiceName, event)
+		go ec.app.PublishEvent(reqDTO, device.ServiceName, reqDTO.Event.ProfileName, reqDTO.Event.DeviceName, reqDTO.Event.SourceName, ctx, ec.dic)
+	}
+
+	errs := ec.app.AddEvents(events, ctx, ec.dic)
+	for j, e := range events {
+		i := responseIndexes[j]
+		if errs[j] != nil {
+			lc.Error(errs[j].Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(errs[j].DebugMessages(), common.CorrelationHeader, correlationId)
+			addResponses[i] = commonDTO.NewBaseResponse(reqIds[j], errs[j].Message(), errs[j].Code())
+			continue
+		}
+		addResponses[i] = commonDTO.NewBaseWithIdResponse(reqIds[j], "", http.StatusCreated, e.Id)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
+}
+
+// readRawEvents splits the request body of the array of AddEventRequest into the undecoded data of each request, the body
+// exceeding maxSize kilobytes is responded with the LimitExceeded error
+func readRawEvents(r *http.Request, maxSize int64) ([][]byte, errors.EdgeX) {
+	var rawEvents [][]byte
+	var maxBytesErr *http.MaxBytesError
+	if strings.ToLower(r.Header.Get(common.ContentType)) == common.ContentTypeCBOR {
+		var rawMessages []cbor.RawMessage
+		if err := cbor.NewDecoder(r.Body).Decode(&rawMessages); stdErrs.As(err, &maxBytesErr) {
+			return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request size exceed %d KB", maxSize), err)
+		} else if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "AddEventRequest array cbor decoding failed", err)
+		}
+		for _, m := range rawMessages {
+			rawEvents = append(rawEvents, m)
+		}
+		return rawEvents, nil
+	}
+
+	var rawMessages []json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&rawMessages); stdErrs.As(err, &maxBytesErr) {
+		return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request size exceed %d KB", maxSize), err)
+	} else if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "AddEventRequest array json decoding failed", err)
+	}
+	for _, m := range rawMessages {
+		rawEvents = append(rawEvents, m)
+	}
+	return rawEvents, nil
+}
+
 func (ec *EventController) EventById(c echo.Context) error {
 	// retrieve all the service injections from bootstrap
 	lc := container.LoggingClientFrom(ec.dic.Get)
@@ -222,6 +338,9 @@ func (ec *EventController) AllEvents(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	if cursor, ok := parseCursor(c); ok {
+		return ec.eventsByCursor(c, dataModels.CursorQuery{Start: 0, End: math.MaxInt64, Cursor: cursor, Limit: limit})
+	}
 	events, totalCount, err := ec.app.AllEvents(offset, limit, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -245,6 +364,9 @@ func (ec *EventController) EventsByDeviceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	if cursor, ok := parseCursor(c); ok {
+		return ec.eventsByCursor(c, dataModels.CursorQuery{DeviceName: name, Start: 0, End: math.MaxInt64, Cursor: cursor, Limit: limit})
+	}
 	events, totalCount, err := ec.app.EventsByDeviceName(offset, limit, name, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -287,6 +409,9 @@ func (ec *EventController) EventsByTimeRange(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	if cursor, ok := parseCursor(c); ok {
+		return ec.eventsByCursor(c, dataModels.CursorQuery{Start: start, End: end, Cursor: cursor, Limit: limit})
+	}
 	events, totalCount, err := ec.app.EventsByTimeRange(start, end, offset, limit, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -10,12 +10,14 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -29,9 +31,11 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/cache"
 
 	"github.com/labstack/echo/v4"
 )
@@ -363,6 +367,169 @@ func TestAddEventSize(t *testing.T) {
 	}
 }
 
+func TestAddEvents(t *testing.T) {
+	validRequest := testAddEvent
+	failedRequest := testAddEvent
+	failedRequest.Event.Id = uuid.New().String()
+	unknownDeviceRequest := testAddEvent
+	unknownDeviceRequest.Event.Id = uuid.New().String()
+	unknownDeviceRequest.Event.DeviceName = "unknownDevice"
+	for i := range unknownDeviceRequest.Event.Readings {
+		unknownDeviceRequest.Event.Readings[i].DeviceName = unknownDeviceRequest.Event.DeviceName
+	}
+	invalidRequest := testAddEvent
+	invalidRequest.Event.Id = ""
+	largeRequest := testAddEvent
+	largeRequest.Event.Id = uuid.New().String()
+	largeRequest.Event.Readings = []dtos.BaseReading{{
+		DeviceName:   TestDeviceName,
+		ResourceName: TestDeviceResourceName,
+		ProfileName:  TestDeviceProfileName,
+		Origin:       TestOriginTime,
+		ValueType:    common.ValueTypeBinary,
+		BinaryReading: dtos.BinaryReading{
+			BinaryValue: make([]byte, 2048),
+			MediaType:   TestBinaryReadingMediaType,
+		},
+	}}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvents", mock.MatchedBy(func(events []models.Event) bool {
+		return slices.ContainsFunc(events, func(e models.Event) bool { return e.Id == failedRequest.Event.Id })
+	})).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to insert events in batch", nil))
+	dbClientMock.On("AddEvents", mock.Anything).Return(nil, nil)
+	dbClientMock.On("AddEvent", mock.MatchedBy(func(e models.Event) bool { return e.Id == failedRequest.Event.Id })).
+		Return(models.Event{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to insert event", nil))
+	dbClientMock.On("AddEvent", mock.Anything).Return(persistedEvent, nil)
+
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	deviceStore := cache.DeviceStore(dic)
+	deviceStore.Add(models.Device{Name: TestDeviceName, ServiceName: TestServiceName})
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.DeviceStoreInterfaceName: func(get di.Get) interface{} {
+			return deviceStore
+		},
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				MaxEventSize: 1,
+				Writable: config.WritableInfo{
+					PersistData: true,
+				},
+			}
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	ec := NewEventController(dic)
+
+	tests := []struct {
+		Name                string
+		Requests            []requests.AddEventRequest
+		RequestContentType  string
+		ExpectedStatusCode  int
+		ExpectedStatusCodes []int
+	}{
+		{"Valid - AddEventRequests JSON", []requests.AddEventRequest{validRequest, unknownDeviceRequest}, common.ContentTypeJSON, http.StatusMultiStatus, []int{http.StatusCreated, http.StatusCreated}},
+		{"Valid - AddEventRequests CBOR", []requests.AddEventRequest{validRequest, unknownDeviceRequest}, common.ContentTypeCBOR, http.StatusMultiStatus, []int{http.StatusCreated, http.StatusCreated}},
+		{"Valid - AddEventRequests with persistence failure", []requests.AddEventRequest{validRequest, failedRequest}, common.ContentTypeJSON, http.StatusMultiStatus, []int{http.StatusCreated, http.StatusInternalServerError}},
+		{"Valid - AddEventRequests with invalid event", []requests.AddEventRequest{validRequest, invalidRequest}, common.ContentTypeJSON, http.StatusMultiStatus, []int{http.StatusCreated, http.StatusBadRequest}},
+		{"Valid - AddEventRequests CBOR with invalid event", []requests.AddEventRequest{invalidRequest, validRequest}, common.ContentTypeCBOR, http.StatusMultiStatus, []int{http.StatusBadRequest, http.StatusCreated}},
+		{"Valid - AddEventRequests with event exceeding MaxEventSize", []requests.AddEventRequest{largeRequest, validRequest}, common.ContentTypeJSON, http.StatusMultiStatus, []int{http.StatusRequestEntityTooLarge, http.StatusCreated}},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.Name, func(t *testing.T) {
+			e := echo.New()
+			byteData, err := toByteArray(testCase.RequestContentType, testCase.Requests)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(byteData))
+			req, err := http.NewRequest(http.MethodPost, constants.ApiEventBatchRoute, reader)
+			req.Header.Set(common.ContentType, testCase.RequestContentType)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = ec.AddEvents(c)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.ExpectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.ExpectedStatusCode != http.StatusMultiStatus {
+				return
+			}
+			var actualResponses []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &actualResponses)
+			require.NoError(t, err)
+			require.Len(t, actualResponses, len(testCase.ExpectedStatusCodes))
+			for i, res := range actualResponses {
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.ExpectedStatusCodes[i], int(res.StatusCode), "BaseResponse status code not as expected")
+				if res.StatusCode == http.StatusCreated {
+					assert.Equal(t, testCase.Requests[i].Event.Id, res.Id, "Event Id not as expected")
+				}
+			}
+		})
+	}
+}
+
+func TestAddEventsExceedingMaxEventBatchSize(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return &dbMock.DBClient{}
+		},
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				MaxEventBatchSize: 1,
+				Writable: config.WritableInfo{
+					PersistData: true,
+				},
+			}
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	ec := NewEventController(dic)
+
+	largeRequests := make([]requests.AddEventRequest, 10)
+	for i := range largeRequests {
+		largeRequests[i] = testAddEvent
+		largeRequests[i].Event.Id = uuid.New().String()
+	}
+	byteData, err := toByteArray(common.ContentTypeJSON, largeRequests)
+	require.NoError(t, err)
+
+	tests := []struct {
+		Name          string
+		ContentLength int64
+	}{
+		{"Invalid - request with content length exceeding MaxEventBatchSize", int64(len(byteData))},
+		{"Invalid - request without content length exceeding MaxEventBatchSize", -1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.Name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiEventBatchRoute, strings.NewReader(string(byteData)))
+			require.NoError(t, err)
+			req.Header.Set(common.ContentType, common.ContentTypeJSON)
+			req.ContentLength = testCase.ContentLength
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = ec.AddEvents(c)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
 func TestEventById(t *testing.T) {
 	validEventId := expectedEventId
 	emptyEventId := ""
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,15 +9,22 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -48,8 +55,18 @@ func (rc *ReadingController) ReadingTotalCount(c echo.Context) error {
 	w := c.Response()
 	ctx := r.Context()
 
+	valueFilter, err := parseReadingValueFilter(c, "", "", 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
 	// Count readings
-	count, err := application.ReadingTotalCount(rc.dic)
+	var count uint32
+	if valueFilter != nil {
+		count, err = application.ReadingCountByValueFilter(*valueFilter, rc.dic)
+	} else {
+		count, err = application.ReadingTotalCount(rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -71,7 +88,20 @@ func (rc *ReadingController) AllReadings(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	readings, totalCount, err := application.AllReadings(offset, limit, rc.dic)
+	valueFilter, err := parseReadingValueFilter(c, "", "", 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cursor, ok := parseCursor(c); ok {
+		return rc.readingsByCursor(c, valueFilter, dataModels.CursorQuery{Start: 0, End: math.MaxInt64, Cursor: cursor, Limit: limit})
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if valueFilter != nil {
+		readings, totalCount, err = application.ReadingsByValueFilter(*valueFilter, offset, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.AllReadings(offset, limit, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -93,7 +123,20 @@ func (rc *ReadingController) ReadingsByTimeRange(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	readings, totalCount, err := application.ReadingsByTimeRange(start, end, offset, limit, rc.dic)
+	valueFilter, err := parseReadingValueFilter(c, "", "", start, end)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cursor, ok := parseCursor(c); ok {
+		return rc.readingsByCursor(c, valueFilter, dataModels.CursorQuery{Start: start, End: end, Cursor: cursor, Limit: limit})
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if valueFilter != nil {
+		readings, totalCount, err = application.ReadingsByValueFilter(*valueFilter, offset, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByTimeRange(start, end, offset, limit, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -117,7 +160,20 @@ func (rc *ReadingController) ReadingsByResourceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	readings, totalCount, err := application.ReadingsByResourceName(offset, limit, resourceName, rc.dic)
+	valueFilter, err := parseReadingValueFilter(c, "", resourceName, 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cursor, ok := parseCursor(c); ok {
+		return rc.readingsByCursor(c, valueFilter, dataModels.CursorQuery{ResourceName: resourceName, Start: 0, End: math.MaxInt64, Cursor: cursor, Limit: limit})
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if valueFilter != nil {
+		readings, totalCount, err = application.ReadingsByValueFilter(*valueFilter, offset, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByResourceName(offset, limit, resourceName, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -141,7 +197,20 @@ func (rc *ReadingController) ReadingsByDeviceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	readings, totalCount, err := application.ReadingsByDeviceName(offset, limit, name, rc.dic)
+	valueFilter, err := parseReadingValueFilter(c, name, "", 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cursor, ok := parseCursor(c); ok {
+		return rc.readingsByCursor(c, valueFilter, dataModels.CursorQuery{DeviceName: name, Start: 0, End: math.MaxInt64, Cursor: cursor, Limit: limit})
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if valueFilter != nil {
+		readings, totalCount, err = application.ReadingsByValueFilter(*valueFilter, offset, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByDeviceName(offset, limit, name, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -161,8 +230,18 @@ func (rc *ReadingController) ReadingCountByDeviceName(c echo.Context) error {
 	// URL parameters
 	deviceName := c.Param(common.Name)
 
+	valueFilter, err := parseReadingValueFilter(c, deviceName, "", 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
 	// Count the event by device
-	count, err := application.ReadingCountByDeviceName(deviceName, rc.dic)
+	var count uint32
+	if valueFilter != nil {
+		count, err = application.ReadingCountByValueFilter(*valueFilter, rc.dic)
+	} else {
+		count, err = application.ReadingCountByDeviceName(deviceName, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -187,7 +266,20 @@ func (rc *ReadingController) ReadingsByResourceNameAndTimeRange(c echo.Context)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	readings, totalCount, err := application.ReadingsByResourceNameAndTimeRange(resourceName, start, end, offset, limit, rc.dic)
+	valueFilter, err := parseReadingValueFilter(c, "", resourceName, start, end)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cursor, ok := parseCursor(c); ok {
+		return rc.readingsByCursor(c, valueFilter, dataModels.CursorQuery{ResourceName: resourceName, Start: start, End: end, Cursor: cursor, Limit: limit})
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if valueFilter != nil {
+		readings, totalCount, err = application.ReadingsByValueFilter(*valueFilter, offset, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByResourceNameAndTimeRange(resourceName, start, end, offset, limit, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -212,7 +304,20 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceName(c echo.Context)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	readings, totalCount, err := application.ReadingsByDeviceNameAndResourceName(deviceName, resourceName, offset, limit, rc.dic)
+	valueFilter, err := parseReadingValueFilter(c, deviceName, resourceName, 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cursor, ok := parseCursor(c); ok {
+		return rc.readingsByCursor(c, valueFilter, dataModels.CursorQuery{DeviceName: deviceName, ResourceName: resourceName, Start: 0, End: math.MaxInt64, Cursor: cursor, Limit: limit})
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if valueFilter != nil {
+		readings, totalCount, err = application.ReadingsByValueFilter(*valueFilter, offset, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByDeviceNameAndResourceName(deviceName, resourceName, offset, limit, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -238,7 +343,20 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceNameAndTimeRange(c e
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
-	readings, totalCount, err := application.ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, offset, limit, rc.dic)
+	valueFilter, err := parseReadingValueFilter(c, deviceName, resourceName, start, end)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cursor, ok := parseCursor(c); ok {
+		return rc.readingsByCursor(c, valueFilter, dataModels.CursorQuery{DeviceName: deviceName, ResourceName: resourceName, Start: start, End: end, Cursor: cursor, Limit: limit})
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if valueFilter != nil {
+		readings, totalCount, err = application.ReadingsByValueFilter(*valueFilter, offset, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, offset, limit, rc.dic)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -295,3 +413,142 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceNamesAndTimeRange(c
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange returns the statistics of the numeric readings by device name, resource name
+// and specified time range, the readings are grouped into the buckets of the interval specified in the query string
+func (rc *ReadingController) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	deviceName := c.Param(common.Name)
+	resourceName := c.Param(common.ResourceName)
+
+	start, err := utils.ParsePathParamToInt64(c, common.Start, 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	end, err := utils.ParsePathParamToInt64(c, common.End, 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if end < start {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("start's value %v is not allowed to be greater than end's value %v", start, end), nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	intervalStr := utils.ParseQueryStringToString(r, common.Interval, "")
+	if intervalStr == "" {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("query parameter %s is required", common.Interval), nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	interval, parseErr := time.ParseDuration(intervalStr)
+	if parseErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse %s '%s'", common.Interval, intervalStr), parseErr)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	aggregates, err := application.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, interval, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := dataResponses.NewMultiReadingAggregatesResponse("", "", http.StatusOK, aggregates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange returns the reading rollups of the tier interval specified in the query
+// string by device name, resource name and specified time range
+func (rc *ReadingController) ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(rc.dic.Get)
+
+	deviceName := c.Param(common.Name)
+	resourceName := c.Param(common.ResourceName)
+
+	// parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseTimeRangeOffsetLimit(c, minOffset, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	intervalStr := utils.ParseQueryStringToString(r, common.Interval, "")
+	if intervalStr == "" {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("query parameter %s is required", common.Interval), nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	interval, parseErr := time.ParseDuration(intervalStr)
+	if parseErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse %s '%s'", common.Interval, intervalStr), parseErr)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	rollups, err := application.ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, interval, start, end, offset, limit, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := dataResponses.NewMultiReadingAggregatesResponse("", "", http.StatusOK, rollups)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// parseReadingValueFilter parses the valueGt, valueLt, valueEq and valueBetween query strings into the ReadingValueFilter of the
+// specified device, resource and time range, or returns nil if none of them is specified. At most one of them is allowed, and the
+// valueBetween is specified as the comma-separated inclusive lower and upper values.
+func parseReadingValueFilter(c echo.Context, deviceName string, resourceName string, start int64, end int64) (*dataModels.ReadingValueFilter, errors.EdgeX) {
+	params := []struct {
+		name     string
+		operator string
+	}{
+		{constants.ValueGreaterThan, dataModels.ValueOperatorGreaterThan},
+		{constants.ValueLessThan, dataModels.ValueOperatorLessThan},
+		{constants.ValueEqual, dataModels.ValueOperatorEqual},
+		{constants.ValueBetween, dataModels.ValueOperatorBetween},
+	}
+
+	var filter *dataModels.ReadingValueFilter
+	for _, param := range params {
+		valueStr := c.QueryParam(param.name)
+		if valueStr == "" {
+			continue
+		}
+		if filter != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("only one of %s, %s, %s and %s can be specified", constants.ValueGreaterThan, constants.ValueLessThan, constants.ValueEqual, constants.ValueBetween), nil)
+		}
+
+		valueStrs := strings.Split(valueStr, common.CommaSeparator)
+		expectedCount := 1
+		if param.operator == dataModels.ValueOperatorBetween {
+			expectedCount = 2
+		}
+		if len(valueStrs) != expectedCount {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s '%s' should contain %d numeric value(s)", param.name, valueStr, expectedCount), nil)
+		}
+		values := make([]float64, expectedCount)
+		for i, v := range valueStrs {
+			value, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse %s '%s' as a finite number", param.name, v), err)
+			}
+			values[i] = value
+		}
+
+		filter = &dataModels.ReadingValueFilter{
+			DeviceName:   deviceName,
+			ResourceName: resourceName,
+			Start:        start,
+			End:          end,
+			Operator:     param.operator,
+			Value:        values[0],
+		}
+		if param.operator == dataModels.ValueOperatorBetween {
+			filter.UpperValue = values[1]
+		}
+	}
+	return filter, nil
+}
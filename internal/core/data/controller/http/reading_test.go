@@ -3,6 +3,7 @@ package http
 import (
 	"encoding/json"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,9 +13,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataResponses "github.com/edgexfoundry/edgex-go/internal/core/data/dtos/responses"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -25,8 +29,11 @@ import (
 
 func TestReadingTotalCount(t *testing.T) {
 	expectedReadingCount := uint32(656672)
+	expectedFilteredCount := uint32(12)
+	valueFilter := dataModels.ReadingValueFilter{Start: 0, End: math.MaxInt64, Operator: dataModels.ValueOperatorGreaterThan, Value: 80}
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("ReadingTotalCount").Return(expectedReadingCount, nil)
+	dbClientMock.On("ReadingCountByValueFilter", valueFilter).Return(expectedFilteredCount, nil)
 
 	dic := mocks.NewMockDIC()
 	dic.Update(di.ServiceConstructorMap{
@@ -36,23 +43,40 @@ func TestReadingTotalCount(t *testing.T) {
 	})
 	rc := NewReadingController(dic)
 
-	e := echo.New()
-	req, err := http.NewRequest(http.MethodGet, common.ApiReadingCountRoute, http.NoBody)
-	require.NoError(t, err)
+	tests := []struct {
+		name          string
+		valueFilters  map[string]string
+		expectedCount uint32
+	}{
+		{"Valid - all readings", nil, expectedReadingCount},
+		{"Valid - with value filter", map[string]string{constants.ValueGreaterThan: "80"}, expectedFilteredCount},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiReadingCountRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			for key, value := range testCase.valueFilters {
+				query.Add(key, value)
+			}
+			req.URL.RawQuery = query.Encode()
 
-	recorder := httptest.NewRecorder()
-	c := e.NewContext(req, recorder)
-	err = rc.ReadingTotalCount(c)
-	require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = rc.ReadingTotalCount(c)
+			require.NoError(t, err)
 
-	var actualResponse commonDTO.CountResponse
-	err = json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
-	require.NoError(t, err)
-	assert.Equal(t, common.ApiVersion, actualResponse.ApiVersion, "API Version not as expected")
-	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
-	assert.Equal(t, http.StatusOK, int(actualResponse.StatusCode), "Response status code not as expected")
-	assert.Empty(t, actualResponse.Message, "Message should be empty when it is successful")
-	assert.Equal(t, expectedReadingCount, actualResponse.Count, "Event count in the response body is not expected")
+			var actualResponse commonDTO.CountResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, actualResponse.ApiVersion, "API Version not as expected")
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, http.StatusOK, int(actualResponse.StatusCode), "Response status code not as expected")
+			assert.Empty(t, actualResponse.Message, "Message should be empty when it is successful")
+			assert.Equal(t, testCase.expectedCount, actualResponse.Count, "Reading count in the response body is not expected")
+		})
+	}
 }
 
 func TestAllReadings(t *testing.T) {
@@ -712,3 +736,223 @@ func TestReadingsByDeviceNameAndResourceNamesAndTimeRange(t *testing.T) {
 		})
 	}
 }
+
+func TestReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	aggregates := []dataModels.ReadingAggregate{
+		{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 0, Interval: 60000000000, Count: 2, Min: 1, Max: 3, Avg: 2, First: 1, Last: 3},
+	}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, int64(0), int64(100000000000), int64(60000000000)).Return(aggregates, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		start              string
+		end                string
+		interval           string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "1m", false, len(aggregates), http.StatusOK},
+		{"Invalid - empty deviceName", "", TestDeviceResourceName, "0", "100000000000", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - empty resourceName", TestDeviceName, "", "0", "100000000000", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - invalid start format", TestDeviceName, TestDeviceResourceName, "aaa", "100000000000", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - invalid end format", TestDeviceName, TestDeviceResourceName, "0", "bbb", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - end before start", TestDeviceName, TestDeviceResourceName, "10", "0", "1m", true, 0, http.StatusBadRequest},
+		{"Invalid - empty interval", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "", true, 0, http.StatusBadRequest},
+		{"Invalid - invalid interval format", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "abc", true, 0, http.StatusBadRequest},
+		{"Invalid - negative interval", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "-1m", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.interval != "" {
+				query.Add(common.Interval, testCase.interval)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.ResourceName, common.Start, common.End)
+			c.SetParamValues(testCase.deviceName, testCase.resourceName, testCase.start, testCase.end)
+			err = rc.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataResponses.MultiReadingAggregatesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Len(t, res.Aggregates, testCase.expectedCount, "Aggregate count not as expected")
+			}
+		})
+	}
+}
+
+func TestReadingRollupsByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	rollups := []dataModels.ReadingAggregate{
+		{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 0, Interval: 3600000000000, Count: 2, Min: 1, Max: 3, Avg: 2, First: 1, Last: 3},
+	}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingRollupsByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, int64(3600000000000), int64(0), int64(100000000000), 0, 20).Return(rollups, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		start              string
+		end                string
+		interval           string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "1h", false, len(rollups), http.StatusOK},
+		{"Invalid - empty deviceName", "", TestDeviceResourceName, "0", "100000000000", "1h", true, 0, http.StatusBadRequest},
+		{"Invalid - empty resourceName", TestDeviceName, "", "0", "100000000000", "1h", true, 0, http.StatusBadRequest},
+		{"Invalid - invalid start format", TestDeviceName, TestDeviceResourceName, "aaa", "100000000000", "1h", true, 0, http.StatusBadRequest},
+		{"Invalid - end before start", TestDeviceName, TestDeviceResourceName, "10", "0", "1h", true, 0, http.StatusBadRequest},
+		{"Invalid - empty interval", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "", true, 0, http.StatusBadRequest},
+		{"Invalid - invalid interval format", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "abc", true, 0, http.StatusBadRequest},
+		{"Invalid - negative interval", TestDeviceName, TestDeviceResourceName, "0", "100000000000", "-1h", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiReadingRollupByDeviceNameAndResourceNameAndTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.interval != "" {
+				query.Add(common.Interval, testCase.interval)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.ResourceName, common.Start, common.End)
+			c.SetParamValues(testCase.deviceName, testCase.resourceName, testCase.start, testCase.end)
+			err = rc.ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataResponses.MultiReadingAggregatesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Len(t, res.Aggregates, testCase.expectedCount, "Rollup count not as expected")
+			}
+		})
+	}
+}
+
+func TestReadingsByValueFilter(t *testing.T) {
+	totalCount := uint32(1)
+	greaterThanFilter := dataModels.ReadingValueFilter{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 0, End: 100, Operator: dataModels.ValueOperatorGreaterThan, Value: 80}
+	betweenFilter := dataModels.ReadingValueFilter{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 0, End: 100, Operator: dataModels.ValueOperatorBetween, Value: 10.5, UpperValue: 20}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByValueFilter", greaterThanFilter, 0, 10).Return(totalCount, []models.Reading{}, nil)
+	dbClientMock.On("ReadingsByValueFilter", betweenFilter, 0, 10).Return(totalCount, []models.Reading{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		valueFilters       map[string]string
+		expectedStatusCode int
+	}{
+		{"Valid - greater than", map[string]string{constants.ValueGreaterThan: "80"}, http.StatusOK},
+		{"Valid - between", map[string]string{constants.ValueBetween: "10.5,20"}, http.StatusOK},
+		{"Invalid - multiple value filters", map[string]string{constants.ValueGreaterThan: "80", constants.ValueLessThan: "90"}, http.StatusBadRequest},
+		{"Invalid - non-numeric value", map[string]string{constants.ValueEqual: "abc"}, http.StatusBadRequest},
+		{"Invalid - NaN value", map[string]string{constants.ValueLessThan: "NaN"}, http.StatusBadRequest},
+		{"Invalid - between with single value", map[string]string{constants.ValueBetween: "10"}, http.StatusBadRequest},
+		{"Invalid - between lower greater than upper", map[string]string{constants.ValueBetween: "20,10"}, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, "0")
+			query.Add(common.Limit, "10")
+			for key, value := range testCase.valueFilters {
+				query.Add(key, value)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.ResourceName, common.Start, common.End)
+			c.SetParamValues(TestDeviceName, TestDeviceResourceName, "0", "100")
+			err = rc.ReadingsByDeviceNameAndResourceNameAndTimeRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res responseDTO.MultiReadingsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, totalCount, res.TotalCount, "Total count not as expected")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
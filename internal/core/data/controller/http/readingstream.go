@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	contentTypeEventStream = "text/event-stream"
+
+	readingStreamEventReading = "reading"
+	readingStreamEventDropped = "dropped"
+)
+
+// StreamReadings streams the readings received from the message bus which match the query filters as Server-Sent Events
+// until the client disconnects. A "dropped" event reports the number of the readings dropped because the client fell behind.
+func (rc *ReadingController) StreamReadings(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	filter, err := parseReadingStreamFilter(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	hub, err := application.CoreDataAppFrom(rc.dic.Get).ReadingStreamHub()
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	sub, err := hub.Subscribe(filter)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	defer hub.Unsubscribe(sub)
+
+	w.Header().Set(common.CorrelationHeader, correlation.FromContext(ctx))
+	w.Header().Set(common.ContentType, contentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	keepAlive := time.NewTicker(hub.KeepAlive())
+	defer keepAlive.Stop()
+	for {
+		var writeErr error
+		select {
+		case <-ctx.Done():
+			return nil
+		case reading := <-sub.Readings():
+			writeErr = writeReadingStreamEvents(w, sub, &reading)
+		case <-keepAlive.C:
+			writeErr = writeReadingStreamEvents(w, sub, nil)
+		}
+		if writeErr != nil {
+			lc.Debugf("Reading stream closed, %v", writeErr)
+			return nil
+		}
+	}
+}
+
+// writeReadingStreamEvents writes the dropped count if any and then the reading, or a keep-alive comment if there is
+// nothing to write
+func writeReadingStreamEvents(w *echo.Response, sub *application.ReadingStreamSubscription, reading *dtos.BaseReading) error {
+	var sb strings.Builder
+	if dropped := sub.Dropped(); dropped > 0 {
+		sb.WriteString(fmt.Sprintf("event: %s\ndata: {\"count\":%d}\n\n", readingStreamEventDropped, dropped))
+	}
+	if reading != nil {
+		data, err := json.Marshal(reading)
+		if err != nil {
+			return err
+		}
+		sb.WriteString(fmt.Sprintf("event: %s\ndata: %s\n\n", readingStreamEventReading, data))
+	}
+	if sb.Len() == 0 {
+		sb.WriteString(": keep-alive\n\n")
+	}
+
+	if _, err := w.Write([]byte(sb.String())); err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
+
+// parseReadingStreamFilter parses the comma-separated deviceName, profileName, resourceName and tags query strings, the tags
+// are specified as key:value pairs
+func parseReadingStreamFilter(c echo.Context) (application.ReadingStreamFilter, errors.EdgeX) {
+	filter := application.ReadingStreamFilter{
+		DeviceNames:   utils.ParseQueryStringToStrings(c, common.DeviceName, common.CommaSeparator),
+		ProfileNames:  utils.ParseQueryStringToStrings(c, common.ProfileName, common.CommaSeparator),
+		ResourceNames: utils.ParseQueryStringToStrings(c, common.ResourceName, common.CommaSeparator),
+	}
+
+	tags := utils.ParseQueryStringToStrings(c, constants.Tags, common.CommaSeparator)
+	if len(tags) > 0 {
+		filter.Tags = make(map[string]string, len(tags))
+	}
+	for _, tag := range tags {
+		key, value, found := strings.Cut(tag, ":")
+		if !found || key == "" {
+			return filter, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid tag '%s', the tag should be specified as key:value", tag), nil)
+		}
+		filter.Tags[key] = value
+	}
+	return filter, nil
+}
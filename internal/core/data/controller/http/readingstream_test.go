@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+
+	"github.com/labstack/echo/v4"
+)
+
+func mockReadingStreamDic(streamInfo config.ReadingStreamInfo) (*di.Container, *application.CoreDataApp) {
+	dic := mocks.NewMockDIC()
+	container.ConfigurationFrom(dic.Get).ReadingStream = streamInfo
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	return dic, app
+}
+
+func TestStreamReadings(t *testing.T) {
+	dic, app := mockReadingStreamDic(config.ReadingStreamInfo{Enabled: true, MaxConnections: 1, BufferSize: 10, KeepAlive: "1h"})
+	e := echo.New()
+	e.GET(constants.ApiReadingStreamRoute, NewReadingController(dic).StreamReadings)
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+constants.ApiReadingStreamRoute+"?"+common.ResourceName+"=Resource2&"+constants.Tags+"=site:A", http.NoBody)
+	require.NoError(t, err)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, "text/event-stream", res.Header.Get(common.ContentType), "Content type not as expected")
+
+	hub, err := app.ReadingStreamHub()
+	require.NoError(t, err)
+	require.Equal(t, 1, hub.Connections(), "Connections not as expected")
+
+	event := dtos.NewEvent(TestProfileName, TestDeviceName, TestSourceName)
+	event.Tags = dtos.Tags{"site": "A"}
+	_ = event.AddSimpleReading("Resource1", common.ValueTypeInt32, int32(1))
+	_ = event.AddSimpleReading("Resource2", common.ValueTypeInt32, int32(2))
+	app.PublishReadings(event)
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+	var received []string
+	for len(received) < 2 {
+		select {
+		case line := <-lines:
+			if line != "" {
+				received = append(received, line)
+			}
+		case <-time.After(time.Second):
+			require.Fail(t, "timed out waiting for the streamed reading")
+		}
+	}
+	assert.Equal(t, "event: reading", received[0], "Event type not as expected")
+	var reading dtos.BaseReading
+	err = json.Unmarshal([]byte(strings.TrimPrefix(received[1], "data: ")), &reading)
+	require.NoError(t, err)
+	assert.Equal(t, "Resource2", reading.ResourceName, "Streamed reading not as expected")
+	assert.Equal(t, "2", reading.Value, "Streamed reading not as expected")
+
+	// the subscription is removed once the client disconnects
+	cancel()
+	assert.Eventually(t, func() bool { return hub.Connections() == 0 }, time.Second, 10*time.Millisecond, "the subscription should be removed")
+}
+
+func TestStreamReadings_Rejected(t *testing.T) {
+	tests := []struct {
+		name               string
+		streamInfo         config.ReadingStreamInfo
+		query              string
+		occupied           bool
+		expectedStatusCode int
+	}{
+		{"Invalid - reading stream not enabled", config.ReadingStreamInfo{}, "", false, http.StatusServiceUnavailable},
+		{"Invalid - tag without value", config.ReadingStreamInfo{Enabled: true, MaxConnections: 1, BufferSize: 1, KeepAlive: "1h"}, constants.Tags + "=site", false, http.StatusBadRequest},
+		{"Invalid - maximum connections reached", config.ReadingStreamInfo{Enabled: true, MaxConnections: 1, BufferSize: 1, KeepAlive: "1h"}, "", true, http.StatusServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic, app := mockReadingStreamDic(testCase.streamInfo)
+			if testCase.occupied {
+				hub, err := app.ReadingStreamHub()
+				require.NoError(t, err)
+				_, err = hub.Subscribe(application.ReadingStreamFilter{})
+				require.NoError(t, err)
+			}
+
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiReadingStreamRoute+"?"+testCase.query, http.NoBody)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = NewReadingController(dic).StreamReadings(c)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
@@ -25,7 +25,8 @@ import (
 
 // SubscribeEvents subscribes to events from message bus
 func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
-	messageBusInfo := dataContainer.ConfigurationFrom(dic.Get).MessageBus
+	configuration := dataContainer.ConfigurationFrom(dic.Get)
+	messageBusInfo := configuration.MessageBus
 	lc := container.LoggingClientFrom(dic.Get)
 
 	messageBus := container.MessagingClientFrom(dic.Get)
@@ -35,6 +36,18 @@ func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
 
 	app := application.CoreDataAppFrom(dic.Get)
 
+	// group the received events into batches to reduce the database writes
+	var batcher *application.EventBatcher
+	if configuration.EventBatch.Enabled {
+		var edgexErr errors.EdgeX
+		batcher, edgexErr = application.NewEventBatcher(app, configuration.EventBatch, dic)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		go batcher.Run(ctx)
+		lc.Infof("Persisting the events received from MessageBus in batches of up to %d events or every %s", configuration.EventBatch.MaxSize, configuration.EventBatch.MaxWait)
+	}
+
 	subscribeTopic := common.BuildTopic(messageBusInfo.GetBaseTopicPrefix(), common.CoreDataEventSubscribeTopic)
 
 	topics := []types.TopicChannel{
@@ -70,12 +83,21 @@ func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
 					lc.Errorf("fail to unmarshal event, %v", err)
 					break
 				}
-				err = validateEvent(msgEnvelope.ReceivedTopic, event.Event)
+				var serviceName string
+				serviceName, err = validateEvent(msgEnvelope.ReceivedTopic, event.Event)
 				if err != nil {
+					app.EventValidationFailed()
 					lc.Error(err.Error())
 					break
 				}
-				err = app.AddEvent(requests.AddEventReqToEventModel(event), ctx, dic)
+				eventModel := requests.AddEventReqToEventModel(event)
+				app.EventReceived(serviceName, eventModel)
+				app.PublishReadings(event.Event)
+				if batcher != nil {
+					batcher.Add(ctx, eventModel)
+					break
+				}
+				err = app.AddEvent(eventModel, ctx, dic)
 				if err != nil {
 					lc.Errorf("fail to persist the event, %v", err)
 				}
@@ -86,38 +108,43 @@ func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
 	return nil
 }
 
-func validateEvent(messageTopic string, e dtos.Event) errors.EdgeX {
+// validateEvent checks whether the event matches the message topic, and returns the device service name in the topic
+func validateEvent(messageTopic string, e dtos.Event) (string, errors.EdgeX) {
 	// Parse messageTopic by the pattern `edgex/events/device/<device-service-name>/<device-profile-name>/<device-name>/<source-name>`
 	fields := strings.Split(messageTopic, "/")
 
 	// assumes a non-empty base topic with events/device/<device-service-name>/<device-profile-name>/<device-name>/<source-name>
 	if len(fields) < 6 {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid message topic %s", messageTopic), nil)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid message topic %s", messageTopic), nil)
 	}
 
 	len := len(fields)
+	serviceName, err := url.PathUnescape(fields[len-4])
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 	profileName, err := url.PathUnescape(fields[len-3])
 	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 	deviceName, err := url.PathUnescape(fields[len-2])
 	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 	sourceName, err := url.PathUnescape(fields[len-1])
 	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 
 	// Check whether the event fields match the message topic
 	if e.ProfileName != profileName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's profileName %s mismatches with the name %s received in topic", e.ProfileName, profileName), nil)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's profileName %s mismatches with the name %s received in topic", e.ProfileName, profileName), nil)
 	}
 	if e.DeviceName != deviceName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's deviceName %s mismatches with the name %s received in topic", e.DeviceName, deviceName), nil)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's deviceName %s mismatches with the name %s received in topic", e.DeviceName, deviceName), nil)
 	}
 	if e.SourceName != sourceName {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's sourceName %s mismatches with the name %s received in topic", e.SourceName, sourceName), nil)
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event's sourceName %s mismatches with the name %s received in topic", e.SourceName, sourceName), nil)
 	}
-	return nil
+	return serviceName, nil
 }
@@ -95,6 +95,7 @@ func deviceSystemEventAction(systemEvent dtos.SystemEvent, dic *di.Container) er
 
 	case common.SystemEventActionDelete:
 		deviceStore.Remove(device.Name)
+		application.CoreDataAppFrom(dic.Get).RemoveDeviceMetrics(device.Name)
 
 		if !dataContainer.ConfigurationFrom(dic.Get).Writable.EventPurge {
 			return nil
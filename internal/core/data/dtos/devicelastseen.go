@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// DeviceLastSeen defines the latest event received from a device
+type DeviceLastSeen struct {
+	DeviceName  string `json:"deviceName"`
+	ServiceName string `json:"serviceName,omitempty"`
+	SourceName  string `json:"sourceName"`
+	Origin      int64  `json:"origin"`
+	LastSeen    int64  `json:"lastSeen"`
+}
+
+// FromDeviceLastSeenModelToDTO transforms the DeviceLastSeen Model to the DeviceLastSeen DTO
+func FromDeviceLastSeenModelToDTO(d models.DeviceLastSeen) DeviceLastSeen {
+	return DeviceLastSeen{
+		DeviceName:  d.DeviceName,
+		ServiceName: d.ServiceName,
+		SourceName:  d.SourceName,
+		Origin:      d.Origin,
+		LastSeen:    d.LastSeen,
+	}
+}
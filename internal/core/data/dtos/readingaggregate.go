@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// ReadingAggregate defines the statistics of the numeric readings within a time bucket
+type ReadingAggregate struct {
+	DeviceName   string  `json:"deviceName"`
+	ResourceName string  `json:"resourceName"`
+	Start        int64   `json:"start"`
+	Interval     int64   `json:"interval"`
+	Count        uint32  `json:"count"`
+	Min          float64 `json:"min"`
+	Max          float64 `json:"max"`
+	Avg          float64 `json:"avg"`
+	First        float64 `json:"first"`
+	Last         float64 `json:"last"`
+}
+
+// FromReadingAggregateModelToDTO transforms the ReadingAggregate Model to the ReadingAggregate DTO
+func FromReadingAggregateModelToDTO(a models.ReadingAggregate) ReadingAggregate {
+	return ReadingAggregate{
+		DeviceName:   a.DeviceName,
+		ResourceName: a.ResourceName,
+		Start:        a.Start,
+		Interval:     a.Interval,
+		Count:        a.Count,
+		Min:          a.Min,
+		Max:          a.Max,
+		Avg:          a.Avg,
+		First:        a.First,
+		Last:         a.Last,
+	}
+}
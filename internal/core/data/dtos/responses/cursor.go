@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+)
+
+// MultiEventsCursorResponse defines the Response Content for GET multiple Event DTOs with the cursor pagination.
+type MultiEventsCursorResponse struct {
+	common.BaseResponse `json:",inline"`
+	Events              []dtos.Event `json:"events"`
+	// NextCursor continues the query after the last event of this page, it is empty if there are no more events
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiEventsCursorResponse(requestId string, message string, statusCode int, events []dtos.Event, nextCursor string) MultiEventsCursorResponse {
+	return MultiEventsCursorResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Events:       events,
+		NextCursor:   nextCursor,
+	}
+}
+
+// MultiReadingsCursorResponse defines the Response Content for GET multiple Reading DTOs with the cursor pagination.
+type MultiReadingsCursorResponse struct {
+	common.BaseResponse `json:",inline"`
+	Readings            []dtos.BaseReading `json:"readings"`
+	// NextCursor continues the query after the last reading of this page, it is empty if there are no more readings
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiReadingsCursorResponse(requestId string, message string, statusCode int, readings []dtos.BaseReading, nextCursor string) MultiReadingsCursorResponse {
+	return MultiReadingsCursorResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Readings:     readings,
+		NextCursor:   nextCursor,
+	}
+}
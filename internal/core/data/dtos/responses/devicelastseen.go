@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+)
+
+// DeviceLastSeenResponse defines the Response Content for GET DeviceLastSeen DTO.
+type DeviceLastSeenResponse struct {
+	common.BaseResponse `json:",inline"`
+	LastSeen            dtos.DeviceLastSeen `json:"lastSeen"`
+}
+
+func NewDeviceLastSeenResponse(requestId string, message string, statusCode int, lastSeen dtos.DeviceLastSeen) DeviceLastSeenResponse {
+	return DeviceLastSeenResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		LastSeen:     lastSeen,
+	}
+}
+
+// MultiDeviceLastSeenResponse defines the Response Content for GET multiple DeviceLastSeen DTOs.
+type MultiDeviceLastSeenResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	LastSeen                          []dtos.DeviceLastSeen `json:"lastSeen"`
+}
+
+func NewMultiDeviceLastSeenResponse(requestId string, message string, statusCode int, totalCount uint32, lastSeen []dtos.DeviceLastSeen) MultiDeviceLastSeenResponse {
+	return MultiDeviceLastSeenResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		LastSeen:                   lastSeen,
+	}
+}
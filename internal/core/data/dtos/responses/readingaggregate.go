@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+)
+
+// MultiReadingAggregatesResponse defines the Response Content for GET multiple reading aggregate DTO.
+type MultiReadingAggregatesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Aggregates          []dtos.ReadingAggregate `json:"aggregates"`
+}
+
+func NewMultiReadingAggregatesResponse(requestId string, message string, statusCode int, aggregates []dtos.ReadingAggregate) MultiReadingAggregatesResponse {
+	return MultiReadingAggregatesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Aggregates:   aggregates,
+	}
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// StaleDevice defines the details of the stale and recovered system events of a device
+type StaleDevice struct {
+	DeviceName  string `json:"deviceName"`
+	ServiceName string `json:"serviceName"`
+	ProfileName string `json:"profileName"`
+	// Interval is the shortest auto event interval of the device, and the device is stale once no event is received for
+	// MissedIntervals of it
+	Interval        string `json:"interval"`
+	MissedIntervals int    `json:"missedIntervals"`
+	// LastSeen is the time core-data received the latest event of the device in nanoseconds, it is omitted if no event is
+	// received since core-data started
+	LastSeen int64 `json:"lastSeen,omitempty"`
+}
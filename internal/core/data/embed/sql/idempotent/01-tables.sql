@@ -26,6 +26,10 @@ CREATE TABLE IF NOT EXISTS core_data.event (
 CREATE INDEX IF NOT EXISTS idx_event_origin
     ON core_data.event(origin);
 
+-- idx_event_origin_id is used by the cursor pagination which pages the events by the keyset of origin and id
+CREATE INDEX IF NOT EXISTS idx_event_origin_id
+    ON core_data.event(origin, id);
+
 -- core_data.reading is used to store the reading information
 CREATE TABLE IF NOT EXISTS core_data.reading (
     event_id UUID,
@@ -38,3 +42,26 @@ CREATE TABLE IF NOT EXISTS core_data.reading (
 
 CREATE INDEX IF NOT EXISTS idx_reading_origin
     ON core_data.reading(origin);
+
+-- idx_reading_origin_event_id is used by the cursor pagination which pages the readings by the keyset of origin, event_id and device_info_id
+CREATE INDEX IF NOT EXISTS idx_reading_origin_event_id
+    ON core_data.reading(origin, event_id, device_info_id);
+
+-- core_data.reading_rollup is used to store the summary of the numeric readings compacted by the rollup retention,
+-- the readings are grouped into the buckets of bucketinterval nanoseconds, and the average is calculated by sum/count
+CREATE TABLE IF NOT EXISTS core_data.reading_rollup (
+    devicename TEXT NOT NULL,
+    resourcename TEXT NOT NULL,
+    bucketinterval BIGINT NOT NULL,
+    bucket BIGINT NOT NULL,
+    count BIGINT NOT NULL,
+    min DOUBLE PRECISION,
+    max DOUBLE PRECISION,
+    sum DOUBLE PRECISION,
+    first DOUBLE PRECISION,
+    last DOUBLE PRECISION,
+    PRIMARY KEY (devicename, resourcename, bucketinterval, bucket)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reading_rollup_bucket
+    ON core_data.reading_rollup(bucketinterval, bucket);
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,12 +8,15 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 )
 
 type DBClient interface {
 	CloseSession()
 
 	AddEvent(e model.Event) (model.Event, errors.EdgeX)
+	AddEvents(events []model.Event) ([]model.Event, errors.EdgeX)
 	EventById(id string) (model.Event, errors.EdgeX)
 	DeleteEventById(id string) errors.EdgeX
 	EventTotalCount() (uint32, errors.EdgeX)
@@ -25,8 +28,10 @@ type DBClient interface {
 	DeleteEventsByDeviceName(deviceName string) errors.EdgeX
 	DeleteEventsByDeviceNameAndSourceName(deviceName, sourceName string) errors.EdgeX
 	EventsByTimeRange(start int64, end int64, offset int, limit int) ([]model.Event, errors.EdgeX)
+	EventsByCursor(query dataModels.CursorQuery) ([]model.Event, string, errors.EdgeX)
 	DeleteEventsByAge(age int64) errors.EdgeX
 	DeleteEventsByAgeAndDeviceNameAndSourceName(age int64, deviceName, sourceName string) errors.EdgeX
+	RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName(age int64, intervals []int64, deviceName, sourceName string) errors.EdgeX
 	ReadingTotalCount() (uint32, errors.EdgeX)
 	AllReadings(offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByTimeRange(start int64, end int64, offset int, limit int) ([]model.Reading, errors.EdgeX)
@@ -46,6 +51,12 @@ type DBClient interface {
 	ReadingsByDeviceNameAndTimeRange(deviceName string, start int64, end int64, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingCountByDeviceNameAndTimeRange(deviceName string, start int64, end int64) (uint32, errors.EdgeX)
 	LatestReadingByOffset(offset uint32) (model.Reading, errors.EdgeX)
+	ReadingsByValueFilter(filter dataModels.ReadingValueFilter, offset int, limit int) (uint32, []model.Reading, errors.EdgeX)
+	ReadingCountByValueFilter(filter dataModels.ReadingValueFilter) (uint32, errors.EdgeX)
+	ReadingsByCursor(query dataModels.CursorQuery) ([]model.Reading, string, errors.EdgeX)
 	LatestEventByDeviceNameAndSourceNameAndOffset(deviceName string, sourceName string, offset uint32) (model.Event, errors.EdgeX)
 	LatestEventByDeviceNameAndSourceNameAndAgeAndOffset(deviceName string, sourceName string, age int64, offset uint32) (model.Event, errors.EdgeX)
+	ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]dataModels.ReadingAggregate, errors.EdgeX)
+	ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, interval int64, start int64, end int64, offset int, limit int) ([]dataModels.ReadingAggregate, errors.EdgeX)
+	DeleteReadingRollupsByAgeAndInterval(age int64, interval int64) errors.EdgeX
 }
@@ -1,8 +1,9 @@
-// Code generated by mockery v2.49.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
 import (
+	datamodels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -45,6 +46,38 @@ func (_m *DBClient) AddEvent(e models.Event) (models.Event, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddEvents provides a mock function with given fields: events
+func (_m *DBClient) AddEvents(events []models.Event) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEvents")
+	}
+
+	var r0 []models.Event
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]models.Event) ([]models.Event, errors.EdgeX)); ok {
+		return rf(events)
+	}
+	if rf, ok := ret.Get(0).(func([]models.Event) []models.Event); ok {
+		r0 = rf(events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.Event) errors.EdgeX); ok {
+		r1 = rf(events)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllEvents provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllEvents(offset int, limit int) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -109,7 +142,7 @@ func (_m *DBClient) AllReadings(offset int, limit int) ([]models.Reading, errors
 	return r0, r1
 }
 
-// CloseSession provides a mock function with given fields:
+// CloseSession provides a mock function with no fields
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
@@ -214,6 +247,26 @@ func (_m *DBClient) DeleteEventsByDeviceNameAndSourceName(deviceName string, sou
 	return r0
 }
 
+// DeleteReadingRollupsByAgeAndInterval provides a mock function with given fields: age, interval
+func (_m *DBClient) DeleteReadingRollupsByAgeAndInterval(age int64, interval int64) errors.EdgeX {
+	ret := _m.Called(age, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReadingRollupsByAgeAndInterval")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, int64) errors.EdgeX); ok {
+		r0 = rf(age, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // EventById provides a mock function with given fields: id
 func (_m *DBClient) EventById(id string) (models.Event, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -334,7 +387,7 @@ func (_m *DBClient) EventCountByTimeRange(start int64, end int64) (uint32, error
 	return r0, r1
 }
 
-// EventTotalCount provides a mock function with given fields:
+// EventTotalCount provides a mock function with no fields
 func (_m *DBClient) EventTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
 
@@ -364,6 +417,45 @@ func (_m *DBClient) EventTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// EventsByCursor provides a mock function with given fields: query
+func (_m *DBClient) EventsByCursor(query datamodels.CursorQuery) ([]models.Event, string, errors.EdgeX) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EventsByCursor")
+	}
+
+	var r0 []models.Event
+	var r1 string
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(datamodels.CursorQuery) ([]models.Event, string, errors.EdgeX)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(datamodels.CursorQuery) []models.Event); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(datamodels.CursorQuery) string); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(datamodels.CursorQuery) errors.EdgeX); ok {
+		r2 = rf(query)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // EventsByDeviceName provides a mock function with given fields: offset, limit, name
 func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
@@ -520,6 +612,38 @@ func (_m *DBClient) LatestReadingByOffset(offset uint32) (models.Reading, errors
 	return r0, r1
 }
 
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end, interval
+func (_m *DBClient) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]datamodels.ReadingAggregate, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, start, end, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange")
+	}
+
+	var r0 []datamodels.ReadingAggregate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int64) ([]datamodels.ReadingAggregate, errors.EdgeX)); ok {
+		return rf(deviceName, resourceName, start, end, interval)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int64) []datamodels.ReadingAggregate); ok {
+		r0 = rf(deviceName, resourceName, start, end, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.ReadingAggregate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64, int64, int64) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, start, end, interval)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByDeviceName provides a mock function with given fields: deviceName
 func (_m *DBClient) ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(deviceName)
@@ -760,7 +884,69 @@ func (_m *DBClient) ReadingCountByTimeRange(start int64, end int64) (uint32, err
 	return r0, r1
 }
 
-// ReadingTotalCount provides a mock function with given fields:
+// ReadingCountByValueFilter provides a mock function with given fields: filter
+func (_m *DBClient) ReadingCountByValueFilter(filter datamodels.ReadingValueFilter) (uint32, errors.EdgeX) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingCountByValueFilter")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(datamodels.ReadingValueFilter) (uint32, errors.EdgeX)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(datamodels.ReadingValueFilter) uint32); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(datamodels.ReadingValueFilter) errors.EdgeX); ok {
+		r1 = rf(filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, interval, start, end, offset, limit
+func (_m *DBClient) ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, interval int64, start int64, end int64, offset int, limit int) ([]datamodels.ReadingAggregate, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, interval, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingRollupsByDeviceNameAndResourceNameAndTimeRange")
+	}
+
+	var r0 []datamodels.ReadingAggregate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int64, int, int) ([]datamodels.ReadingAggregate, errors.EdgeX)); ok {
+		return rf(deviceName, resourceName, interval, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int64, int, int) []datamodels.ReadingAggregate); ok {
+		r0 = rf(deviceName, resourceName, interval, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.ReadingAggregate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, interval, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReadingTotalCount provides a mock function with no fields
 func (_m *DBClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
 
@@ -790,6 +976,45 @@ func (_m *DBClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// ReadingsByCursor provides a mock function with given fields: query
+func (_m *DBClient) ReadingsByCursor(query datamodels.CursorQuery) ([]models.Reading, string, errors.EdgeX) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingsByCursor")
+	}
+
+	var r0 []models.Reading
+	var r1 string
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(datamodels.CursorQuery) ([]models.Reading, string, errors.EdgeX)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(datamodels.CursorQuery) []models.Reading); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(datamodels.CursorQuery) string); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(datamodels.CursorQuery) errors.EdgeX); ok {
+		r2 = rf(query)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // ReadingsByDeviceName provides a mock function with given fields: offset, limit, name
 func (_m *DBClient) ReadingsByDeviceName(offset int, limit int, name string) ([]models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
@@ -1046,6 +1271,65 @@ func (_m *DBClient) ReadingsByTimeRange(start int64, end int64, offset int, limi
 	return r0, r1
 }
 
+// ReadingsByValueFilter provides a mock function with given fields: filter, offset, limit
+func (_m *DBClient) ReadingsByValueFilter(filter datamodels.ReadingValueFilter, offset int, limit int) (uint32, []models.Reading, errors.EdgeX) {
+	ret := _m.Called(filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadingsByValueFilter")
+	}
+
+	var r0 uint32
+	var r1 []models.Reading
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(datamodels.ReadingValueFilter, int, int) (uint32, []models.Reading, errors.EdgeX)); ok {
+		return rf(filter, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(datamodels.ReadingValueFilter, int, int) uint32); ok {
+		r0 = rf(filter, offset, limit)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(datamodels.ReadingValueFilter, int, int) []models.Reading); ok {
+		r1 = rf(filter, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(datamodels.ReadingValueFilter, int, int) errors.EdgeX); ok {
+		r2 = rf(filter, offset, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName provides a mock function with given fields: age, intervals, deviceName, sourceName
+func (_m *DBClient) RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName(age int64, intervals []int64, deviceName string, sourceName string) errors.EdgeX {
+	ret := _m.Called(age, intervals, deviceName, sourceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, []int64, string, string) errors.EdgeX); ok {
+		r0 = rf(age, intervals, deviceName, sourceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDBClient(t interface {
@@ -19,13 +19,18 @@ package data
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/controller/messaging"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/cache"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/handlers"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
@@ -75,6 +80,15 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		lc.Errorf("Failed to run event purging process, %v", err)
 	}
 
+	err = application.AsyncMonitorStaleDevices(ctx, dic)
+	if err != nil {
+		lc.Errorf("Failed to run stale device detection, %v", err)
+	}
+
+	if container.ConfigurationFrom(dic.Get).ReadingStream.Enabled {
+		startReadingStreamServer(ctx, wg, dic)
+	}
+
 	return true
 }
 
@@ -99,3 +113,50 @@ func initDeviceCache(ctx context.Context, dic *di.Container) errors.EdgeX {
 	}
 	return nil
 }
+
+// startReadingStreamServer starts the dedicated web server of the reading stream. The requests are bound to the service
+// context so that the open streams are closed when the service shuts down.
+func startReadingStreamServer(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	router := echo.New()
+	router.Use(handlers.ManageHeader)
+	router.Use(handlers.LoggingMiddleware(lc))
+	router.Use(handlers.ProcessCORS(configuration.Service.CORSConfiguration))
+	router.Use(handlers.HandlePreflight(configuration.Service.CORSConfiguration))
+	LoadReadingStreamRoutes(router, dic)
+
+	host := configuration.Service.ServerBindAddr
+	if host == "" {
+		host = configuration.Service.Host
+	}
+	addr := host + ":" + strconv.Itoa(configuration.ReadingStream.Port)
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           router,
+		ReadHeaderTimeout: 5 * time.Second,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+		lc.Info("Reading stream web server shut down")
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		lc.Infof("Reading stream web server starting (%s)", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			lc.Errorf("Reading stream web server failed, %v", err)
+		}
+	}()
+}
@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// CursorQuery represents a page of the events or readings sorted in descending order of origin, the page continues from the
+// position of the Cursor instead of skipping an offset, so that the pages stay stable while the new records arrive
+type CursorQuery struct {
+	// DeviceName and ResourceName narrow the records to query, an empty name matches any device or resource.
+	// The ResourceName only applies to the readings.
+	DeviceName   string
+	ResourceName string
+	// Start and End are the inclusive origin time range of the records to query
+	Start int64
+	End   int64
+	// Cursor is the database specific position of the last record of the previous page, an empty Cursor begins from the
+	// latest record
+	Cursor string
+	Limit  int
+}
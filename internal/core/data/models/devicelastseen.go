@@ -0,0 +1,18 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeviceLastSeen represents the latest event received from a device, so that a device which stopped reporting can be
+// identified
+type DeviceLastSeen struct {
+	DeviceName string
+	// ServiceName is the name of the device service sending the event, it is empty if the service is unknown
+	ServiceName string
+	SourceName  string
+	// Origin is the origin of the latest event and LastSeen is the time core-data received it, both in nanoseconds
+	Origin   int64
+	LastSeen int64
+}
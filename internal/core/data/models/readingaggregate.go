@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ReadingAggregate represents the statistics of the numeric readings which origin falls into the time bucket
+// [Start, Start+Interval)
+type ReadingAggregate struct {
+	DeviceName   string
+	ResourceName string
+	Start        int64
+	Interval     int64
+	Count        uint32
+	Min          float64
+	Max          float64
+	Avg          float64
+	First        float64
+	Last         float64
+}
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import "slices"
+
+// Constants related to the operators of the ReadingValueFilter
+const (
+	ValueOperatorGreaterThan = "gt"
+	ValueOperatorLessThan    = "lt"
+	ValueOperatorEqual       = "eq"
+	ValueOperatorBetween     = "between"
+)
+
+// ReadingValueFilter represents the numeric comparison applied to the reading values, only the readings of the numeric
+// value types are compared and the other readings never match the filter
+type ReadingValueFilter struct {
+	// DeviceName and ResourceName narrow the readings to compare, an empty name matches any device or resource
+	DeviceName   string
+	ResourceName string
+	// Start and End are the inclusive origin time range of the readings to compare
+	Start    int64
+	End      int64
+	Operator string
+	Value    float64
+	// UpperValue is the inclusive upper limit of the between operator, the Value is the inclusive lower limit
+	UpperValue float64
+}
+
+// Matches checks whether the numeric value satisfies the operator of the filter
+func (f ReadingValueFilter) Matches(value float64) bool {
+	switch f.Operator {
+	case ValueOperatorGreaterThan:
+		return value > f.Value
+	case ValueOperatorLessThan:
+		return value < f.Value
+	case ValueOperatorEqual:
+		return value == f.Value
+	case ValueOperatorBetween:
+		return value >= f.Value && value <= f.UpperValue
+	}
+	return false
+}
+
+// IsValidValueOperator checks whether the operator is one of the supported ReadingValueFilter operators
+func IsValidValueOperator(operator string) bool {
+	return slices.Contains([]string{ValueOperatorGreaterThan, ValueOperatorLessThan, ValueOperatorEqual, ValueOperatorBetween}, operator)
+}
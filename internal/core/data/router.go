@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/constants"
 	dataController "github.com/edgexfoundry/edgex-go/internal/core/data/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -27,6 +28,7 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	// Events
 	ec := dataController.NewEventController(dic)
 	r.POST(common.ApiEventServiceNameProfileNameDeviceNameSourceNameRoute, ec.AddEvent, authenticationHook)
+	r.POST(constants.ApiEventBatchRoute, ec.AddEvents, authenticationHook)
 	r.GET(common.ApiEventIdRoute, ec.EventById, authenticationHook)
 	r.DELETE(common.ApiEventIdRoute, ec.DeleteEventById, authenticationHook)
 	r.GET(common.ApiEventCountRoute, ec.EventTotalCount, authenticationHook)
@@ -36,6 +38,8 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.DELETE(common.ApiEventByDeviceNameRoute, ec.DeleteEventsByDeviceName, authenticationHook)
 	r.GET(common.ApiEventByTimeRangeRoute, ec.EventsByTimeRange, authenticationHook)
 	r.DELETE(common.ApiEventByAgeRoute, ec.DeleteEventsByAge, authenticationHook) // TODO: Add authentication to support-scheduler
+	r.GET(constants.ApiAllDeviceLastSeenRoute, ec.AllDeviceLastSeen, authenticationHook)
+	r.GET(constants.ApiDeviceLastSeenByDeviceNameRoute, ec.DeviceLastSeenByDeviceName, authenticationHook)
 
 	// Readings
 	rc := dataController.NewReadingController(dic)
@@ -49,4 +53,15 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiReadingByDeviceNameAndResourceNameRoute, rc.ReadingsByDeviceNameAndResourceName, authenticationHook)
 	r.GET(common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, rc.ReadingsByDeviceNameAndResourceNameAndTimeRange, authenticationHook)
 	r.GET(common.ApiReadingByDeviceNameAndTimeRangeRoute, rc.ReadingsByDeviceNameAndResourceNamesAndTimeRange, authenticationHook)
+	r.GET(constants.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute, rc.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange, authenticationHook)
+	r.GET(constants.ApiReadingRollupByDeviceNameAndResourceNameAndTimeRangeRoute, rc.ReadingRollupsByDeviceNameAndResourceNameAndTimeRange, authenticationHook)
+}
+
+// LoadReadingStreamRoutes loads the routes of the reading stream, which are served by a dedicated web server without the
+// request timeout so that the streaming responses can be kept open
+func LoadReadingStreamRoutes(r *echo.Echo, dic *di.Container) {
+	authenticationHook := handlers.AutoConfigAuthenticationFunc(dic)
+
+	rc := dataController.NewReadingController(dic)
+	r.GET(constants.ApiReadingStreamRoute, rc.StreamReadings, authenticationHook)
 }
@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// auditActions and auditEntityTypes are the actions and entity types of the audit records, which are the same as the
+// ones of the system events plus the device templates
+var (
+	auditActions     = []string{common.SystemEventActionAdd, common.SystemEventActionUpdate, common.SystemEventActionDelete}
+	auditEntityTypes = []string{common.DeviceSystemEventType, common.DeviceProfileSystemEventType, common.DeviceServiceSystemEventType,
+		common.ProvisionWatcherSystemEventType, constants.DeviceTemplateEntityType}
+)
+
+type auditActorKey struct{}
+
+// WithAuditActor returns the context carrying the actor of the metadata changes made with it, so that the changes are
+// recorded in the audit log with the actor
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// recordAudit stores the audit record of the change made to the entity if the audit log is enabled. The before and
+// after are the DTOs of the entity before and after the change, the before is nil for an added entity and the after is
+// nil for a deleted entity. The actor and correlation id are taken from the context. The failures are logged rather
+// than returned since the change has been done.
+func recordAudit(ctx context.Context, action string, entityType string, entityName string, before any, after any, dic *di.Container) {
+	if !container.ConfigurationFrom(dic.Get).AuditLog.Enabled {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	record := metadataModels.AuditRecord{
+		Action:        action,
+		EntityType:    entityType,
+		EntityName:    entityName,
+		CorrelationId: correlation.FromContext(ctx),
+	}
+	record.Actor, _ = ctx.Value(auditActorKey{}).(string)
+	var err errors.EdgeX
+	if record.Before, err = toJSONValue(before); err == nil {
+		record.After, err = toJSONValue(after)
+	}
+	if err == nil {
+		_, err = container.DBClientFrom(dic.Get).AddAuditRecord(record)
+	}
+	if err != nil {
+		lc.Errorf("failed to record the %s of %s '%s' in the audit log: %v", action, entityType, entityName, err)
+	}
+}
+
+// AuditRecords returns the audit records matching the query in descending order of creation along with the total count
+// of the matching records, each record contains the changes from the entity before the change to the one after
+func AuditRecords(query metadataModels.AuditRecordQuery, dic *di.Container) (records []metadataDTOs.AuditRecord, totalCount uint32, err errors.EdgeX) {
+	if query.Action != "" && !slices.Contains(auditActions, query.Action) {
+		return records, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown action '%s', only %v are allowed", query.Action, auditActions), nil)
+	}
+	if query.EntityType != "" && !slices.Contains(auditEntityTypes, query.EntityType) {
+		return records, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown entity type '%s', only %v are allowed", query.EntityType, auditEntityTypes), nil)
+	}
+	if query.End < query.Start {
+		return records, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end %d should not be earlier than start %d", query.End, query.Start), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.AuditRecordCount(query)
+	if err != nil {
+		return records, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, query.Offset, query.Limit)
+	if !cont {
+		return []metadataDTOs.AuditRecord{}, totalCount, err
+	}
+
+	recordModels, err := dbClient.AuditRecords(query)
+	if err != nil {
+		return records, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	records = make([]metadataDTOs.AuditRecord, len(recordModels))
+	for i, r := range recordModels {
+		records[i] = metadataDTOs.FromAuditRecordModelToDTO(r)
+		records[i].Changes, err = diffJSON(r.Before, r.After, ignoredDiffFields)
+		if err != nil {
+			return nil, totalCount, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return records, totalCount, nil
+}
+
+// AsyncPurgeAuditRecords deletes the audit records older than the AuditLog.Retention periodically if the audit log is
+// enabled
+func AsyncPurgeAuditRecords(ctx context.Context, dic *di.Container) errors.EdgeX {
+	auditLog := container.ConfigurationFrom(dic.Get).AuditLog
+	if !auditLog.Enabled {
+		return nil
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	retention, err := time.ParseDuration(auditLog.Retention)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "audit log Retention parse failed", err)
+	}
+	if retention <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("audit log Retention '%s' should be greater than zero", retention), nil)
+	}
+
+	go func() {
+		ticker := time.NewTicker(min(retention, time.Hour))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting audit records purging")
+				return
+			case <-ticker.C:
+				if err := container.DBClientFrom(dic.Get).DeleteAuditRecordsByAge(retention.Milliseconds()); err != nil {
+					lc.Errorf("failed to purge the audit records older than %s: %v", retention, err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
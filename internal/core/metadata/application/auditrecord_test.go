@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAudit(t *testing.T) {
+	before := dtos.FromDeviceProfileModelToDTO(buildRevisionTestProfile())
+	after := before
+	after.Description = "thermostat of floor 1"
+	ctx := context.WithValue(WithAuditActor(context.Background(), "admin"), common.CorrelationHeader, "b6d1f1b5")
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AddAuditRecord", mock.Anything).Return(metadataModels.AuditRecord{}, nil)
+	dic := newRevisionTestDIC(dbClientMock, 0)
+
+	// the audit log is disabled
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, testProfileName, before, after, dic)
+	dbClientMock.AssertNotCalled(t, "AddAuditRecord", mock.Anything)
+
+	container.ConfigurationFrom(dic.Get).AuditLog.Enabled = true
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, testProfileName, before, after, dic)
+	dbClientMock.AssertCalled(t, "AddAuditRecord", mock.MatchedBy(func(r metadataModels.AuditRecord) bool {
+		return r.Actor == "admin" && r.CorrelationId == "b6d1f1b5" && r.Action == common.SystemEventActionUpdate &&
+			r.EntityType == common.DeviceProfileSystemEventType && r.EntityName == testProfileName
+	}))
+
+	// the changes are computed from the stored before and after
+	stored := dbClientMock.Calls[0].Arguments.Get(0).(metadataModels.AuditRecord)
+	dbClientMock.On("AuditRecordCount", mock.Anything).Return(uint32(1), nil)
+	dbClientMock.On("AuditRecords", mock.Anything).Return([]metadataModels.AuditRecord{stored}, nil)
+	records, totalCount, err := AuditRecords(metadataModels.AuditRecordQuery{Limit: -1}, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), totalCount)
+	require.Len(t, records, 1)
+	require.Len(t, records[0].Changes, 1)
+	assert.Equal(t, "description", records[0].Changes[0].Path)
+	assert.Equal(t, "thermostat of floor 1", records[0].Changes[0].NewValue)
+}
+
+func TestAsyncPurgeAuditRecords(t *testing.T) {
+	dic := newRevisionTestDIC(&mocks.DBClient{}, 0)
+	auditLog := &container.ConfigurationFrom(dic.Get).AuditLog
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the audit log is disabled
+	require.NoError(t, AsyncPurgeAuditRecords(ctx, dic))
+
+	auditLog.Enabled = true
+	auditLog.Retention = "1 month"
+	require.Error(t, AsyncPurgeAuditRecords(ctx, dic))
+	auditLog.Retention = "0s"
+	require.Error(t, AsyncPurgeAuditRecords(ctx, dic))
+	auditLog.Retention = "720h"
+	require.NoError(t, AsyncPurgeAuditRecords(ctx, dic))
+}
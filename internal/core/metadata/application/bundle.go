@@ -0,0 +1,366 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// bundleImportEntity is an entity of the metadata bundle planned to be imported, apply is nil if nothing will be written
+type bundleImportEntity struct {
+	result metadataDTOs.BundleImportResult
+	apply  func() errors.EdgeX
+}
+
+// ExportMetadataBundle exports all the device services, device profiles, devices and provision watchers into a metadata bundle
+func ExportMetadataBundle(ctx context.Context, dic *di.Container) (bundle metadataDTOs.MetadataBundle, err errors.EdgeX) {
+	bundle.SchemaVersion = constants.BundleSchemaVersion
+	bundle.Created = pkgCommon.MakeTimestamp()
+
+	bundle.DeviceServices, _, err = AllDeviceServices(0, -1, nil, ctx, dic)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+	bundle.DeviceProfiles, _, err = AllDeviceProfiles(0, -1, nil, dic)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+	bundle.Devices, _, err = AllDevices(0, -1, nil, "", 0, dic)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+	bundle.ProvisionWatchers, _, err = AllProvisionWatchers(0, -1, nil, dic)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf(
+		"Metadata bundle exported with %d device services, %d device profiles, %d devices and %d provision watchers. Correlation-ID: %s ",
+		len(bundle.DeviceServices), len(bundle.DeviceProfiles), len(bundle.Devices), len(bundle.ProvisionWatchers),
+		correlation.FromContext(ctx),
+	)
+	return bundle, nil
+}
+
+// ImportMetadataBundle imports the metadata bundle in dependency order, i.e. device services, device profiles, devices (the
+// parents before their children) and then provision watchers. The existing entities are skipped, overwritten or reported as
+// conflicted according to the conflict policy, and nothing is written if any entity conflicts. When dryRun is true, the
+// planned results are reported without writing anything.
+func ImportMetadataBundle(bundle metadataDTOs.MetadataBundle, conflictPolicy string, dryRun bool, ctx context.Context, dic *di.Container) (report metadataDTOs.BundleImportReport, err errors.EdgeX) {
+	if bundle.SchemaVersion != constants.BundleSchemaVersion {
+		return report, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported metadata bundle schema version '%s', only '%s' is supported", bundle.SchemaVersion, constants.BundleSchemaVersion), nil)
+	}
+	switch conflictPolicy {
+	case constants.ConflictPolicySkip, constants.ConflictPolicyOverwrite, constants.ConflictPolicyFail:
+	default:
+		return report, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported conflict policy '%s'", conflictPolicy), nil)
+	}
+
+	entities, err := planBundleImport(bundle, conflictPolicy, ctx, dic)
+	if err != nil {
+		return report, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	report = metadataDTOs.BundleImportReport{DryRun: dryRun, ConflictPolicy: conflictPolicy, Results: make([]metadataDTOs.BundleImportResult, len(entities))}
+	conflicted := false
+	for i, entity := range entities {
+		report.Results[i] = entity.result
+		if entity.result.Action == constants.BundleImportActionConflicted {
+			conflicted = true
+		}
+	}
+	if dryRun || conflicted {
+		return report, nil
+	}
+
+	for i, entity := range entities {
+		if entity.apply == nil {
+			continue
+		}
+		if err := entity.apply(); err != nil {
+			report.Results[i].Action = constants.BundleImportActionFailed
+			report.Results[i].Message = err.Message()
+		}
+	}
+
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("Metadata bundle imported with %d entities. Correlation-ID: %s ", len(entities), correlation.FromContext(ctx))
+	return report, nil
+}
+
+// planBundleImport validates each entity of the metadata bundle and decides its action by its existence and the conflict policy.
+// The device services and device profiles referenced by the devices and provision watchers should be either imported from
+// the bundle or stored already.
+func planBundleImport(bundle metadataDTOs.MetadataBundle, conflictPolicy string, ctx context.Context, dic *di.Container) ([]bundleImportEntity, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	var entities []bundleImportEntity
+	// the names of the valid device services and device profiles of the bundle, which exist once the bundle is imported
+	bundleServices := make(map[string]bool, len(bundle.DeviceServices))
+	bundleProfiles := make(map[string]bool, len(bundle.DeviceProfiles))
+	// checkReferences returns the EntityDoesNotExist error if the referenced device service or device profile will not exist
+	checkReferences := func(serviceName string, profileName string) errors.EdgeX {
+		if !bundleServices[serviceName] {
+			exists, err := dbClient.DeviceServiceNameExists(serviceName)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+			if !exists {
+				return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device service '%s' is neither in the bundle nor stored", serviceName), nil)
+			}
+		}
+		if profileName != "" && !bundleProfiles[profileName] {
+			exists, err := dbClient.DeviceProfileNameExists(profileName)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+			if !exists {
+				return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' is neither in the bundle nor stored", profileName), nil)
+			}
+		}
+		return nil
+	}
+
+	for _, dto := range bundle.DeviceServices {
+		entity := newBundleImportEntity(common.DeviceServiceSystemEventType, dto.Name)
+		if err := common.Validate(dto); err != nil {
+			entities = append(entities, entity.failed(err))
+			continue
+		}
+		exists, err := dbClient.DeviceServiceNameExists(dto.Name)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		bundleServices[dto.Name] = true
+		ds := dtos.ToDeviceServiceModel(dto)
+		entities = append(entities, entity.planned(exists, conflictPolicy,
+			func() errors.EdgeX {
+				ds.Id = ""
+				_, err := AddDeviceService(ds, ctx, dic)
+				return err
+			},
+			func() errors.EdgeX { return overwriteDeviceService(ds, ctx, dic) },
+		))
+	}
+
+	for _, dto := range bundle.DeviceProfiles {
+		entity := newBundleImportEntity(common.DeviceProfileSystemEventType, dto.Name)
+		if err := dto.Validate(); err != nil {
+			entities = append(entities, entity.failed(err))
+			continue
+		}
+		if err := normalizeProfileValueTypes(&dto); err != nil {
+			entities = append(entities, entity.failed(err))
+			continue
+		}
+		exists, err := dbClient.DeviceProfileNameExists(dto.Name)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		bundleProfiles[dto.Name] = true
+		dp := dtos.ToDeviceProfileModel(dto)
+		entities = append(entities, entity.planned(exists, conflictPolicy,
+			func() errors.EdgeX {
+				dp.Id = ""
+				_, err := AddDeviceProfile(dp, ctx, dic)
+				return err
+			},
+			func() errors.EdgeX { return overwriteDeviceProfile(dp, ctx, dic) },
+		))
+	}
+
+	for _, dto := range sortDevicesByParent(bundle.Devices) {
+		entity := newBundleImportEntity(common.DeviceSystemEventType, dto.Name)
+		if err := common.Validate(dto); err != nil {
+			entities = append(entities, entity.failed(err))
+			continue
+		}
+		if err := checkReferences(dto.ServiceName, dto.ProfileName); errors.Kind(err) == errors.KindEntityDoesNotExist {
+			entities = append(entities, entity.failed(err))
+			continue
+		} else if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		exists, err := dbClient.DeviceNameExists(dto.Name)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		d := dtos.ToDeviceModel(dto)
+		// the id is assigned by this service on creation, or taken from the existing device on overwriting
+		d.Id = ""
+		entities = append(entities, entity.planned(exists, conflictPolicy,
+			func() errors.EdgeX {
+				_, err := AddDevice(d, ctx, dic, true, false)
+				return err
+			},
+			func() errors.EdgeX {
+				_, err := AddDevice(d, ctx, dic, true, true)
+				return err
+			},
+		))
+	}
+
+	for _, dto := range bundle.ProvisionWatchers {
+		entity := newBundleImportEntity(common.ProvisionWatcherSystemEventType, dto.Name)
+		if err := common.Validate(dto); err != nil {
+			entities = append(entities, entity.failed(err))
+			continue
+		}
+		if err := checkReferences(dto.ServiceName, dto.DiscoveredDevice.ProfileName); errors.Kind(err) == errors.KindEntityDoesNotExist {
+			entities = append(entities, entity.failed(err))
+			continue
+		} else if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		exists := true
+		if _, err := dbClient.ProvisionWatcherByName(dto.Name); errors.Kind(err) == errors.KindEntityDoesNotExist {
+			exists = false
+		} else if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		pw := dtos.ToProvisionWatcherModel(dto)
+		entities = append(entities, entity.planned(exists, conflictPolicy,
+			func() errors.EdgeX {
+				pw.Id = ""
+				_, err := AddProvisionWatcher(pw, ctx, dic)
+				return err
+			},
+			func() errors.EdgeX { return overwriteProvisionWatcher(pw, ctx, dic) },
+		))
+	}
+
+	return entities, nil
+}
+
+func newBundleImportEntity(entityType string, name string) bundleImportEntity {
+	return bundleImportEntity{result: metadataDTOs.BundleImportResult{Type: entityType, Name: name}}
+}
+
+// failed marks the entity as failed with the error
+func (e bundleImportEntity) failed(err error) bundleImportEntity {
+	e.result.Action = constants.BundleImportActionFailed
+	if edgexErr, ok := err.(errors.EdgeX); ok {
+		e.result.Message = edgexErr.Message()
+	} else {
+		e.result.Message = err.Error()
+	}
+	return e
+}
+
+// planned decides the action of the entity by its existence and the conflict policy
+func (e bundleImportEntity) planned(exists bool, conflictPolicy string, create func() errors.EdgeX, overwrite func() errors.EdgeX) bundleImportEntity {
+	if !exists {
+		e.result.Action = constants.BundleImportActionCreated
+		e.apply = create
+		return e
+	}
+	switch conflictPolicy {
+	case constants.ConflictPolicySkip:
+		e.result.Action = constants.BundleImportActionSkipped
+		e.result.Message = fmt.Sprintf("%s '%s' already exists", e.result.Type, e.result.Name)
+	case constants.ConflictPolicyOverwrite:
+		e.result.Action = constants.BundleImportActionOverwritten
+		e.apply = overwrite
+	default:
+		e.result.Action = constants.BundleImportActionConflicted
+		e.result.Message = fmt.Sprintf("%s '%s' already exists", e.result.Type, e.result.Name)
+	}
+	return e
+}
+
+// normalizeProfileValueTypes normalizes the value types of the device resources like the YAML decoding of the device profile does
+func normalizeProfileValueTypes(dto *dtos.DeviceProfile) errors.EdgeX {
+	for i, resource := range dto.DeviceResources {
+		valueType, err := common.NormalizeValueType(resource.Properties.ValueType)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		dto.DeviceResources[i].Properties.ValueType = valueType
+	}
+	return nil
+}
+
+// sortDevicesByParent sorts the devices so that the parent devices in the bundle are imported before their children, the
+// devices in a parent cycle keep their original order at the end
+func sortDevicesByParent(devices []dtos.Device) []dtos.Device {
+	pending := make(map[string]bool, len(devices))
+	for _, d := range devices {
+		pending[d.Name] = true
+	}
+
+	sorted := make([]dtos.Device, 0, len(devices))
+	for len(sorted) < len(devices) {
+		progressed := false
+		for _, d := range devices {
+			if !pending[d.Name] || (d.Parent != "" && d.Parent != d.Name && pending[d.Parent]) {
+				continue
+			}
+			sorted = append(sorted, d)
+			delete(pending, d.Name)
+			progressed = true
+		}
+		if !progressed {
+			for _, d := range devices {
+				if pending[d.Name] {
+					sorted = append(sorted, d)
+					delete(pending, d.Name)
+				}
+			}
+		}
+	}
+	return sorted
+}
+
+// overwriteDeviceService replaces the existing device service with the one from the metadata bundle
+func overwriteDeviceService(ds models.DeviceService, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	existing, err := dbClient.DeviceServiceByName(ds.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	ds.Id = existing.Id
+	ds.Created = existing.Created
+
+	return updateDeviceService(existing, ds, 0, ctx, dic)
+}
+
+// overwriteDeviceProfile replaces the existing device profile with the one from the metadata bundle
+func overwriteDeviceProfile(dp models.DeviceProfile, ctx context.Context, dic *di.Container) errors.EdgeX {
+	existing, err := container.DBClientFrom(dic.Get).DeviceProfileByName(dp.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	dp.Id = existing.Id
+	dp.Created = existing.Created
+
+	return UpdateDeviceProfile(dp, ctx, dic)
+}
+
+// overwriteProvisionWatcher replaces the existing provision watcher with the one from the metadata bundle
+func overwriteProvisionWatcher(pw models.ProvisionWatcher, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	existing, err := dbClient.ProvisionWatcherByName(pw.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	pw.Id = existing.Id
+	pw.Created = existing.Created
+
+	return updateProvisionWatcher(existing, pw, 0, ctx, dic)
+}
@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortDevicesByParent(t *testing.T) {
+	tests := []struct {
+		name          string
+		devices       []dtos.Device
+		expectedOrder []string
+	}{
+		{"no parents", []dtos.Device{{Name: "a"}, {Name: "b"}}, []string{"a", "b"}},
+		{"children before parents", []dtos.Device{{Name: "grandchild", Parent: "child"}, {Name: "child", Parent: "root"}, {Name: "root"}}, []string{"root", "child", "grandchild"}},
+		{"parent outside the bundle", []dtos.Device{{Name: "child", Parent: "existing"}, {Name: "other"}}, []string{"child", "other"}},
+		{"parent cycle", []dtos.Device{{Name: "a", Parent: "b"}, {Name: "b", Parent: "a"}, {Name: "c"}}, []string{"c", "a", "b"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			sorted := sortDevicesByParent(testCase.devices)
+			names := make([]string, len(sorted))
+			for i, d := range sorted {
+				names[i] = d.Name
+			}
+			assert.Equal(t, testCase.expectedOrder, names)
+		})
+	}
+}
+
+func TestImportMetadataBundleReferences(t *testing.T) {
+	storedService := "stored-service"
+	storedProfile := "stored-profile"
+	newDevice := func(name, serviceName, profileName string) dtos.Device {
+		return dtos.Device{Name: name, ServiceName: serviceName, ProfileName: profileName, AdminState: models.Unlocked, OperatingState: models.Up,
+			Protocols: map[string]dtos.ProtocolProperties{"other": {"Key": "value"}}}
+	}
+	bundle := metadataDTOs.MetadataBundle{
+		SchemaVersion: constants.BundleSchemaVersion,
+		Devices: []dtos.Device{
+			newDevice("stored-references", storedService, storedProfile),
+			newDevice("unknown-service", "unknown-service", storedProfile),
+			newDevice("unknown-profile", storedService, "unknown-profile"),
+		},
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", storedService).Return(true, nil)
+	dbClientMock.On("DeviceServiceNameExists", "unknown-service").Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", storedProfile).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "unknown-profile").Return(false, nil)
+	dbClientMock.On("DeviceNameExists", "stored-references").Return(false, nil)
+	dic := newBulkDeviceTestDIC(dbClientMock, 0)
+
+	report, err := ImportMetadataBundle(bundle, constants.ConflictPolicySkip, true, context.Background(), dic)
+	require.NoError(t, err)
+
+	expectedActions := []string{constants.BundleImportActionCreated, constants.BundleImportActionFailed, constants.BundleImportActionFailed}
+	require.Len(t, report.Results, len(expectedActions))
+	for i, result := range report.Results {
+		assert.Equal(t, expectedActions[i], result.Action, "Result action of '%s' not as expected", result.Name)
+	}
+	assert.Contains(t, report.Results[1].Message, "unknown-service")
+	assert.Contains(t, report.Results[2].Message, "unknown-profile")
+}
@@ -50,6 +50,52 @@ func checkCapacityWithNewDevice(d models.Device, dic *di.Container) errors.EdgeX
 	return nil
 }
 
+// checkCapacityWithNewDevices checks the device and resource capacity against the total of the new devices
+func checkCapacityWithNewDevices(ds []models.Device, dic *di.Container) errors.EdgeX {
+	config := container.ConfigurationFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	lock := container.CapacityCheckLockFrom(dic.Get)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if config.Writable.MaxDevices > 0 {
+		deviceCount, err := dbClient.DeviceCountByLabels(nil)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "query device count failed", err)
+		}
+		if deviceCount+uint32(len(ds)) > config.Writable.MaxDevices {
+			return errors.NewCommonEdgeX(
+				errors.KindContractInvalid,
+				fmt.Sprintf("the existing total number of device is '%d', add %d devices will exceed the maximum limitation '%d'", deviceCount, len(ds), config.Writable.MaxDevices), nil)
+		}
+	}
+	if config.Writable.MaxResources > 0 {
+		totalInUseResourceCount, err := dbClient.InUseResourceCount()
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "query in use resource count failed", err)
+		}
+		var newResourceCount uint32
+		profileResourceCounts := make(map[string]uint32)
+		for _, d := range ds {
+			count, ok := profileResourceCounts[d.ProfileName]
+			if !ok {
+				count, err = resourceCountByProfile(d.ProfileName, dic)
+				if err != nil {
+					return errors.NewCommonEdgeX(errors.Kind(err), "get resource count failed", err)
+				}
+				profileResourceCounts[d.ProfileName] = count
+			}
+			newResourceCount += count
+		}
+		if totalInUseResourceCount+newResourceCount > config.Writable.MaxResources {
+			return errors.NewCommonEdgeX(
+				errors.KindContractInvalid,
+				fmt.Sprintf("'%d' resources is in use, increase '%d' resources will exceed the maximum limitation '%d'", totalInUseResourceCount, newResourceCount, config.Writable.MaxResources), nil)
+		}
+	}
+	return nil
+}
+
 func checkResourceCapacityByExistingAndNewProfile(oldProfileName, newProfileName string, dic *di.Container) errors.EdgeX {
 	config := container.ConfigurationFrom(dic.Get)
 	dbClient := container.DBClientFrom(dic.Get)
@@ -83,6 +129,62 @@ func checkResourceCapacityByExistingAndNewProfile(oldProfileName, newProfileName
 	return nil
 }
 
+// checkResourceCapacityByUpdatedDevices checks the resource capacity against the total resource count change made by
+// updating the devices from the old ones, where the devices and old devices are matched by index
+func checkResourceCapacityByUpdatedDevices(oldDevices, devices []models.Device, dic *di.Container) errors.EdgeX {
+	config := container.ConfigurationFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	lock := container.CapacityCheckLockFrom(dic.Get)
+	lock.Lock()
+	defer lock.Unlock()
+
+	profileResourceCounts := make(map[string]uint32)
+	resourceCount := func(profileName string) (uint32, errors.EdgeX) {
+		count, ok := profileResourceCounts[profileName]
+		if !ok {
+			var err errors.EdgeX
+			count, err = resourceCountByProfile(profileName, dic)
+			if err != nil {
+				return 0, errors.NewCommonEdgeX(errors.Kind(err), "get resource count failed", err)
+			}
+			profileResourceCounts[profileName] = count
+		}
+		return count, nil
+	}
+
+	var removedResourceCount, addedResourceCount uint32
+	for i, d := range devices {
+		if oldDevices[i].ProfileName == d.ProfileName {
+			continue
+		}
+		oldCount, err := resourceCount(oldDevices[i].ProfileName)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		newCount, err := resourceCount(d.ProfileName)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		removedResourceCount += oldCount
+		addedResourceCount += newCount
+	}
+	if addedResourceCount <= removedResourceCount {
+		return nil
+	}
+
+	totalInUseResourceCount, err := dbClient.InUseResourceCount()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "query in use resource count failed", err)
+	}
+	if totalInUseResourceCount-removedResourceCount+addedResourceCount > config.Writable.MaxResources {
+		return errors.NewCommonEdgeX(
+			errors.KindContractInvalid,
+			fmt.Sprintf("'%d' resources is in use, change the profiles of the devices from %d resource count to %d resource count will exceed the maximum limitation '%d'",
+				totalInUseResourceCount, removedResourceCount, addedResourceCount, config.Writable.MaxResources), nil)
+	}
+	return nil
+}
+
 func checkResourceCapacityByUpdateProfile(profile models.DeviceProfile, dic *di.Container) errors.EdgeX {
 	config := container.ConfigurationFrom(dic.Get)
 	dbClient := container.DBClientFrom(dic.Get)
@@ -33,6 +33,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
@@ -47,6 +48,11 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container, bypassVa
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
 
+	// Fill the fields left empty with the defaults of the device template before any validation
+	if edgeXerr = resolveDeviceTemplate(&d, explicitDeviceFields{}, dbClient.DeviceTemplateByName); edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	// Check the existence of device service before device validation
 	exists, edgeXerr := dbClient.DeviceServiceNameExists(d.ServiceName)
 	if edgeXerr != nil {
@@ -106,6 +112,7 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container, bypassVa
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(addedDevice)
+	recordAudit(ctx, common.SystemEventActionAdd, common.DeviceSystemEventType, addedDevice.Name, nil, deviceDTO, dic)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, d.ServiceName, deviceDTO, ctx, dic)
 
 	return addedDevice.Id, nil
@@ -142,7 +149,7 @@ func updateDevice(d models.Device, ctx context.Context, dic *di.Container) (id s
 		}
 	}
 
-	err = updateDeviceInDB(d, oldServiceName, ctx, dic)
+	err = updateDeviceInDB(oldDevice, d, oldServiceName, 0, ctx, dic)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
@@ -160,6 +167,10 @@ func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) err
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceSystemEventType, device.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	childcount, _, err := dbClient.DeviceTree(name, 1, 0, 1, nil)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -167,12 +178,13 @@ func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) err
 	if childcount != 0 {
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "cannot delete device with children", nil)
 	}
-	err = dbClient.DeleteDeviceByName(name)
+	err = dbClient.DeleteDeviceByName(name, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
+	recordAudit(ctx, common.SystemEventActionDelete, common.DeviceSystemEventType, device.Name, deviceDTO, nil, dic)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, device.ServiceName, deviceDTO, ctx, dic)
 
 	return nil
@@ -236,6 +248,10 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container,
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceSystemEventType, device.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	// Old service name is used for invoking callback
 	var oldServiceName string
@@ -243,18 +259,28 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container,
 		oldServiceName = device.ServiceName
 	}
 
+	oldDevice := device
+	requests.ReplaceDeviceModelFieldsWithDTO(&device, dto)
+	if err = resolvePatchedDeviceTemplate(&device, oldDevice, dto, dbClient.DeviceTemplateByName); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the profile may also be changed by the device template, so the capacity is checked with the resolved device
 	if container.ConfigurationFrom(dic.Get).Writable.MaxResources > 0 {
-		if err = checkResourceCapacityByExistingAndNewProfile(device.ProfileName, *dto.ProfileName, dic); err != nil {
+		if err = checkResourceCapacityByExistingAndNewProfile(oldDevice.ProfileName, device.ProfileName, dic); err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 
-	requests.ReplaceDeviceModelFieldsWithDTO(&device, dto)
-
 	err = validateParentProfileAndAutoEvent(dic, device)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if device.Parent != oldDevice.Parent {
+		if err = checkDeviceParent(dbClient, device.Name, device.Parent); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
 
@@ -267,16 +293,16 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container,
 		}
 	}
 
-	return updateDeviceInDB(device, oldServiceName, ctx, dic)
+	return updateDeviceInDB(oldDevice, device, oldServiceName, ifRevision, ctx, dic)
 }
 
-// updateDeviceInDB calls the UpdateDevice method from the infrastructure layer and validate the device auto events
-// and publish the "update device" system event at last
-func updateDeviceInDB(device models.Device, oldServiceName string, ctx context.Context, dic *di.Container) errors.EdgeX {
+// updateDeviceInDB calls the UpdateDevice method from the infrastructure layer to replace the old device with the device,
+// validate the device auto events and publish the "update device" system event at last
+func updateDeviceInDB(oldDevice models.Device, device models.Device, oldServiceName string, ifRevision uint64, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	err := dbClient.UpdateDevice(device)
+	err := dbClient.UpdateDevice(device, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -292,6 +318,7 @@ func updateDeviceInDB(device models.Device, oldServiceName string, ctx context.C
 	)
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceSystemEventType, device.Name, dtos.FromDeviceModelToDTO(oldDevice), deviceDTO, dic)
 	if oldServiceName != "" {
 		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, oldServiceName, deviceDTO, ctx, dic)
 	}
@@ -352,6 +379,25 @@ func AllDevices(offset int, limit int, labels []string, parent string, maxLevels
 	return devices, totalCount, nil
 }
 
+// SearchDevices returns the page of the devices matching all the filters of the query along with the total count of the
+// matching devices
+func SearchDevices(query metadataModels.DeviceQuery, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
+	totalCount, deviceModels, err := container.DBClientFrom(dic.Get).DevicesByQuery(query)
+	if err != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, query.Offset, query.Limit)
+	if !cont {
+		return []dtos.Device{}, totalCount, err
+	}
+
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, totalCount, nil
+}
+
 // DeviceByName query the device by name
 func DeviceByName(name string, dic *di.Container) (device dtos.Device, err errors.EdgeX) {
 	if name == "" {
@@ -396,6 +442,13 @@ func DevicesByProfileName(offset int, limit int, profileName string, dic *di.Con
 var noMessagingClientError = goErrors.New("MessageBus Client not available. Please update RequireMessageBus and MessageBus configuration to enable sending System Events via the EdgeX MessageBus")
 
 func validateParentProfileAndAutoEvent(dic *di.Container, d models.Device) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	return validateDeviceWithProfile(d, dbClient.DeviceProfileByName)
+}
+
+// validateDeviceWithProfile validates the parent and auto events of the device against its device profile, which is
+// looked up by profileByName
+func validateDeviceWithProfile(d models.Device, profileByName func(name string) (models.DeviceProfile, errors.EdgeX)) errors.EdgeX {
 	if d.ProfileName == "" {
 		// if the profile is not set, skip the validation until we have the profile
 		return nil
@@ -403,8 +456,7 @@ func validateParentProfileAndAutoEvent(dic *di.Container, d models.Device) error
 	if (d.Name == d.Parent) && (d.Name != "") {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "a device cannot be its own parent", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
-	dp, err := dbClient.DeviceProfileByName(d.ProfileName)
+	dp, err := profileByName(d.ProfileName)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile '%s' not found during validating device '%s'", d.ProfileName, d.Name), err)
 	}
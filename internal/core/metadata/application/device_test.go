@@ -21,6 +21,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -153,8 +154,8 @@ func TestForceAddDevice(t *testing.T) {
 	dbClientMock.On("DeviceByName", invalidDeviceName).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to query", nil))
 	dbClientMock.On("DeviceByName", validDeviceName).Return(returnedDevice, nil)
 	dbClientMock.On("DeviceByName", invalidDeviceName2).Return(invalidDevice2, nil)
-	dbClientMock.On("UpdateDevice", returnedDevice).Return(nil)
-	dbClientMock.On("UpdateDevice", invalidDevice2).Return(errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to update", nil))
+	dbClientMock.On("UpdateDevice", returnedDevice, mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDevice", invalidDevice2, mock.Anything).Return(errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to update", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
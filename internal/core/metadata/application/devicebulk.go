@@ -0,0 +1,416 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// bulkDeviceNotAppliedMessage is the result message of the valid devices in a batch that is rejected because of the others
+const bulkDeviceNotAppliedMessage = "not applied because other devices in the batch failed"
+
+// bulkDeviceLookup looks up the device services, device profiles and device templates referenced by a batch of devices,
+// each of them is only queried once for the whole batch
+type bulkDeviceLookup struct {
+	dbClient       interfaces.DBClient
+	serviceErrors  map[string]errors.EdgeX
+	profiles       map[string]models.DeviceProfile
+	profileErrors  map[string]errors.EdgeX
+	templates      map[string]metadataModels.DeviceTemplate
+	templateErrors map[string]errors.EdgeX
+}
+
+func newBulkDeviceLookup(dbClient interfaces.DBClient) *bulkDeviceLookup {
+	return &bulkDeviceLookup{
+		dbClient:       dbClient,
+		serviceErrors:  make(map[string]errors.EdgeX),
+		profiles:       make(map[string]models.DeviceProfile),
+		profileErrors:  make(map[string]errors.EdgeX),
+		templates:      make(map[string]metadataModels.DeviceTemplate),
+		templateErrors: make(map[string]errors.EdgeX),
+	}
+}
+
+// checkServiceExists returns an error if the device service doesn't exist
+func (l *bulkDeviceLookup) checkServiceExists(name string) errors.EdgeX {
+	if err, ok := l.serviceErrors[name]; ok {
+		return err
+	}
+	var err errors.EdgeX
+	exists, edgeXerr := l.dbClient.DeviceServiceNameExists(name)
+	if edgeXerr != nil {
+		err = errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("device service '%s' existence check failed", name), edgeXerr)
+	} else if !exists {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device service '%s' does not exists", name), nil)
+	}
+	l.serviceErrors[name] = err
+	return err
+}
+
+// deviceProfileByName returns the device profile by name
+func (l *bulkDeviceLookup) deviceProfileByName(name string) (models.DeviceProfile, errors.EdgeX) {
+	if err, ok := l.profileErrors[name]; ok {
+		return models.DeviceProfile{}, err
+	}
+	if dp, ok := l.profiles[name]; ok {
+		return dp, nil
+	}
+	dp, err := l.dbClient.DeviceProfileByName(name)
+	if err != nil {
+		l.profileErrors[name] = err
+		return dp, err
+	}
+	l.profiles[name] = dp
+	return dp, nil
+}
+
+// deviceTemplateByName returns the device template by name
+func (l *bulkDeviceLookup) deviceTemplateByName(name string) (metadataModels.DeviceTemplate, errors.EdgeX) {
+	if err, ok := l.templateErrors[name]; ok {
+		return metadataModels.DeviceTemplate{}, err
+	}
+	if t, ok := l.templates[name]; ok {
+		return t, nil
+	}
+	t, err := l.dbClient.DeviceTemplateByName(name)
+	if err != nil {
+		l.templateErrors[name] = err
+		return t, err
+	}
+	l.templates[name] = t
+	return t, nil
+}
+
+// bulkDeviceResults builds the results of a batch of devices from their errors, which are matched by index. If any of
+// the devices fails, the others are reported as not applied and the returned error carries the kind of the first failure.
+func bulkDeviceResults(names []string, errs []errors.EdgeX, action string) ([]metadataDTOs.BulkDeviceResult, errors.EdgeX) {
+	results := make([]metadataDTOs.BulkDeviceResult, len(names))
+	var firstErr errors.EdgeX
+	failedCount := 0
+	for i, name := range names {
+		results[i].Name = name
+		if errs[i] == nil {
+			continue
+		}
+		results[i].StatusCode = errs[i].Code()
+		results[i].Message = errs[i].Message()
+		failedCount++
+		if firstErr == nil {
+			firstErr = errs[i]
+		}
+	}
+	if firstErr == nil {
+		return results, nil
+	}
+
+	for i := range results {
+		if errs[i] == nil {
+			results[i].StatusCode = http.StatusFailedDependency
+			results[i].Message = bulkDeviceNotAppliedMessage
+		}
+	}
+	return results, errors.NewCommonEdgeX(errors.Kind(firstErr),
+		fmt.Sprintf("%d of %d devices failed, none of the devices is %s", failedCount, len(names), action), nil)
+}
+
+// validateDevicesCallback invokes the device validation of the device services concurrently, while the devices of the
+// same device service are validated one after another. The devices already failed in errs are skipped, and the
+// validation errors are set to errs by index.
+func validateDevicesCallback(ds []models.Device, errs []errors.EdgeX, dic *di.Container) {
+	indexesByService := make(map[string][]int)
+	for i, d := range ds {
+		if errs[i] == nil {
+			indexesByService[d.ServiceName] = append(indexesByService[d.ServiceName], i)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, indexes := range indexesByService {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, i := range indexes {
+				if err := validateDeviceCallback(dtos.FromDeviceModelToDTO(ds[i]), dic); err != nil {
+					errs[i] = errors.NewCommonEdgeXWrapper(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// deviceSystemEvent is a device system event to be published to the owner device service
+type deviceSystemEvent struct {
+	owner  string
+	device dtos.Device
+}
+
+// publishDeviceSystemEvents publishes the device system events of a batch of devices one after another
+func publishDeviceSystemEvents(action string, events []deviceSystemEvent, ctx context.Context, dic *di.Container) {
+	for _, event := range events {
+		publishSystemEvent(common.DeviceSystemEventType, action, event.owner, event.device, ctx, dic)
+	}
+}
+
+// AddDevices validates the whole batch of new devices up front and then adds them in a single write, so that none of the
+// devices is added if any of them fails. The device validation of each device service is invoked for its devices unless
+// bypassValidation is true. The results are returned along with the error if any of the devices fails.
+func AddDevices(ds []models.Device, ctx context.Context, dic *di.Container, bypassValidation bool) ([]metadataDTOs.BulkDeviceResult, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	lookup := newBulkDeviceLookup(dbClient)
+	names := make([]string, len(ds))
+	errs := make([]errors.EdgeX, len(ds))
+	seen := make(map[string]bool, len(ds))
+	for i := range ds {
+		names[i] = ds[i].Name
+		if seen[ds[i].Name] {
+			errs[i] = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s is duplicated in the batch", ds[i].Name), nil)
+			continue
+		}
+		seen[ds[i].Name] = true
+
+		if err := resolveDeviceTemplate(&ds[i], explicitDeviceFields{}, lookup.deviceTemplateByName); err != nil {
+			errs[i] = err
+			continue
+		}
+		d := ds[i]
+		if err := lookup.checkServiceExists(d.ServiceName); err != nil {
+			errs[i] = err
+			continue
+		}
+		if err := validateDeviceWithProfile(d, lookup.deviceProfileByName); err != nil {
+			errs[i] = errors.NewCommonEdgeXWrapper(err)
+			continue
+		}
+		exists, err := dbClient.DeviceNameExists(d.Name)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		} else if exists {
+			errs[i] = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s already exists", d.Name), nil)
+		}
+	}
+	if results, err := bulkDeviceResults(names, errs, "added"); err != nil {
+		return results, err
+	}
+
+	if config.Writable.MaxDevices > 0 || config.Writable.MaxResources > 0 {
+		if err := checkCapacityWithNewDevices(ds, dic); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	if !bypassValidation {
+		validateDevicesCallback(ds, errs, dic)
+		if results, err := bulkDeviceResults(names, errs, "added"); err != nil {
+			return results, err
+		}
+	}
+
+	addedDevices, err := dbClient.AddDevices(ds)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("%d devices created on DB successfully. Correlation-ID: %s ", len(addedDevices), correlation.FromContext(ctx))
+
+	results := make([]metadataDTOs.BulkDeviceResult, len(addedDevices))
+	events := make([]deviceSystemEvent, len(addedDevices))
+	for i, d := range addedDevices {
+		for _, autoEvent := range d.AutoEvents {
+			utils.CheckMinInterval(autoEvent.Interval, minAutoEventInterval, lc)
+		}
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		recordAudit(ctx, common.SystemEventActionAdd, common.DeviceSystemEventType, d.Name, nil, deviceDTO, dic)
+		results[i] = metadataDTOs.BulkDeviceResult{Name: d.Name, Id: d.Id, StatusCode: http.StatusCreated}
+		events[i] = deviceSystemEvent{owner: d.ServiceName, device: deviceDTO}
+	}
+	go publishDeviceSystemEvents(common.SystemEventActionAdd, events, ctx, dic)
+
+	return results, nil
+}
+
+// UpdateDevices validates the whole batch of device updates up front and then applies them in a single write, so that
+// none of the devices is updated if any of them fails. The device validation of each device service is invoked for its
+// devices unless bypassValidation is true. The results are returned along with the error if any of the devices fails.
+// The request carrying the If-Match header is rejected, as the batch can't be conditioned on a single entity tag.
+func UpdateDevices(dtoList []dtos.UpdateDevice, ctx context.Context, dic *di.Container, bypassValidation bool) ([]metadataDTOs.BulkDeviceResult, errors.EdgeX) {
+	if err := rejectIfMatch(ctx); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	lookup := newBulkDeviceLookup(dbClient)
+	names := make([]string, len(dtoList))
+	errs := make([]errors.EdgeX, len(dtoList))
+	oldDevices := make([]models.Device, len(dtoList))
+	devices := make([]models.Device, len(dtoList))
+	seen := make(map[string]bool, len(dtoList))
+	for i, dto := range dtoList {
+		if dto.Name != nil {
+			names[i] = *dto.Name
+		} else if dto.Id != nil {
+			names[i] = *dto.Id
+		}
+		if dto.ServiceName != nil {
+			if err := lookup.checkServiceExists(*dto.ServiceName); err != nil {
+				errs[i] = err
+				continue
+			}
+		}
+
+		device, err := deviceByDTO(dbClient, dto)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		names[i] = device.Name
+		if seen[device.Name] {
+			errs[i] = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s is duplicated in the batch", device.Name), nil)
+			continue
+		}
+		seen[device.Name] = true
+
+		oldDevices[i] = device
+		requests.ReplaceDeviceModelFieldsWithDTO(&device, dto)
+		if err = resolvePatchedDeviceTemplate(&device, oldDevices[i], dto, lookup.deviceTemplateByName); err != nil {
+			errs[i] = err
+			continue
+		}
+		if err = validateDeviceWithProfile(device, lookup.deviceProfileByName); err != nil {
+			errs[i] = errors.NewCommonEdgeXWrapper(err)
+			continue
+		}
+		devices[i] = device
+	}
+	if results, err := bulkDeviceResults(names, errs, "updated"); err != nil {
+		return results, err
+	}
+
+	if container.ConfigurationFrom(dic.Get).Writable.MaxResources > 0 {
+		if err := checkResourceCapacityByUpdatedDevices(oldDevices, devices, dic); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	if !bypassValidation {
+		validateDevicesCallback(devices, errs, dic)
+		if results, err := bulkDeviceResults(names, errs, "updated"); err != nil {
+			return results, err
+		}
+	}
+
+	if err := dbClient.UpdateDevices(devices); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("%d devices updated on DB successfully. Correlation-ID: %s ", len(devices), correlation.FromContext(ctx))
+
+	results := make([]metadataDTOs.BulkDeviceResult, len(devices))
+	var events []deviceSystemEvent
+	for i, d := range devices {
+		for _, autoEvent := range d.AutoEvents {
+			utils.CheckMinInterval(autoEvent.Interval, minAutoEventInterval, lc)
+		}
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceSystemEventType, d.Name, dtos.FromDeviceModelToDTO(oldDevices[i]), deviceDTO, dic)
+		results[i] = metadataDTOs.BulkDeviceResult{Name: d.Name, Id: d.Id, StatusCode: http.StatusOK}
+		// the old device service is also notified if the device is moved to another device service
+		if oldDevices[i].ServiceName != d.ServiceName {
+			events = append(events, deviceSystemEvent{owner: oldDevices[i].ServiceName, device: deviceDTO})
+		}
+		events = append(events, deviceSystemEvent{owner: d.ServiceName, device: deviceDTO})
+	}
+	go publishDeviceSystemEvents(common.SystemEventActionUpdate, events, ctx, dic)
+
+	return results, nil
+}
+
+// DeleteDevicesByNames validates the whole batch of devices up front and then deletes them in a single write, so that
+// none of the devices is deleted if any of them fails. A device having child devices can only be deleted along with all
+// its children. The results are returned along with the error if any of the devices fails. The request carrying the
+// If-Match header is rejected, as the batch can't be conditioned on a single entity tag.
+func DeleteDevicesByNames(names []string, ctx context.Context, dic *di.Container) ([]metadataDTOs.BulkDeviceResult, errors.EdgeX) {
+	if err := rejectIfMatch(ctx); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+
+	inBatch := make(map[string]bool, len(names))
+	for _, name := range names {
+		inBatch[name] = true
+	}
+
+	errs := make([]errors.EdgeX, len(names))
+	devices := make([]models.Device, len(names))
+	seen := make(map[string]bool, len(names))
+	for i, name := range names {
+		if seen[name] {
+			errs[i] = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s is duplicated in the batch", name), nil)
+			continue
+		}
+		seen[name] = true
+
+		device, err := dbClient.DeviceByName(name)
+		if err != nil {
+			errs[i] = errors.NewCommonEdgeXWrapper(err)
+			continue
+		}
+		_, children, err := dbClient.DeviceTree(name, 1, 0, -1, nil)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, child := range children {
+			if !inBatch[child.Name] {
+				errs[i] = errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("cannot delete device with children, the child device '%s' is not in the batch", child.Name), nil)
+				break
+			}
+		}
+		devices[i] = device
+	}
+	if results, err := bulkDeviceResults(names, errs, "deleted"); err != nil {
+		return results, err
+	}
+
+	if err := dbClient.DeleteDevicesByNames(names, nil); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("%d devices deleted on DB successfully. Correlation-ID: %s ", len(devices), correlation.FromContext(ctx))
+
+	results := make([]metadataDTOs.BulkDeviceResult, len(devices))
+	events := make([]deviceSystemEvent, len(devices))
+	for i, d := range devices {
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		recordAudit(ctx, common.SystemEventActionDelete, common.DeviceSystemEventType, d.Name, deviceDTO, nil, dic)
+		results[i] = metadataDTOs.BulkDeviceResult{Name: d.Name, Id: d.Id, StatusCode: http.StatusOK}
+		events[i] = deviceSystemEvent{owner: d.ServiceName, device: deviceDTO}
+	}
+	go publishDeviceSystemEvents(common.SystemEventActionDelete, events, ctx, dic)
+
+	return results, nil
+}
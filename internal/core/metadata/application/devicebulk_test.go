@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataUtils "github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newBulkDeviceTestDIC(dbClient *mocks.DBClient, maxDevices uint32) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{MaxDevices: maxDevices},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		container.CapacityCheckLockName: func(get di.Get) interface{} {
+			return metadataUtils.NewCapacityCheckLock()
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+func TestAddDevices(t *testing.T) {
+	serviceName := "test-service"
+	notFoundServiceName := "notFoundService"
+	profileName := "test-profile"
+	existingName := "existingDevice"
+	device1 := models.Device{Name: "device1", ServiceName: serviceName, ProfileName: profileName}
+	device2 := models.Device{Name: "device2", ServiceName: serviceName, ProfileName: profileName}
+	existingDevice := models.Device{Name: existingName, ServiceName: serviceName, ProfileName: profileName}
+	notFoundServiceDevice := models.Device{Name: "device3", ServiceName: notFoundServiceName, ProfileName: profileName}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", serviceName).Return(true, nil)
+	dbClientMock.On("DeviceServiceNameExists", notFoundServiceName).Return(false, nil)
+	dbClientMock.On("DeviceProfileByName", profileName).Return(models.DeviceProfile{Name: profileName}, nil)
+	dbClientMock.On("DeviceNameExists", existingName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+	dbClientMock.On("DeviceCountByLabels", []string(nil)).Return(uint32(1), nil)
+	dbClientMock.On("AddDevices", []models.Device{device1, device2}).Return([]models.Device{{Id: "id1", Name: device1.Name}, {Id: "id2", Name: device2.Name}}, nil)
+
+	tests := []struct {
+		name                string
+		devices             []models.Device
+		maxDevices          uint32
+		errorExpected       bool
+		expectedErrorKind   errors.ErrKind
+		expectedStatusCodes []int
+	}{
+		{"Valid", []models.Device{device1, device2}, 0, false, "", []int{http.StatusCreated, http.StatusCreated}},
+		{"Invalid - duplicated in the batch", []models.Device{device1, device1}, 0, true, errors.KindDuplicateName, []int{http.StatusFailedDependency, http.StatusConflict}},
+		{"Invalid - device name exists", []models.Device{device1, existingDevice}, 0, true, errors.KindDuplicateName, []int{http.StatusFailedDependency, http.StatusConflict}},
+		{"Invalid - device service not found", []models.Device{notFoundServiceDevice, device2}, 0, true, errors.KindContractInvalid, []int{http.StatusBadRequest, http.StatusFailedDependency}},
+		{"Invalid - exceed the maximum devices", []models.Device{device1, device2}, 2, true, errors.KindContractInvalid, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := newBulkDeviceTestDIC(dbClientMock, testCase.maxDevices)
+			results, err := AddDevices(testCase.devices, context.Background(), dic, true)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, results, len(testCase.expectedStatusCodes))
+			for i, result := range results {
+				assert.Equal(t, testCase.devices[i].Name, result.Name)
+				assert.Equal(t, testCase.expectedStatusCodes[i], result.StatusCode)
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddDevices", 1)
+}
+
+func TestDeleteDevicesByNames(t *testing.T) {
+	parent := models.Device{Name: "parent", ServiceName: "test-service"}
+	child := models.Device{Name: "child", ServiceName: "test-service", Parent: parent.Name}
+	notFoundName := "notFound"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceByName", parent.Name).Return(parent, nil)
+	dbClientMock.On("DeviceByName", child.Name).Return(child, nil)
+	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceTree", parent.Name, 1, 0, -1, []string(nil)).Return(uint32(1), []models.Device{child}, nil)
+	dbClientMock.On("DeviceTree", child.Name, 1, 0, -1, []string(nil)).Return(uint32(0), []models.Device{}, nil)
+	dbClientMock.On("DeleteDevicesByNames", []string{child.Name, parent.Name}, mock.Anything).Return(nil)
+	dic := newBulkDeviceTestDIC(dbClientMock, 0)
+
+	tests := []struct {
+		name                string
+		names               []string
+		errorExpected       bool
+		expectedErrorKind   errors.ErrKind
+		expectedStatusCodes []int
+	}{
+		{"Valid - delete along with the children", []string{child.Name, parent.Name}, false, "", []int{http.StatusOK, http.StatusOK}},
+		{"Invalid - children not in the batch", []string{parent.Name}, true, errors.KindStatusConflict, []int{http.StatusConflict}},
+		{"Invalid - device not found", []string{child.Name, notFoundName}, true, errors.KindEntityDoesNotExist, []int{http.StatusFailedDependency, http.StatusNotFound}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := DeleteDevicesByNames(testCase.names, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, results, len(testCase.expectedStatusCodes))
+			for i, result := range results {
+				assert.Equal(t, testCase.names[i], result.Name)
+				assert.Equal(t, testCase.expectedStatusCodes[i], result.StatusCode)
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeleteDevicesByNames", 1)
+}
+
+func TestBulkDevicesRejectIfMatch(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dic := newBulkDeviceTestDIC(dbClientMock, 0)
+	ctx := utils.WithIfMatch(context.Background(), utils.ETag(1))
+	name := "device1"
+
+	results, err := UpdateDevices([]dtos.UpdateDevice{{Name: &name}}, ctx, dic, true)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Nil(t, results)
+
+	results, err = DeleteDevicesByNames([]string{name}, ctx, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Nil(t, results)
+
+	dbClientMock.AssertNotCalled(t, "UpdateDevices", mock.Anything)
+	dbClientMock.AssertNotCalled(t, "DeleteDevicesByNames", mock.Anything, mock.Anything)
+}
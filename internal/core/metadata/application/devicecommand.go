@@ -7,10 +7,13 @@ package application
 
 import (
 	"context"
+	"slices"
+
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -27,6 +30,7 @@ func AddDeviceProfileDeviceCommand(profileName string, deviceCommand models.Devi
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	before := dtos.FromDeviceProfileModelToDTO(profile)
 
 	profile.DeviceCommands = append(profile.DeviceCommands, deviceCommand)
 
@@ -36,10 +40,11 @@ func AddDeviceProfileDeviceCommand(profileName string, deviceCommand models.Devi
 		return errors.NewCommonEdgeXWrapper(validateErr)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	err = dbClient.UpdateDeviceProfile(profile, 0, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, profile.Name, before, profileDTO, dic)
 
 	lc.Debugf("DeviceProfile deviceCommands added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
@@ -55,6 +60,11 @@ func PatchDeviceProfileDeviceCommand(profileName string, dto dtos.UpdateDeviceCo
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, profile.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	before := dtos.FromDeviceProfileModelToDTO(profile)
 
 	// Find matched deviceCommand
 	index := -1
@@ -69,14 +79,15 @@ func PatchDeviceProfileDeviceCommand(profileName string, dto dtos.UpdateDeviceCo
 	}
 
 	requests.ReplaceDeviceCommandModelFieldsWithDTO(&profile.DeviceCommands[index], dto)
+	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	err = dbClient.UpdateDeviceProfile(profile, ifRevision, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, profile.Name, before, profileDTO, dic)
 
 	lc.Debugf("DeviceProfile deviceCommands patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
-	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -96,18 +107,15 @@ func DeleteDeviceCommandByName(profileName string, commandName string, ctx conte
 	}
 
 	dbClient := container.DBClientFrom(dic.Get)
-	// Check the associated Device existence
-	devices, err := dbClient.DevicesByProfileName(0, 1, profileName)
+	profile, err := dbClient.DeviceProfileByName(profileName)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
-	} else if len(devices) > 0 {
-		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to update the device profile when associated device exists", nil)
 	}
-
-	profile, err := dbClient.DeviceProfileByName(profileName)
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, profile.Name, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	before := dtos.FromDeviceProfileModelToDTO(profile)
 
 	index := -1
 	for i := range profile.DeviceCommands {
@@ -120,17 +128,24 @@ func DeleteDeviceCommandByName(profileName string, commandName string, ctx conte
 		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device command not found", nil)
 	}
 
-	profile.DeviceCommands = append(profile.DeviceCommands[:index], profile.DeviceCommands[index+1:]...)
+	stored := profile
+	profile.DeviceCommands = slices.Delete(slices.Clone(profile.DeviceCommands), index, index+1)
+	// the command removal breaks the devices using the profile, so it is rejected if the profile is in use
+	err = checkDeviceProfileChangeImpact(stored, profile, true, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 	e := (&profileDTO).Validate()
 	if e != nil {
 		return errors.NewCommonEdgeXWrapper(e)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	err = dbClient.UpdateDeviceProfile(profile, ifRevision, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, profile.Name, before, profileDTO, dic)
 
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 	return nil
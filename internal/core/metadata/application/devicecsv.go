@@ -0,0 +1,292 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	goErrors "errors"
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// deviceCsvColumns is the column indexes of the device fields mapped by the device CSV mapping, -1 if the field is not mapped
+type deviceCsvColumns struct {
+	name               int
+	description        int
+	parent             int
+	profileName        int
+	serviceName        int
+	labels             int
+	autoEvents         int
+	protocolProperties map[string]map[string]int
+}
+
+// newDeviceCsvColumns looks up the columns of the mapping in the CSV header, an error is returned if any mapped column
+// is not found. The UTF-8 byte order mark written by the spreadsheet applications is trimmed from the first column.
+func newDeviceCsvColumns(header []string, mapping metadataDTOs.DeviceCsvMapping) (deviceCsvColumns, errors.EdgeX) {
+	indexes := make(map[string]int, len(header))
+	for i, h := range header {
+		if i == 0 {
+			h = strings.TrimPrefix(h, "\ufeff")
+		}
+		indexes[strings.TrimSpace(h)] = i
+	}
+	var missing []string
+	index := func(column string) int {
+		if column == "" {
+			return -1
+		}
+		i, ok := indexes[column]
+		if !ok {
+			missing = append(missing, column)
+			return -1
+		}
+		return i
+	}
+
+	columns := deviceCsvColumns{
+		name:               index(mapping.Name),
+		description:        index(mapping.Description),
+		parent:             index(mapping.Parent),
+		profileName:        index(mapping.ProfileName),
+		serviceName:        index(mapping.ServiceName),
+		labels:             index(mapping.Labels),
+		autoEvents:         index(mapping.AutoEvents),
+		protocolProperties: make(map[string]map[string]int, len(mapping.ProtocolProperties)),
+	}
+	for protocol, properties := range mapping.ProtocolProperties {
+		columns.protocolProperties[protocol] = make(map[string]int, len(properties))
+		for property, column := range properties {
+			columns.protocolProperties[protocol][property] = index(column)
+		}
+	}
+	if len(missing) > 0 {
+		return columns, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the mapped columns %v are not found in the CSV header", missing), nil)
+	}
+	return columns, nil
+}
+
+// value returns the trimmed value of the column in the record and whether the column is mapped
+func (c deviceCsvColumns) value(record []string, column int) (string, bool) {
+	if column < 0 {
+		return "", false
+	}
+	return strings.TrimSpace(record[column]), true
+}
+
+// UploadDevicesCsv creates or updates the devices from the rows of the device CSV, whose columns are mapped to the device
+// fields by the mapping. The new devices are added, while the existing ones are patched with the mapped fields only.
+// Each row is applied separately and reported along with its line number, the error is only returned if the CSV itself
+// can't be read.
+func UploadDevicesCsv(r io.Reader, mapping metadataDTOs.DeviceCsvMapping, bypassValidation bool, ctx context.Context, dic *di.Container) ([]metadataDTOs.DeviceCsvRowResult, errors.EdgeX) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the CSV is empty", nil)
+	} else if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the CSV header", err)
+	}
+	columns, edgeXerr := newDeviceCsvColumns(header, mapping)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	results := []metadataDTOs.DeviceCsvRowResult{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if goErrors.As(err, &parseErr) && goErrors.Is(err, csv.ErrFieldCount) {
+			results = append(results, metadataDTOs.DeviceCsvRowResult{
+				Line:    parseErr.StartLine,
+				Action:  constants.DeviceCsvActionFailed,
+				Message: fmt.Sprintf("the row has %d fields, %d fields are expected as the header", len(record), len(header)),
+			})
+			continue
+		} else if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the CSV", err)
+		}
+		line, _ := reader.FieldPos(0)
+		results = append(results, uploadDeviceCsvRow(line, record, columns, mapping, bypassValidation, ctx, dic))
+	}
+
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("Device CSV uploaded with %d rows. Correlation-ID: %s ", len(results), correlation.FromContext(ctx))
+	return results, nil
+}
+
+// uploadDeviceCsvRow adds or patches the device of a row in the device CSV
+func uploadDeviceCsvRow(line int, record []string, columns deviceCsvColumns, mapping metadataDTOs.DeviceCsvMapping, bypassValidation bool,
+	ctx context.Context, dic *di.Container) metadataDTOs.DeviceCsvRowResult {
+	name, _ := columns.value(record, columns.name)
+	result := metadataDTOs.DeviceCsvRowResult{Line: line, Name: name}
+	failed := func(err errors.EdgeX) metadataDTOs.DeviceCsvRowResult {
+		result.Action = constants.DeviceCsvActionFailed
+		result.Message = err.Message()
+		return result
+	}
+	if name == "" {
+		return failed(errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil))
+	}
+
+	update := dtos.UpdateDevice{Name: &name}
+	if description, ok := columns.value(record, columns.description); ok {
+		update.Description = &description
+	}
+	if parent, ok := columns.value(record, columns.parent); ok {
+		update.Parent = &parent
+	}
+	if profileName, _ := columns.value(record, columns.profileName); profileName != "" {
+		update.ProfileName = &profileName
+	} else if mapping.DefaultProfileName != "" {
+		update.ProfileName = &mapping.DefaultProfileName
+	}
+	if serviceName, _ := columns.value(record, columns.serviceName); serviceName != "" {
+		update.ServiceName = &serviceName
+	} else if mapping.DefaultServiceName != "" {
+		update.ServiceName = &mapping.DefaultServiceName
+	}
+	if labels, ok := columns.value(record, columns.labels); ok {
+		update.Labels = splitDeviceCsvList(labels)
+	}
+	if autoEvents, ok := columns.value(record, columns.autoEvents); ok {
+		var err errors.EdgeX
+		update.AutoEvents, err = parseDeviceCsvAutoEvents(autoEvents)
+		if err != nil {
+			return failed(err)
+		}
+	}
+	if len(columns.protocolProperties) > 0 {
+		update.Protocols = make(map[string]dtos.ProtocolProperties, len(columns.protocolProperties))
+		for protocol, properties := range columns.protocolProperties {
+			protocolProperties := make(dtos.ProtocolProperties, len(properties))
+			for property, column := range properties {
+				if value, _ := columns.value(record, column); value != "" {
+					protocolProperties[property] = value
+				}
+			}
+			update.Protocols[protocol] = protocolProperties
+		}
+	}
+
+	exists, err := DeviceNameExists(name, dic)
+	if err != nil {
+		return failed(err)
+	}
+	if exists {
+		if update.Protocols != nil {
+			stored, err := DeviceByName(name, dic)
+			if err != nil {
+				return failed(err)
+			}
+			update.Protocols = mergeDeviceCsvProtocols(stored.Protocols, update.Protocols)
+		}
+		if validateErr := common.Validate(update); validateErr != nil {
+			return failed(errors.NewCommonEdgeXWrapper(validateErr))
+		}
+		if err = PatchDevice(update, ctx, dic, bypassValidation); err != nil {
+			return failed(err)
+		}
+		result.Action = constants.DeviceCsvActionUpdated
+		return result
+	}
+
+	device := dtos.Device{
+		Name:           name,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         update.Labels,
+		AutoEvents:     update.AutoEvents,
+		Protocols:      update.Protocols,
+		Properties:     make(map[string]any),
+	}
+	if update.Description != nil {
+		device.Description = *update.Description
+	}
+	if update.Parent != nil {
+		device.Parent = *update.Parent
+	}
+	if update.ProfileName != nil {
+		device.ProfileName = *update.ProfileName
+	}
+	if update.ServiceName != nil {
+		device.ServiceName = *update.ServiceName
+	}
+	if device.Protocols == nil {
+		device.Protocols = make(map[string]dtos.ProtocolProperties)
+	}
+	if validateErr := common.Validate(device); validateErr != nil {
+		return failed(errors.NewCommonEdgeXWrapper(validateErr))
+	}
+	result.Id, err = AddDevice(dtos.ToDeviceModel(device), ctx, dic, bypassValidation, false)
+	if err != nil {
+		return failed(err)
+	}
+	result.Action = constants.DeviceCsvActionCreated
+	return result
+}
+
+// mergeDeviceCsvProtocols merges the protocol properties mapped from a row into the stored protocols of the device, as
+// the PATCH replaces the protocols as a whole. The protocols and the properties not mapped or left blank in the row are
+// kept as stored.
+func mergeDeviceCsvProtocols(stored map[string]dtos.ProtocolProperties, mapped map[string]dtos.ProtocolProperties) map[string]dtos.ProtocolProperties {
+	merged := make(map[string]dtos.ProtocolProperties, len(stored)+len(mapped))
+	for protocol, properties := range stored {
+		merged[protocol] = maps.Clone(properties)
+	}
+	for protocol, properties := range mapped {
+		if merged[protocol] == nil {
+			merged[protocol] = make(dtos.ProtocolProperties, len(properties))
+		}
+		maps.Copy(merged[protocol], properties)
+	}
+	return merged
+}
+
+// splitDeviceCsvList splits the list in a cell of the device CSV, the empty items are dropped
+func splitDeviceCsvList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, constants.DeviceCsvListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseDeviceCsvAutoEvents parses the auto events in a cell of the device CSV, each of which is the source name and the
+// interval separated by "=", e.g. "Temperature=10s;Humidity=1m"
+func parseDeviceCsvAutoEvents(value string) ([]dtos.AutoEvent, errors.EdgeX) {
+	items := splitDeviceCsvList(value)
+	autoEvents := make([]dtos.AutoEvent, len(items))
+	for i, item := range items {
+		sourceName, interval, found := strings.Cut(item, constants.DeviceCsvAutoEventSeparator)
+		sourceName, interval = strings.TrimSpace(sourceName), strings.TrimSpace(interval)
+		if !found || sourceName == "" || interval == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("auto event '%s' is not in the format of <sourceName>%s<interval>", item, constants.DeviceCsvAutoEventSeparator), nil)
+		}
+		autoEvents[i] = dtos.AutoEvent{SourceName: sourceName, Interval: interval}
+	}
+	return autoEvents, nil
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadDevicesCsv(t *testing.T) {
+	serviceName := "test-service"
+	profileName := "test-profile"
+	existingDevice := models.Device{Id: "id1", Name: "existingDevice", ServiceName: serviceName, ProfileName: profileName, AdminState: models.Unlocked, OperatingState: models.Up,
+		Protocols: map[string]models.ProtocolProperties{
+			"modbus-tcp": {"Address": "10.0.0.9", "Port": "502", "UnitID": "1"},
+			"other":      {"Key": "value"},
+		}}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", serviceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileByName", profileName).Return(models.DeviceProfile{Name: profileName, DeviceResources: []models.DeviceResource{{Name: "Temperature"}}}, nil)
+	dbClientMock.On("DeviceNameExists", existingDevice.Name).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+	dbClientMock.On("DeviceByName", existingDevice.Name).Return(existingDevice, nil)
+	var added models.Device
+	dbClientMock.On("AddDevice", mock.Anything).Run(func(args mock.Arguments) {
+		added = args.Get(0).(models.Device)
+	}).Return(func(d models.Device) models.Device {
+		d.Id = "id2"
+		return d
+	}, nil)
+	var updated models.Device
+	dbClientMock.On("UpdateDevice", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(0).(models.Device)
+	}).Return(nil)
+	dic := newBulkDeviceTestDIC(dbClientMock, 0)
+
+	mapping := metadataDTOs.DeviceCsvMapping{
+		Name:               "Device Name",
+		Labels:             "Tags",
+		ProtocolProperties: map[string]map[string]string{"modbus-tcp": {"Address": "IP", "Port": "Port"}},
+		AutoEvents:         "Readings",
+		DefaultProfileName: profileName,
+		DefaultServiceName: serviceName,
+	}
+	// the header starts with the UTF-8 byte order mark written by the spreadsheet applications
+	csv := "\ufeff" + `Device Name,Tags,IP,Port,Readings
+newDevice,floor1;hvac,10.0.0.1,502,Temperature=10s
+existingDevice,floor2,10.0.0.2,,
+,floor3,10.0.0.3,502,
+badRow,floor4
+badAutoEvent,floor5,10.0.0.5,502,Temperature
+`
+	results, err := UploadDevicesCsv(strings.NewReader(csv), mapping, true, context.Background(), dic)
+	require.NoError(t, err)
+
+	expected := []metadataDTOs.DeviceCsvRowResult{
+		{Line: 2, Name: "newDevice", Action: constants.DeviceCsvActionCreated, Id: "id2"},
+		{Line: 3, Name: existingDevice.Name, Action: constants.DeviceCsvActionUpdated},
+		{Line: 4, Action: constants.DeviceCsvActionFailed},
+		{Line: 5, Action: constants.DeviceCsvActionFailed},
+		{Line: 6, Name: "badAutoEvent", Action: constants.DeviceCsvActionFailed},
+	}
+	require.Len(t, results, len(expected))
+	for i, result := range results {
+		assert.Equal(t, expected[i].Line, result.Line)
+		assert.Equal(t, expected[i].Name, result.Name)
+		assert.Equal(t, expected[i].Action, result.Action)
+		assert.Equal(t, expected[i].Id, result.Id)
+		if result.Action == constants.DeviceCsvActionFailed {
+			assert.NotEmpty(t, result.Message)
+		} else {
+			assert.Empty(t, result.Message)
+		}
+	}
+
+	dbClientMock.AssertNumberOfCalls(t, "AddDevice", 1)
+	assert.Equal(t, []string{"floor1", "hvac"}, added.Labels)
+	assert.Equal(t, models.ProtocolProperties{"Address": "10.0.0.1", "Port": "502"}, added.Protocols["modbus-tcp"])
+	assert.Equal(t, []models.AutoEvent{{SourceName: "Temperature", Interval: "10s"}}, added.AutoEvents)
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", 1)
+	// the mapped properties are merged into the stored protocols, the blank cell and the unmapped ones are kept as stored
+	assert.Equal(t, map[string]models.ProtocolProperties{
+		"modbus-tcp": {"Address": "10.0.0.2", "Port": "502", "UnitID": "1"},
+		"other":      {"Key": "value"},
+	}, updated.Protocols)
+}
+
+func TestUploadDevicesCsvInvalid(t *testing.T) {
+	dic := newBulkDeviceTestDIC(&mocks.DBClient{}, 0)
+
+	tests := []struct {
+		name    string
+		csv     string
+		mapping metadataDTOs.DeviceCsvMapping
+	}{
+		{"Invalid - empty CSV", "", metadataDTOs.DeviceCsvMapping{Name: "Name"}},
+		{"Invalid - mapped column not found", "Name,Profile\ndevice1,profile1\n", metadataDTOs.DeviceCsvMapping{Name: "Name", ServiceName: "Service"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := UploadDevicesCsv(strings.NewReader(testCase.csv), testCase.mapping, true, context.Background(), dic)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
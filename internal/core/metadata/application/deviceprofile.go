@@ -36,7 +36,7 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	}
 
 	correlationId := correlation.FromContext(ctx)
-	addedDeviceProfile, err := dbClient.AddDeviceProfile(d)
+	addedDeviceProfile, err := dbClient.AddDeviceProfile(d, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
@@ -48,6 +48,7 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(addedDeviceProfile)
+	recordAudit(ctx, common.SystemEventActionAdd, common.DeviceProfileSystemEventType, addedDeviceProfile.Name, nil, profileDTO, dic)
 	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
 
 	return addedDeviceProfile.Id, nil
@@ -71,7 +72,20 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 		}
 	}
 
-	err = dbClient.UpdateDeviceProfile(d)
+	stored, err := dbClient.DeviceProfileByName(d.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, stored.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = checkDeviceProfileChangeImpact(stored, d, config.Writable.ProfileChange.StrictBreakingChanges, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = dbClient.UpdateDeviceProfile(d, ifRevision, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -87,6 +101,7 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, profile.Name, dtos.FromDeviceProfileModelToDTO(stored), profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -129,6 +144,10 @@ func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Contain
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, profile.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	// Check the associated Device and ProvisionWatcher existence
 	devices, edgeXErr := dbClient.DevicesByProfileName(0, 1, name)
 	if edgeXErr != nil {
@@ -145,12 +164,13 @@ func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the device profile when associated provisionWatcher exists", nil)
 	}
 
-	err = dbClient.DeleteDeviceProfileByName(name)
+	err = dbClient.DeleteDeviceProfileByName(name, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordAudit(ctx, common.SystemEventActionDelete, common.DeviceProfileSystemEventType, profile.Name, profileDTO, nil, dic)
 	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
 
 	return nil
@@ -271,9 +291,14 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, deviceProfile.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
+	before := dtos.FromDeviceProfileModelToDTO(deviceProfile)
 	requests.ReplaceDeviceProfileModelBasicInfoFieldsWithDTO(&deviceProfile, dto)
-	err = dbClient.UpdateDeviceProfile(deviceProfile)
+	err = dbClient.UpdateDeviceProfile(deviceProfile, ifRevision, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -284,6 +309,7 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(deviceProfile)
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, deviceProfile.Name, before, profileDTO, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
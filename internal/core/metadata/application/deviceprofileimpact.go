@@ -0,0 +1,226 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// DeviceProfileChangeImpact computes the impact of replacing the stored device profile with the given one on the devices
+// using the profile, without applying the change
+func DeviceProfileChangeImpact(profile models.DeviceProfile, dic *di.Container) (impact metadataDTOs.DeviceProfileImpact, err errors.EdgeX) {
+	stored, err := container.DBClientFrom(dic.Get).DeviceProfileByName(profile.Name)
+	if err != nil {
+		return impact, errors.NewCommonEdgeXWrapper(err)
+	}
+	return profileChangeImpact(stored, profile, dic)
+}
+
+// DeleteDeviceResourceImpact computes the impact of deleting the device resource from the device profile, without
+// deleting the resource
+func DeleteDeviceResourceImpact(profileName string, resourceName string, dic *di.Container) (impact metadataDTOs.DeviceProfileImpact, err errors.EdgeX) {
+	stored, err := container.DBClientFrom(dic.Get).DeviceProfileByName(profileName)
+	if err != nil {
+		return impact, errors.NewCommonEdgeXWrapper(err)
+	}
+	if _, err = resourceByName(stored.DeviceResources, resourceName); err != nil {
+		return impact, errors.NewCommonEdgeXWrapper(err)
+	}
+	profile := stored
+	profile.DeviceResources = slices.DeleteFunc(slices.Clone(stored.DeviceResources), func(r models.DeviceResource) bool {
+		return r.Name == resourceName
+	})
+	return profileChangeImpact(stored, profile, dic)
+}
+
+// DeleteDeviceCommandImpact computes the impact of deleting the device command from the device profile, without
+// deleting the command
+func DeleteDeviceCommandImpact(profileName string, commandName string, dic *di.Container) (impact metadataDTOs.DeviceProfileImpact, err errors.EdgeX) {
+	stored, err := container.DBClientFrom(dic.Get).DeviceProfileByName(profileName)
+	if err != nil {
+		return impact, errors.NewCommonEdgeXWrapper(err)
+	}
+	if !slices.ContainsFunc(stored.DeviceCommands, func(c models.DeviceCommand) bool { return c.Name == commandName }) {
+		return impact, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device command %s not exists", commandName), nil)
+	}
+	profile := stored
+	profile.DeviceCommands = slices.DeleteFunc(slices.Clone(stored.DeviceCommands), func(c models.DeviceCommand) bool {
+		return c.Name == commandName
+	})
+	return profileChangeImpact(stored, profile, dic)
+}
+
+// profileChangeImpact computes the impact of replacing the stored device profile with the given one on the devices using
+// the profile
+func profileChangeImpact(stored models.DeviceProfile, profile models.DeviceProfile, dic *di.Container) (impact metadataDTOs.DeviceProfileImpact, err errors.EdgeX) {
+	devices, err := container.DBClientFrom(dic.Get).DevicesByProfileName(0, -1, stored.Name)
+	if err != nil {
+		return impact, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceProfileImpact(stored, profile, devices), nil
+}
+
+// checkDeviceProfileChangeImpact computes the impact of replacing the stored device profile with the given one, and
+// rejects the breaking changes to the profile in use if strict is true, otherwise the breaking changes are logged
+func checkDeviceProfileChangeImpact(stored models.DeviceProfile, profile models.DeviceProfile, strict bool, dic *di.Container) errors.EdgeX {
+	impact, err := profileChangeImpact(stored, profile, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if !impact.InUse || !impact.Breaking {
+		return nil
+	}
+
+	deviceNames := make([]string, len(impact.AffectedDevices))
+	for i, d := range impact.AffectedDevices {
+		deviceNames[i] = d.Name
+	}
+	messages := make([]string, len(impact.Changes))
+	for i, c := range impact.Changes {
+		messages[i] = c.Message
+	}
+	summary := fmt.Sprintf("breaking changes to device profile %s affect the devices [%s]: %s",
+		profile.Name, strings.Join(deviceNames, ", "), strings.Join(messages, "; "))
+	if strict {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, summary, nil)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Warn(summary)
+	return nil
+}
+
+// deviceProfileImpact compares the device profile with its new version, and returns the changes breaking the devices
+// using the profile. The profile changes affect all the devices, while the auto event changes affect the device
+// reading the removed source only.
+func deviceProfileImpact(from models.DeviceProfile, to models.DeviceProfile, devices []models.Device) metadataDTOs.DeviceProfileImpact {
+	impact := metadataDTOs.DeviceProfileImpact{
+		ProfileName:     from.Name,
+		InUse:           len(devices) > 0,
+		Changes:         []metadataDTOs.DeviceProfileBreakingChange{},
+		AffectedDevices: []metadataDTOs.AffectedDevice{},
+	}
+
+	toResources := make(map[string]models.DeviceResource, len(to.DeviceResources))
+	for _, r := range to.DeviceResources {
+		toResources[r.Name] = r
+	}
+	toCommands := make(map[string]models.DeviceCommand, len(to.DeviceCommands))
+	for _, c := range to.DeviceCommands {
+		toCommands[c.Name] = c
+	}
+
+	for _, old := range from.DeviceResources {
+		r, ok := toResources[old.Name]
+		switch {
+		case !ok:
+			impact.Changes = append(impact.Changes, metadataDTOs.DeviceProfileBreakingChange{
+				Type:         constants.BreakingChangeResourceRemoved,
+				ResourceName: old.Name,
+				Message:      fmt.Sprintf("device resource %s is removed", old.Name),
+			})
+		case r.Properties.ValueType != old.Properties.ValueType:
+			impact.Changes = append(impact.Changes, metadataDTOs.DeviceProfileBreakingChange{
+				Type:         constants.BreakingChangeResourceRetyped,
+				ResourceName: old.Name,
+				OldValue:     old.Properties.ValueType,
+				NewValue:     r.Properties.ValueType,
+				Message:      fmt.Sprintf("value type of device resource %s is changed from %s to %s", old.Name, old.Properties.ValueType, r.Properties.ValueType),
+			})
+		case lostPermission(old.Properties.ReadWrite, r.Properties.ReadWrite):
+			impact.Changes = append(impact.Changes, metadataDTOs.DeviceProfileBreakingChange{
+				Type:         constants.BreakingChangeResourceReadWrite,
+				ResourceName: old.Name,
+				OldValue:     old.Properties.ReadWrite,
+				NewValue:     r.Properties.ReadWrite,
+				Message:      fmt.Sprintf("read/write mode of device resource %s is changed from %s to %s", old.Name, old.Properties.ReadWrite, r.Properties.ReadWrite),
+			})
+		}
+	}
+
+	for _, old := range from.DeviceCommands {
+		c, ok := toCommands[old.Name]
+		switch {
+		case !ok:
+			impact.Changes = append(impact.Changes, metadataDTOs.DeviceProfileBreakingChange{
+				Type:        constants.BreakingChangeCommandRemoved,
+				CommandName: old.Name,
+				Message:     fmt.Sprintf("device command %s is removed", old.Name),
+			})
+		case lostPermission(old.ReadWrite, c.ReadWrite):
+			impact.Changes = append(impact.Changes, metadataDTOs.DeviceProfileBreakingChange{
+				Type:        constants.BreakingChangeCommandReadWrite,
+				CommandName: old.Name,
+				OldValue:    old.ReadWrite,
+				NewValue:    c.ReadWrite,
+				Message:     fmt.Sprintf("read/write mode of device command %s is changed from %s to %s", old.Name, old.ReadWrite, c.ReadWrite),
+			})
+		}
+	}
+	for _, c := range to.DeviceCommands {
+		for _, ro := range c.ResourceOperations {
+			if _, ok := toResources[ro.DeviceResource]; !ok {
+				impact.Changes = append(impact.Changes, metadataDTOs.DeviceProfileBreakingChange{
+					Type:         constants.BreakingChangeCommandResourceMissing,
+					CommandName:  c.Name,
+					ResourceName: ro.DeviceResource,
+					Message:      fmt.Sprintf("device command %s references the missing device resource %s", c.Name, ro.DeviceResource),
+				})
+			}
+		}
+	}
+	profileBreaking := len(impact.Changes) > 0
+
+	for _, d := range devices {
+		affected := profileBreaking
+		for _, autoEvent := range d.AutoEvents {
+			_, isResource := toResources[autoEvent.SourceName]
+			_, isCommand := toCommands[autoEvent.SourceName]
+			if isResource || isCommand || !hasSource(from, autoEvent.SourceName) {
+				continue
+			}
+			impact.Changes = append(impact.Changes, metadataDTOs.DeviceProfileBreakingChange{
+				Type:       constants.BreakingChangeAutoEventSourceRemoved,
+				DeviceName: d.Name,
+				OldValue:   autoEvent.SourceName,
+				Message:    fmt.Sprintf("auto event source %s of device %s does not exist", autoEvent.SourceName, d.Name),
+			})
+			affected = true
+		}
+		if affected {
+			impact.AffectedDevices = append(impact.AffectedDevices, metadataDTOs.AffectedDevice{Name: d.Name, ServiceName: d.ServiceName})
+		}
+	}
+	impact.Breaking = len(impact.Changes) > 0
+	return impact
+}
+
+// hasSource checks whether the device resource or device command exists in the device profile
+func hasSource(profile models.DeviceProfile, sourceName string) bool {
+	return slices.ContainsFunc(profile.DeviceResources, func(r models.DeviceResource) bool { return r.Name == sourceName }) ||
+		slices.ContainsFunc(profile.DeviceCommands, func(c models.DeviceCommand) bool { return c.Name == sourceName })
+}
+
+// lostPermission checks whether the new read/write mode lacks a permission of the old one
+func lostPermission(oldReadWrite string, newReadWrite string) bool {
+	for _, permission := range []string{common.ReadWrite_R, common.ReadWrite_W} {
+		if strings.Contains(oldReadWrite, permission) && !strings.Contains(newReadWrite, permission) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceProfileImpact(t *testing.T) {
+	from := buildRevisionTestProfile()
+	devices := []models.Device{
+		{Name: "device1", ServiceName: "service1", AutoEvents: []models.AutoEvent{{SourceName: "setpoint"}}},
+		{Name: "device2", ServiceName: "service1"},
+	}
+
+	compatible := buildRevisionTestProfile()
+	compatible.DeviceResources[0].Properties.Units = "F"
+	compatible.DeviceResources[0].Properties.ReadWrite = "RW"
+	removedResource := buildRevisionTestProfile()
+	removedResource.DeviceResources = removedResource.DeviceResources[:1]
+	retypedResource := buildRevisionTestProfile()
+	retypedResource.DeviceResources[0].Properties.ValueType = "Int32"
+	readOnlyResource := buildRevisionTestProfile()
+	readOnlyResource.DeviceResources[1].Properties.ReadWrite = "R"
+	removedCommand := buildRevisionTestProfile()
+	removedCommand.DeviceCommands = nil
+	writeOnlyCommand := buildRevisionTestProfile()
+	writeOnlyCommand.DeviceCommands[0].ReadWrite = "W"
+	missingResource := buildRevisionTestProfile()
+	missingResource.DeviceCommands[0].ResourceOperations = append(missingResource.DeviceCommands[0].ResourceOperations, models.ResourceOperation{DeviceResource: "humidity"})
+
+	tests := []struct {
+		name                    string
+		to                      models.DeviceProfile
+		devices                 []models.Device
+		expectedChanges         []string
+		expectedAffectedDevices []string
+	}{
+		{"compatible change", compatible, devices, nil, nil},
+		{"removed resource", removedResource, devices,
+			[]string{constants.BreakingChangeResourceRemoved, constants.BreakingChangeAutoEventSourceRemoved}, []string{"device1", "device2"}},
+		{"retyped resource", retypedResource, devices, []string{constants.BreakingChangeResourceRetyped}, []string{"device1", "device2"}},
+		{"read only resource", readOnlyResource, devices, []string{constants.BreakingChangeResourceReadWrite}, []string{"device1", "device2"}},
+		{"removed command", removedCommand, devices, []string{constants.BreakingChangeCommandRemoved}, []string{"device1", "device2"}},
+		{"write only command", writeOnlyCommand, devices, []string{constants.BreakingChangeCommandReadWrite}, []string{"device1", "device2"}},
+		{"command references missing resource", missingResource, devices, []string{constants.BreakingChangeCommandResourceMissing}, []string{"device1", "device2"}},
+		{"profile not in use", removedResource, nil, []string{constants.BreakingChangeResourceRemoved}, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			impact := deviceProfileImpact(from, testCase.to, testCase.devices)
+
+			var changes []string
+			for _, c := range impact.Changes {
+				changes = append(changes, c.Type)
+			}
+			var affectedDevices []string
+			for _, d := range impact.AffectedDevices {
+				affectedDevices = append(affectedDevices, d.Name)
+			}
+			assert.Equal(t, testCase.expectedChanges, changes)
+			assert.Equal(t, testCase.expectedAffectedDevices, affectedDevices)
+			assert.Equal(t, len(testCase.expectedChanges) > 0, impact.Breaking)
+			assert.Equal(t, len(testCase.devices) > 0, impact.InUse)
+		})
+	}
+}
+
+func TestCheckDeviceProfileChangeImpact(t *testing.T) {
+	stored := buildRevisionTestProfile()
+	breaking := buildRevisionTestProfile()
+	breaking.DeviceCommands = nil
+
+	dbClient := &mocks.DBClient{}
+	dbClient.On("DevicesByProfileName", 0, -1, testProfileName).Return([]models.Device{{Name: "device1"}}, nil)
+	dic := newRevisionTestDIC(dbClient, 0)
+
+	tests := []struct {
+		name          string
+		profile       models.DeviceProfile
+		strict        bool
+		errorExpected bool
+	}{
+		{"valid - compatible change in strict mode", stored, true, false},
+		{"valid - breaking change in non-strict mode", breaking, false, false},
+		{"invalid - breaking change in strict mode", breaking, true, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkDeviceProfileChangeImpact(stored, testCase.profile, testCase.strict, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+				assert.Contains(t, err.Message(), "device1")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// maxDeviceProfileRevisions returns the number of the latest revisions kept in the revision history of each device
+// profile, which is passed to the profile writes recording the revisions in the same transaction, 0 disables the history
+func maxDeviceProfileRevisions(dic *di.Container) uint32 {
+	return container.ConfigurationFrom(dic.Get).Writable.ProfileChange.MaxRevisions
+}
+
+// DeviceProfileRevisions query the revisions of the device profile with offset and limit, the latest revision comes first
+func DeviceProfileRevisions(profileName string, offset int, limit int, dic *di.Container) (revisions []metadataDTOs.DeviceProfileRevision, totalCount uint32, err errors.EdgeX) {
+	if profileName == "" {
+		return revisions, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.DeviceProfileRevisionCountByProfileName(profileName)
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []metadataDTOs.DeviceProfileRevision{}, totalCount, err
+	}
+
+	rs, err := dbClient.DeviceProfileRevisionsByProfileName(offset, limit, profileName)
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	revisions = make([]metadataDTOs.DeviceProfileRevision, len(rs))
+	for i, r := range rs {
+		revisions[i] = metadataDTOs.FromDeviceProfileRevisionModelToDTO(r)
+	}
+	return revisions, totalCount, nil
+}
+
+// DiffDeviceProfileRevisions returns the changes from a revision of the device profile to another
+func DiffDeviceProfileRevisions(profileName string, from uint64, to uint64, dic *di.Container) (diff metadataDTOs.DeviceProfileDiff, err errors.EdgeX) {
+	if profileName == "" {
+		return diff, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	fromRevision, err := dbClient.DeviceProfileRevisionByProfileNameAndRevision(profileName, from)
+	if err != nil {
+		return diff, errors.NewCommonEdgeXWrapper(err)
+	}
+	toRevision, err := dbClient.DeviceProfileRevisionByProfileNameAndRevision(profileName, to)
+	if err != nil {
+		return diff, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	changes, err := diffDeviceProfiles(fromRevision.Profile, toRevision.Profile)
+	if err != nil {
+		return diff, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.DeviceProfileDiff{
+		ProfileName:  profileName,
+		FromRevision: from,
+		ToRevision:   to,
+		Changes:      changes,
+	}, nil
+}
+
+// RollbackDeviceProfile restores the device profile to the given revision. The restored profile goes through the same
+// validation as a profile update including the If-Match check of the context, and is recorded as a new revision.
+func RollbackDeviceProfile(profileName string, revision uint64, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if profileName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	current, err := dbClient.DeviceProfileByName(profileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	r, err := dbClient.DeviceProfileRevisionByProfileNameAndRevision(profileName, revision)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	profileDTO := dtos.FromDeviceProfileModelToDTO(r.Profile)
+	profileDTO.Id = current.Id
+	validateErr := profileDTO.Validate()
+	if validateErr != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("revision %d of device profile %s is not valid", revision, profileName), validateErr)
+	}
+
+	err = UpdateDeviceProfile(dtos.ToDeviceProfileModel(profileDTO), ctx, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// diffDeviceProfiles compares the JSON representation of the device profiles, the device resources and device commands
+// are matched by name so that the changes are located regardless of their order
+func diffDeviceProfiles(from models.DeviceProfile, to models.DeviceProfile) ([]metadataDTOs.FieldChange, errors.EdgeX) {
+	return diffJSON(dtos.FromDeviceProfileModelToDTO(from), dtos.FromDeviceProfileModelToDTO(to), ignoredDiffFields)
+}
@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProfileName = "thermostat"
+
+func buildRevisionTestProfile() models.DeviceProfile {
+	return models.DeviceProfile{
+		Id:     "08f4c2e9-21a2-4c0b-a1c3-1e7f2e0a9f5d",
+		Name:   testProfileName,
+		Labels: []string{"hvac"},
+		DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Properties: models.ResourceProperties{ValueType: "Float32", ReadWrite: "R", Units: "C"}},
+			{Name: "setpoint", Properties: models.ResourceProperties{ValueType: "Float32", ReadWrite: "RW"}},
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "status", ReadWrite: "R", ResourceOperations: []models.ResourceOperation{{DeviceResource: "temperature"}}},
+		},
+	}
+}
+
+func TestDiffDeviceProfiles(t *testing.T) {
+	from := buildRevisionTestProfile()
+
+	changedProperty := buildRevisionTestProfile()
+	changedProperty.DeviceResources[0].Properties.Units = "F"
+	changedProperty.Modified = 1000
+	reordered := buildRevisionTestProfile()
+	reordered.DeviceResources[0], reordered.DeviceResources[1] = reordered.DeviceResources[1], reordered.DeviceResources[0]
+	addedResource := buildRevisionTestProfile()
+	addedResource.DeviceResources = append(addedResource.DeviceResources, models.DeviceResource{Name: "humidity", Properties: models.ResourceProperties{ValueType: "Float32", ReadWrite: "R"}})
+	removedCommand := buildRevisionTestProfile()
+	removedCommand.DeviceCommands = nil
+	changedLabels := buildRevisionTestProfile()
+	changedLabels.Labels = []string{"hvac", "floor1"}
+
+	tests := []struct {
+		name            string
+		to              models.DeviceProfile
+		expectedPaths   []string
+		expectedChanges []string
+	}{
+		{"no change", from, nil, nil},
+		{"changed property", changedProperty, []string{"deviceResources[temperature].properties.units"}, []string{constants.FieldChangeChanged}},
+		{"reordered resources", reordered, nil, nil},
+		{"added resource", addedResource, []string{"deviceResources[humidity]"}, []string{constants.FieldChangeAdded}},
+		{"removed command", removedCommand, []string{"deviceCommands[status]"}, []string{constants.FieldChangeRemoved}},
+		{"added label", changedLabels, []string{"labels[1]"}, []string{constants.FieldChangeAdded}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			changes, err := diffDeviceProfiles(from, testCase.to)
+			require.NoError(t, err)
+			require.Len(t, changes, len(testCase.expectedPaths))
+			for i, change := range changes {
+				assert.Equal(t, testCase.expectedPaths[i], change.Path)
+				assert.Equal(t, testCase.expectedChanges[i], change.Type)
+			}
+		})
+	}
+}
+
+func TestDiffDeviceProfileRevisions(t *testing.T) {
+	from := buildRevisionTestProfile()
+	to := buildRevisionTestProfile()
+	to.Description = "thermostat of floor 1"
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", testProfileName, uint64(1)).Return(metadataModels.DeviceProfileRevision{Revision: 1, Profile: from}, nil)
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", testProfileName, uint64(2)).Return(metadataModels.DeviceProfileRevision{Revision: 2, Profile: to}, nil)
+	dic := newRevisionTestDIC(dbClientMock, 10)
+
+	diff, err := DiffDeviceProfileRevisions(testProfileName, 1, 2, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), diff.FromRevision)
+	assert.Equal(t, uint64(2), diff.ToRevision)
+	assert.Equal(t, []metadataDTOs.FieldChange{
+		{Path: "description", Type: constants.FieldChangeAdded, NewValue: "thermostat of floor 1"},
+	}, diff.Changes)
+}
+
+func newRevisionTestDIC(dbClient *mocks.DBClient, maxRevisions uint32) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					ProfileChange: config.ProfileChange{MaxRevisions: maxRevisions},
+				},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+}
@@ -9,12 +9,14 @@ package application
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -61,6 +63,7 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	before := dtos.FromDeviceProfileModelToDTO(profile)
 
 	err = deviceResourceUoMValidation(resource, dic)
 	if err != nil {
@@ -81,10 +84,11 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 		return errors.NewCommonEdgeXWrapper(validateErr)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	err = dbClient.UpdateDeviceProfile(profile, 0, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, profile.Name, before, profileDTO, dic)
 
 	lc.Debugf("DeviceProfile deviceResources added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
@@ -100,6 +104,11 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, profile.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	before := dtos.FromDeviceProfileModelToDTO(profile)
 
 	// Find matched deviceResource
 	index := -1
@@ -114,14 +123,15 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 	}
 
 	requests.ReplaceDeviceResourceModelFieldsWithDTO(&profile.DeviceResources[index], dto)
+	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	err = dbClient.UpdateDeviceProfile(profile, ifRevision, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, profile.Name, before, profileDTO, dic)
 
 	lc.Debugf("DeviceProfile deviceResources patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
-	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -141,18 +151,15 @@ func DeleteDeviceResourceByName(profileName string, resourceName string, ctx con
 	}
 
 	dbClient := container.DBClientFrom(dic.Get)
-	// Check the associated Device existence
-	devices, err := dbClient.DevicesByProfileName(0, 1, profileName)
+	profile, err := dbClient.DeviceProfileByName(profileName)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
-	} else if len(devices) > 0 {
-		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to update the device profile when associated device exists", nil)
 	}
-
-	profile, err := dbClient.DeviceProfileByName(profileName)
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, profile.Name, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	before := dtos.FromDeviceProfileModelToDTO(profile)
 
 	index := -1
 	for i := range profile.DeviceResources {
@@ -165,17 +172,24 @@ func DeleteDeviceResourceByName(profileName string, resourceName string, ctx con
 		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device resource not found", nil)
 	}
 
-	profile.DeviceResources = append(profile.DeviceResources[:index], profile.DeviceResources[index+1:]...)
+	stored := profile
+	profile.DeviceResources = slices.Delete(slices.Clone(profile.DeviceResources), index, index+1)
+	// the resource removal breaks the devices using the profile, so it is rejected if the profile is in use
+	err = checkDeviceProfileChangeImpact(stored, profile, true, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 	e := (&profileDTO).Validate()
 	if e != nil {
 		return errors.NewCommonEdgeXWrapper(e)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	err = dbClient.UpdateDeviceProfile(profile, ifRevision, maxDeviceProfileRevisions(dic))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceProfileSystemEventType, profile.Name, before, profileDTO, dic)
 
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 	return nil
@@ -39,6 +39,7 @@ func AddDeviceService(d models.DeviceService, ctx context.Context, dic *di.Conta
 		addedDeviceService.Id,
 		correlationId,
 	)
+	recordAudit(ctx, common.SystemEventActionAdd, common.DeviceServiceSystemEventType, addedDeviceService.Name, nil, dtos.FromDeviceServiceModelToDTO(addedDeviceService), dic)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(d)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, d.Name, DeviceServiceDTO, ctx, dic)
 	return addedDeviceService.Id, nil
@@ -61,25 +62,35 @@ func DeviceServiceByName(name string, ctx context.Context, dic *di.Container) (d
 // PatchDeviceService executes the PATCH operation with the device service DTO to replace the old data
 func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
-	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
 	deviceService, err := deviceServiceByDTO(dbClient, dto)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceServiceSystemEventType, deviceService.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
+	stored := deviceService
 	requests.ReplaceDeviceServiceModelFieldsWithDTO(&deviceService, dto)
+	return updateDeviceService(stored, deviceService, ifRevision, ctx, dic)
+}
 
-	err = dbClient.UpdateDeviceService(deviceService)
+// updateDeviceService replaces the stored device service with the given one if the revision of the stored one is
+// ifRevision, or unconditionally if ifRevision is 0, and then records the change and publishes the system event
+func updateDeviceService(stored models.DeviceService, deviceService models.DeviceService, ifRevision uint64, ctx context.Context, dic *di.Container) errors.EdgeX {
+	err := container.DBClientFrom(dic.Get).UpdateDeviceService(deviceService, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	lc.Debugf(
-		"DeviceService patched on DB successfully. Correlation-ID: %s ",
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf(
+		"DeviceService updated on DB successfully. Correlation-ID: %s ",
 		correlation.FromContext(ctx),
 	)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
+	recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceServiceSystemEventType, deviceService.Name, dtos.FromDeviceServiceModelToDTO(stored), DeviceServiceDTO, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionUpdate, deviceService.Name, DeviceServiceDTO, ctx, dic)
 	return nil
 }
@@ -113,6 +124,10 @@ func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Contain
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceServiceSystemEventType, deviceService.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	// Check the associated Device and ProvisionWatcher existence
 	devices, edgeXErr := dbClient.DevicesByServiceName(0, 1, name)
 	if edgeXErr != nil {
@@ -129,11 +144,12 @@ func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the device service when associated provisionWatcher exists", nil)
 	}
 
-	err = dbClient.DeleteDeviceServiceByName(name)
+	err = dbClient.DeleteDeviceServiceByName(name, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
+	recordAudit(ctx, common.SystemEventActionDelete, common.DeviceServiceSystemEventType, deviceService.Name, DeviceServiceDTO, nil, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, deviceService.Name, DeviceServiceDTO, ctx, dic)
 	return nil
 }
@@ -0,0 +1,367 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// deviceTemplateNameOf returns the name of the device template linked by the device properties, if any
+func deviceTemplateNameOf(d models.Device) string {
+	templateName, _ := d.Properties[constants.DeviceTemplateNameProperty].(string)
+	return templateName
+}
+
+// explicitDeviceFields indicates the device fields with the template defaults which are set explicitly by a patch, so
+// that they are kept as they are by resolveDeviceTemplate even if they are cleared. The properties are not included as
+// they carry the template link, and only the properties missing from the device are filled by the template.
+type explicitDeviceFields struct {
+	profileName bool
+	labels      bool
+	autoEvents  bool
+	protocols   bool
+}
+
+// explicitDeviceFieldsOf returns the device fields with the template defaults which are set by the patch
+func explicitDeviceFieldsOf(dto dtos.UpdateDevice) explicitDeviceFields {
+	return explicitDeviceFields{
+		profileName: dto.ProfileName != nil,
+		labels:      dto.Labels != nil,
+		autoEvents:  dto.AutoEvents != nil,
+		protocols:   dto.Protocols != nil,
+	}
+}
+
+// resolvePatchedDeviceTemplate fills the fields of the patched device with the defaults of its device template when
+// the patch links the device to another template, while the fields set by the patch are kept even if they are cleared.
+// The device linked to the same template is left as it is, as its fields have been resolved when it was linked.
+func resolvePatchedDeviceTemplate(d *models.Device, oldDevice models.Device, dto dtos.UpdateDevice, templateByName func(name string) (metadataModels.DeviceTemplate, errors.EdgeX)) errors.EdgeX {
+	if deviceTemplateNameOf(*d) == deviceTemplateNameOf(oldDevice) {
+		return nil
+	}
+	return resolveDeviceTemplate(d, explicitDeviceFieldsOf(dto), templateByName)
+}
+
+// resolveDeviceTemplate fills the fields of the device left empty with the defaults of its device template except the
+// explicit fields, the device is left as it is if it isn't linked to any template
+func resolveDeviceTemplate(d *models.Device, explicit explicitDeviceFields, templateByName func(name string) (metadataModels.DeviceTemplate, errors.EdgeX)) errors.EdgeX {
+	templateName := deviceTemplateNameOf(*d)
+	if templateName == "" {
+		return nil
+	}
+	template, err := templateByName(templateName)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device template '%s' does not exist", templateName), nil)
+	} else if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query device template '%s'", templateName), err)
+	}
+
+	if d.ProfileName == "" && !explicit.profileName {
+		d.ProfileName = template.ProfileName
+	}
+	if len(d.Labels) == 0 && !explicit.labels {
+		d.Labels = template.Labels
+	}
+	if len(d.AutoEvents) == 0 && !explicit.autoEvents {
+		d.AutoEvents = template.AutoEvents
+	}
+	if !explicit.protocols {
+		d.Protocols = mergeTemplateProtocols(d.Protocols, template.Protocols)
+	}
+	properties := maps.Clone(d.Properties)
+	if properties == nil {
+		properties = make(map[string]any)
+	}
+	for key, value := range template.Properties {
+		if _, ok := properties[key]; !ok {
+			properties[key] = value
+		}
+	}
+	d.Properties = properties
+	return nil
+}
+
+// mergeTemplateProtocols returns the copy of the device protocols with the protocol properties missing from the device
+// filled by the template protocols
+func mergeTemplateProtocols(deviceProtocols, templateProtocols map[string]models.ProtocolProperties) map[string]models.ProtocolProperties {
+	protocols := make(map[string]models.ProtocolProperties, len(deviceProtocols))
+	for name, properties := range deviceProtocols {
+		protocols[name] = maps.Clone(properties)
+	}
+	for name, templateProperties := range templateProtocols {
+		if protocols[name] == nil {
+			protocols[name] = make(models.ProtocolProperties, len(templateProperties))
+		}
+		for key, value := range templateProperties {
+			if _, ok := protocols[name][key]; !ok {
+				protocols[name][key] = value
+			}
+		}
+	}
+	return protocols
+}
+
+// inheritDeviceTemplateChange applies the change from the old to the new device template to the device, and returns
+// whether the device is changed. A field of the device is regarded as inherited and replaced if it is unset or still
+// equal to the old template, while the fields overridden by the device are kept.
+func inheritDeviceTemplateChange(d *models.Device, oldTemplate, newTemplate metadataModels.DeviceTemplate) bool {
+	changed := false
+	if oldTemplate.ProfileName != newTemplate.ProfileName && d.ProfileName == oldTemplate.ProfileName {
+		d.ProfileName = newTemplate.ProfileName
+		changed = true
+	}
+	if !reflect.DeepEqual(oldTemplate.Labels, newTemplate.Labels) && (len(d.Labels) == 0 || reflect.DeepEqual(d.Labels, oldTemplate.Labels)) {
+		d.Labels = newTemplate.Labels
+		changed = true
+	}
+	if !reflect.DeepEqual(oldTemplate.AutoEvents, newTemplate.AutoEvents) && (len(d.AutoEvents) == 0 || reflect.DeepEqual(d.AutoEvents, oldTemplate.AutoEvents)) {
+		d.AutoEvents = newTemplate.AutoEvents
+		changed = true
+	}
+
+	protocols := make(map[string]models.ProtocolProperties, len(d.Protocols))
+	for name, properties := range d.Protocols {
+		protocols[name] = maps.Clone(properties)
+	}
+	protocolChanged := false
+	for name := range mergeKeys(oldTemplate.Protocols, newTemplate.Protocols) {
+		properties := protocols[name]
+		if properties == nil {
+			properties = make(models.ProtocolProperties)
+		}
+		if !inheritMapChange(properties, oldTemplate.Protocols[name], newTemplate.Protocols[name]) {
+			continue
+		}
+		protocolChanged = true
+		if len(properties) > 0 {
+			protocols[name] = properties
+		} else {
+			delete(protocols, name)
+		}
+	}
+	if protocolChanged {
+		d.Protocols = protocols
+		changed = true
+	}
+
+	oldProperties := maps.Clone(oldTemplate.Properties)
+	newProperties := maps.Clone(newTemplate.Properties)
+	delete(oldProperties, constants.DeviceTemplateNameProperty)
+	delete(newProperties, constants.DeviceTemplateNameProperty)
+	properties := maps.Clone(d.Properties)
+	if properties == nil {
+		properties = make(map[string]any)
+	}
+	if inheritMapChange(properties, oldProperties, newProperties) {
+		d.Properties = properties
+		changed = true
+	}
+	return changed
+}
+
+// inheritMapChange applies the change of the keys from the old to the new template map to the device map, where the
+// keys absent or still equal to the old template are inherited, and returns whether the device map is changed
+func inheritMapChange[V any](m, oldTemplate, newTemplate map[string]V) bool {
+	changed := false
+	for key := range mergeKeys(oldTemplate, newTemplate) {
+		oldValue, oldOk := oldTemplate[key]
+		newValue, newOk := newTemplate[key]
+		if oldOk == newOk && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		if value, ok := m[key]; ok && !(oldOk && reflect.DeepEqual(value, oldValue)) {
+			continue
+		}
+		if newOk {
+			m[key] = newValue
+		} else {
+			delete(m, key)
+		}
+		changed = true
+	}
+	return changed
+}
+
+// mergeKeys returns the union of the keys of the maps
+func mergeKeys[V any](ms ...map[string]V) map[string]bool {
+	keys := make(map[string]bool)
+	for _, m := range ms {
+		for key := range m {
+			keys[key] = true
+		}
+	}
+	return keys
+}
+
+// AddDeviceTemplate adds a new device template
+func AddDeviceTemplate(t metadataModels.DeviceTemplate, ctx context.Context, dic *di.Container) (string, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if t.ProfileName != "" {
+		exists, err := dbClient.DeviceProfileNameExists(t.ProfileName)
+		if err != nil {
+			return "", errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("device profile '%s' existence check failed", t.ProfileName), err)
+		} else if !exists {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile '%s' does not exists", t.ProfileName), nil)
+		}
+	}
+
+	addedTemplate, err := dbClient.AddDeviceTemplate(t)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceTemplate created on DB successfully. DeviceTemplate ID: %s, Correlation-ID: %s ", addedTemplate.Id, correlation.FromContext(ctx))
+	recordAudit(ctx, common.SystemEventActionAdd, constants.DeviceTemplateEntityType, addedTemplate.Name, nil, metadataDTOs.FromDeviceTemplateModelToDTO(addedTemplate), dic)
+	return addedTemplate.Id, nil
+}
+
+// UpdateDeviceTemplate replaces the device template and applies the change to the linked devices, while the fields
+// overridden by the devices are kept. The template and the changed devices are updated in a single write if all the
+// changed devices are still valid and accepted by the device validation of their device services, and the device
+// update events are published to the device services of the changed devices.
+func UpdateDeviceTemplate(t metadataModels.DeviceTemplate, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	oldTemplate, err := dbClient.DeviceTemplateByName(t.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	t.Id = oldTemplate.Id
+	t.Created = oldTemplate.Created
+
+	linkedDevices, err := dbClient.DevicesByTemplateName(t.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lookup := newBulkDeviceLookup(dbClient)
+	var oldDevices, devices []models.Device
+	for _, oldDevice := range linkedDevices {
+		device := oldDevice
+		if !inheritDeviceTemplateChange(&device, oldTemplate, t) {
+			continue
+		}
+		if err = validateDeviceWithProfile(device, lookup.deviceProfileByName); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("the device template change is not applicable to device '%s'", device.Name), err)
+		}
+		oldDevices = append(oldDevices, oldDevice)
+		devices = append(devices, device)
+	}
+
+	if len(devices) > 0 && container.ConfigurationFrom(dic.Get).Writable.MaxResources > 0 {
+		if err = checkResourceCapacityByUpdatedDevices(oldDevices, devices, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	errs := make([]errors.EdgeX, len(devices))
+	validateDevicesCallback(devices, errs, dic)
+	for i, validateErr := range errs {
+		if validateErr != nil {
+			return errors.NewCommonEdgeX(errors.Kind(validateErr), fmt.Sprintf("the device template change is rejected by the device service of device '%s'", devices[i].Name), validateErr)
+		}
+	}
+
+	if err = dbClient.UpdateDeviceTemplate(t, devices); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	recordAudit(ctx, common.SystemEventActionUpdate, constants.DeviceTemplateEntityType, t.Name,
+		metadataDTOs.FromDeviceTemplateModelToDTO(oldTemplate), metadataDTOs.FromDeviceTemplateModelToDTO(t), dic)
+	if len(devices) == 0 {
+		lc.Debugf("DeviceTemplate updated on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+		return nil
+	}
+	lc.Debugf("DeviceTemplate updated on DB successfully along with %d linked devices. Correlation-ID: %s ", len(devices), correlation.FromContext(ctx))
+
+	var events []deviceSystemEvent
+	for i, d := range devices {
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		recordAudit(ctx, common.SystemEventActionUpdate, common.DeviceSystemEventType, d.Name, dtos.FromDeviceModelToDTO(oldDevices[i]), deviceDTO, dic)
+		events = append(events, deviceSystemEvent{owner: d.ServiceName, device: deviceDTO})
+	}
+	go publishDeviceSystemEvents(common.SystemEventActionUpdate, events, ctx, dic)
+	return nil
+}
+
+// DeviceTemplateByName queries the device template by name
+func DeviceTemplateByName(name string, dic *di.Container) (metadataDTOs.DeviceTemplate, errors.EdgeX) {
+	if name == "" {
+		return metadataDTOs.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	template, err := dbClient.DeviceTemplateByName(name)
+	if err != nil {
+		return metadataDTOs.DeviceTemplate{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromDeviceTemplateModelToDTO(template), nil
+}
+
+// AllDeviceTemplates queries the device templates with offset and limit
+func AllDeviceTemplates(offset int, limit int, dic *di.Container) (templates []metadataDTOs.DeviceTemplate, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.DeviceTemplateCount()
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []metadataDTOs.DeviceTemplate{}, totalCount, err
+	}
+
+	templateModels, err := dbClient.AllDeviceTemplates(offset, limit)
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	templates = make([]metadataDTOs.DeviceTemplate, len(templateModels))
+	for i, t := range templateModels {
+		templates[i] = metadataDTOs.FromDeviceTemplateModelToDTO(t)
+	}
+	return templates, totalCount, nil
+}
+
+// DeleteDeviceTemplateByName deletes the device template by name, which is rejected if any device is still linked to it
+func DeleteDeviceTemplateByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	template, err := dbClient.DeviceTemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	count, err := dbClient.DeviceCountByTemplateName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if count > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the device template when linked device exists", nil)
+	}
+
+	if err = dbClient.DeleteDeviceTemplateByName(name); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	recordAudit(ctx, common.SystemEventActionDelete, constants.DeviceTemplateEntityType, name, metadataDTOs.FromDeviceTemplateModelToDTO(template), nil, dic)
+	return nil
+}
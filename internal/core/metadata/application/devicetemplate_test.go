@@ -0,0 +1,215 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testDeviceTemplate() metadataModels.DeviceTemplate {
+	return metadataModels.DeviceTemplate{
+		Name:        "test-template",
+		ProfileName: "test-profile",
+		Labels:      []string{"hvac"},
+		AutoEvents:  []models.AutoEvent{{SourceName: "Temperature", Interval: "10s"}},
+		Protocols:   map[string]models.ProtocolProperties{"modbus-tcp": {"Port": "502", "UnitID": "1"}},
+		Properties:  map[string]any{"vendor": "acme"},
+	}
+}
+
+// newDeviceTemplateTestDIC returns the test DIC with a messaging client answering the device validation requests,
+// which fail if validationFailed is true
+func newDeviceTemplateTestDIC(dbClient *mocks.DBClient, validationFailed bool) *di.Container {
+	dic := newBulkDeviceTestDIC(dbClient, 0)
+	container.ConfigurationFrom(dic.Get).Service.RequestTimeout = "30s"
+
+	mockMessaging := &messagingMocks.MessageClient{}
+	mockMessaging.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(req types.MessageEnvelope, _ string, _ string, _ time.Duration) (*types.MessageEnvelope, error) {
+			if validationFailed {
+				res := types.NewMessageEnvelopeWithError(req.RequestID, "validation failed")
+				return &res, nil
+			}
+			res, err := types.NewMessageEnvelopeForResponse(nil, req.RequestID, req.CorrelationID, common.ContentTypeJSON)
+			return &res, err
+		})
+	mockMessaging.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return mockMessaging
+		},
+	})
+	return dic
+}
+
+func TestResolveDeviceTemplate(t *testing.T) {
+	template := testDeviceTemplate()
+	templateByName := func(name string) (metadataModels.DeviceTemplate, errors.EdgeX) {
+		if name == template.Name {
+			return template, nil
+		}
+		return metadataModels.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device template doesn't exist in the database", nil)
+	}
+
+	device := models.Device{
+		Name:       "device1",
+		Labels:     []string{"floor1"},
+		Protocols:  map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1", "UnitID": "2"}},
+		Properties: map[string]any{constants.DeviceTemplateNameProperty: template.Name},
+	}
+	require.NoError(t, resolveDeviceTemplate(&device, explicitDeviceFields{}, templateByName))
+	assert.Equal(t, template.ProfileName, device.ProfileName)
+	assert.Equal(t, []string{"floor1"}, device.Labels, "the labels overridden by the device should be kept")
+	assert.Equal(t, template.AutoEvents, device.AutoEvents)
+	assert.Equal(t, models.ProtocolProperties{"Address": "10.0.0.1", "Port": "502", "UnitID": "2"}, device.Protocols["modbus-tcp"])
+	assert.Equal(t, map[string]any{constants.DeviceTemplateNameProperty: template.Name, "vendor": "acme"}, device.Properties)
+	assert.Equal(t, models.ProtocolProperties{"Port": "502", "UnitID": "1"}, template.Protocols["modbus-tcp"], "the template should not be changed")
+
+	noTemplateDevice := models.Device{Name: "device2", Properties: map[string]any{}}
+	require.NoError(t, resolveDeviceTemplate(&noTemplateDevice, explicitDeviceFields{}, templateByName))
+	assert.Empty(t, noTemplateDevice.ProfileName)
+
+	notFoundDevice := models.Device{Name: "device3", Properties: map[string]any{constants.DeviceTemplateNameProperty: "notFound"}}
+	err := resolveDeviceTemplate(&notFoundDevice, explicitDeviceFields{}, templateByName)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestUpdateDeviceTemplate(t *testing.T) {
+	oldTemplate := testDeviceTemplate()
+	newTemplate := testDeviceTemplate()
+	newTemplate.Labels = []string{"hvac", "floor1"}
+	newTemplate.Protocols = map[string]models.ProtocolProperties{"modbus-tcp": {"Port": "1502", "UnitID": "1"}}
+	newTemplate.Properties = map[string]any{"vendor": "acme", "model": "x1"}
+
+	inherited := models.Device{
+		Name:        "inherited",
+		ServiceName: "test-service",
+		ProfileName: oldTemplate.ProfileName,
+		Labels:      oldTemplate.Labels,
+		AutoEvents:  oldTemplate.AutoEvents,
+		Protocols:   map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1", "Port": "502", "UnitID": "1"}},
+		Properties:  map[string]any{constants.DeviceTemplateNameProperty: oldTemplate.Name, "vendor": "acme"},
+	}
+	overridden := inherited
+	overridden.Name = "overridden"
+	overridden.Labels = []string{"floor2"}
+	overridden.Protocols = map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.2", "Port": "5020", "UnitID": "1"}}
+	overridden.Properties = map[string]any{constants.DeviceTemplateNameProperty: oldTemplate.Name, "vendor": "acme", "model": "y2"}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceTemplateByName", oldTemplate.Name).Return(oldTemplate, nil)
+	dbClientMock.On("DevicesByTemplateName", oldTemplate.Name).Return([]models.Device{inherited, overridden}, nil)
+	dbClientMock.On("DeviceProfileByName", oldTemplate.ProfileName).Return(models.DeviceProfile{Name: oldTemplate.ProfileName, DeviceResources: []models.DeviceResource{{Name: "Temperature"}}}, nil)
+	var updated []models.Device
+	dbClientMock.On("UpdateDeviceTemplate", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(1).([]models.Device)
+	}).Return(nil)
+	dic := newDeviceTemplateTestDIC(dbClientMock, false)
+
+	err := UpdateDeviceTemplate(newTemplate, context.Background(), dic)
+	require.NoError(t, err)
+
+	require.Len(t, updated, 1, "the device overriding all the changed fields should not be updated")
+	assert.Equal(t, inherited.Name, updated[0].Name)
+	assert.Equal(t, newTemplate.Labels, updated[0].Labels)
+	assert.Equal(t, models.ProtocolProperties{"Address": "10.0.0.1", "Port": "1502", "UnitID": "1"}, updated[0].Protocols["modbus-tcp"])
+	assert.Equal(t, "x1", updated[0].Properties["model"])
+	assert.Equal(t, "502", inherited.Protocols["modbus-tcp"]["Port"], "the devices returned by the DB should not be changed in place")
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceTemplate", 1)
+}
+
+func TestUpdateDeviceTemplateInvalid(t *testing.T) {
+	oldTemplate := testDeviceTemplate()
+	newTemplate := testDeviceTemplate()
+	newTemplate.ProfileName = "notFoundProfile"
+	device := models.Device{
+		Name:        "device1",
+		ServiceName: "test-service",
+		ProfileName: oldTemplate.ProfileName,
+		Properties:  map[string]any{constants.DeviceTemplateNameProperty: oldTemplate.Name},
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceTemplateByName", oldTemplate.Name).Return(oldTemplate, nil)
+	dbClientMock.On("DevicesByTemplateName", oldTemplate.Name).Return([]models.Device{device}, nil)
+	dbClientMock.On("DeviceProfileByName", newTemplate.ProfileName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic := newBulkDeviceTestDIC(dbClientMock, 0)
+
+	err := UpdateDeviceTemplate(newTemplate, context.Background(), dic)
+	require.Error(t, err)
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceTemplate", mock.Anything, mock.Anything)
+}
+
+func TestUpdateDeviceTemplateValidationFailed(t *testing.T) {
+	oldTemplate := testDeviceTemplate()
+	newTemplate := testDeviceTemplate()
+	newTemplate.Protocols = map[string]models.ProtocolProperties{"modbus-tcp": {"Port": "1502", "UnitID": "1"}}
+	device := models.Device{
+		Name:        "device1",
+		ServiceName: "test-service",
+		ProfileName: oldTemplate.ProfileName,
+		Protocols:   map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1", "Port": "502", "UnitID": "1"}},
+		Properties:  map[string]any{constants.DeviceTemplateNameProperty: oldTemplate.Name},
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceTemplateByName", oldTemplate.Name).Return(oldTemplate, nil)
+	dbClientMock.On("DevicesByTemplateName", oldTemplate.Name).Return([]models.Device{device}, nil)
+	dbClientMock.On("DeviceProfileByName", oldTemplate.ProfileName).Return(models.DeviceProfile{Name: oldTemplate.ProfileName}, nil)
+	dic := newDeviceTemplateTestDIC(dbClientMock, true)
+
+	err := UpdateDeviceTemplate(newTemplate, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServerError, errors.Kind(err))
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceTemplate", mock.Anything, mock.Anything)
+}
+
+func TestResolvePatchedDeviceTemplate(t *testing.T) {
+	template := testDeviceTemplate()
+	templateByName := func(name string) (metadataModels.DeviceTemplate, errors.EdgeX) {
+		return template, nil
+	}
+	oldDevice := models.Device{Name: "device1", ProfileName: "old-profile", Properties: map[string]any{}}
+
+	// the labels cleared by the patch are kept while the auto events absent from the patch are filled by the template
+	emptyLabels := []string{}
+	dto := dtos.UpdateDevice{Labels: emptyLabels, Properties: map[string]any{constants.DeviceTemplateNameProperty: template.Name}}
+	device := oldDevice
+	device.Labels = emptyLabels
+	device.Properties = dto.Properties
+	require.NoError(t, resolvePatchedDeviceTemplate(&device, oldDevice, dto, templateByName))
+	assert.Empty(t, device.Labels)
+	assert.Equal(t, template.AutoEvents, device.AutoEvents)
+	assert.Equal(t, "old-profile", device.ProfileName)
+
+	// the device linked to the same template is not resolved again
+	linkedDevice := models.Device{Name: "device2", Properties: map[string]any{constants.DeviceTemplateNameProperty: template.Name}}
+	patched := linkedDevice
+	require.NoError(t, resolvePatchedDeviceTemplate(&patched, linkedDevice, dtos.UpdateDevice{}, templateByName))
+	assert.Empty(t, patched.AutoEvents)
+	assert.Empty(t, patched.ProfileName)
+}
@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// checkDeviceParent checks that the device can be placed under the parent without forming a cycle in the device tree,
+// i.e. the parent is neither the device itself nor one of its descendants. The parent that doesn't exist is not checked.
+func checkDeviceParent(dbClient interfaces.DBClient, name string, parent string) errors.EdgeX {
+	if parent == "" {
+		return nil
+	}
+	if parent == name {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "a device cannot be its own parent", nil)
+	}
+	ancestors, err := dbClient.DeviceAncestors(parent)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return nil
+	} else if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if slices.ContainsFunc(ancestors, func(d models.Device) bool { return d.Name == name }) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("cannot move device '%s' under its descendant '%s'", name, parent), nil)
+	}
+	return nil
+}
+
+// deviceWithDescendants returns the device followed by all of its descendants in the device tree
+func deviceWithDescendants(dbClient interfaces.DBClient, device models.Device) ([]models.Device, errors.EdgeX) {
+	_, descendants, err := dbClient.DeviceTree(device.Name, 0, 0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return append([]models.Device{device}, descendants...), nil
+}
+
+// DeviceAncestors returns the ancestors of the device, starting from its parent up to the root of the device tree
+func DeviceAncestors(name string, dic *di.Container) ([]dtos.Device, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	ancestors, err := dbClient.DeviceAncestors(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices := make([]dtos.Device, len(ancestors))
+	for i, d := range ancestors {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, nil
+}
+
+// DeleteDeviceSubtreeByName deletes the device along with all of its descendants in a single write, and returns the
+// deleted devices. Nothing is deleted if dryRun is true, the devices that would be deleted are returned instead. The
+// If-Match header value carried by the context is only checked against the revision of the device at the root of the
+// subtree, the descendants are deleted whatever their revisions are.
+func DeleteDeviceSubtreeByName(name string, dryRun bool, ctx context.Context, dic *di.Container) ([]dtos.Device, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	device, err := dbClient.DeviceByName(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceSystemEventType, device.Name, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	subtree, err := deviceWithDescendants(dbClient, device)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	// the deepest devices are deleted first, so that no device is left without its parent halfway
+	slices.Reverse(subtree)
+
+	devices := make([]dtos.Device, len(subtree))
+	names := make([]string, len(subtree))
+	for i, d := range subtree {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+		names[i] = d.Name
+	}
+	if dryRun {
+		return devices, nil
+	}
+
+	if err = dbClient.DeleteDevicesByNames(names, map[string]uint64{device.Name: ifRevision}); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("Device %s deleted along with %d descendants on DB successfully. Correlation-ID: %s ",
+		name, len(subtree)-1, correlation.FromContext(ctx))
+
+	events := make([]deviceSystemEvent, len(devices))
+	for i, d := range devices {
+		recordAudit(ctx, common.SystemEventActionDelete, common.DeviceSystemEventType, d.Name, d, nil, dic)
+		events[i] = deviceSystemEvent{owner: d.ServiceName, device: d}
+	}
+	go publishDeviceSystemEvents(common.SystemEventActionDelete, events, ctx, dic)
+
+	return devices, nil
+}
+
+// MoveDevice re-parents the device under the parent along with all of its descendants, and returns the moved devices.
+// The device is moved to the root of the device tree if the parent is empty. The parent must exist and must not be the
+// device itself or one of its descendants.
+func MoveDevice(name string, parent string, ctx context.Context, dic *di.Container) ([]dtos.Device, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	device, err := dbClient.DeviceByName(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	ifRevision, err := ifMatchRevision(ctx, common.DeviceSystemEventType, device.Name, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if parent != "" && parent != name {
+		exists, err := dbClient.DeviceNameExists(parent)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		} else if !exists {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("parent device '%s' does not exist", parent), nil)
+		}
+	}
+	if err = checkDeviceParent(dbClient, name, parent); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if device.Parent != parent {
+		oldDevice := device
+		device.Parent = parent
+		if err = updateDeviceInDB(oldDevice, device, "", ifRevision, ctx, dic); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	subtree, err := deviceWithDescendants(dbClient, device)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices := make([]dtos.Device, len(subtree))
+	for i, d := range subtree {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, nil
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteDeviceSubtreeByName(t *testing.T) {
+	root := models.Device{Name: "root", ServiceName: "test-service"}
+	child := models.Device{Name: "child", ServiceName: "test-service", Parent: root.Name}
+	grandchild := models.Device{Name: "grandchild", ServiceName: "test-service", Parent: child.Name}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceByName", root.Name).Return(root, nil)
+	dbClientMock.On("DeviceTree", root.Name, 0, 0, -1, []string(nil)).Return(uint32(2), []models.Device{child, grandchild}, nil)
+	dbClientMock.On("DeleteDevicesByNames", []string{grandchild.Name, child.Name, root.Name}, map[string]uint64{root.Name: 0}).Return(nil)
+	dbClientMock.On("DeleteDevicesByNames", []string{grandchild.Name, child.Name, root.Name}, map[string]uint64{root.Name: 2}).Return(nil)
+	dbClientMock.On("EntityRevision", common.DeviceSystemEventType, root.Name).Return(uint64(2), nil)
+	dic := newBulkDeviceTestDIC(dbClientMock, 0)
+
+	devices, err := DeleteDeviceSubtreeByName(root.Name, true, context.Background(), dic)
+	require.NoError(t, err)
+	require.Len(t, devices, 3)
+	assert.Equal(t, []string{grandchild.Name, child.Name, root.Name}, []string{devices[0].Name, devices[1].Name, devices[2].Name})
+	dbClientMock.AssertNotCalled(t, "DeleteDevicesByNames", mock.Anything, mock.Anything)
+
+	devices, err = DeleteDeviceSubtreeByName(root.Name, false, context.Background(), dic)
+	require.NoError(t, err)
+	require.Len(t, devices, 3)
+	dbClientMock.AssertNumberOfCalls(t, "DeleteDevicesByNames", 1)
+
+	// the revision matching the If-Match header is passed to the delete, so that it's applied only at the revision
+	_, err = DeleteDeviceSubtreeByName(root.Name, false, utils.WithIfMatch(context.Background(), `"2"`), dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "DeleteDevicesByNames", []string{grandchild.Name, child.Name, root.Name}, map[string]uint64{root.Name: 2})
+	_, err = DeleteDeviceSubtreeByName(root.Name, false, utils.WithIfMatch(context.Background(), `"1"`), dic)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, utils.ErrorCode(err))
+	dbClientMock.AssertNumberOfCalls(t, "DeleteDevicesByNames", 2)
+}
+
+func TestMoveDevice(t *testing.T) {
+	root := models.Device{Name: "root", ServiceName: "test-service"}
+	child := models.Device{Name: "child", ServiceName: "test-service", Parent: root.Name}
+	grandchild := models.Device{Name: "grandchild", ServiceName: "test-service", Parent: child.Name}
+	other := models.Device{Name: "other", ServiceName: "test-service"}
+	notFoundName := "notFound"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceByName", child.Name).Return(child, nil)
+	dbClientMock.On("DeviceNameExists", other.Name).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", grandchild.Name).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", notFoundName).Return(false, nil)
+	dbClientMock.On("DeviceAncestors", other.Name).Return([]models.Device{}, nil)
+	dbClientMock.On("DeviceAncestors", grandchild.Name).Return([]models.Device{child, root}, nil)
+	dbClientMock.On("DeviceTree", child.Name, 0, 0, -1, []string(nil)).Return(uint32(1), []models.Device{grandchild}, nil)
+	moved := child
+	moved.Parent = other.Name
+	dbClientMock.On("UpdateDevice", moved, mock.Anything).Return(nil)
+	dic := newBulkDeviceTestDIC(dbClientMock, 0)
+
+	tests := []struct {
+		name              string
+		parent            string
+		errorExpected     bool
+		expectedErrorKind errors.ErrKind
+	}{
+		{"Valid - move under another device", other.Name, false, ""},
+		{"Invalid - move under itself", child.Name, true, errors.KindContractInvalid},
+		{"Invalid - move under its descendant", grandchild.Name, true, errors.KindContractInvalid},
+		{"Invalid - parent not found", notFoundName, true, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			devices, err := MoveDevice(child.Name, testCase.parent, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, devices, 2)
+			assert.Equal(t, other.Name, devices[0].Parent)
+			assert.Equal(t, grandchild.Name, devices[1].Name)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", 1)
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// EntityRevision returns the revision of the device, device profile, device service or provision watcher by name,
+// which is increased whenever the entity is updated
+func EntityRevision(entityType string, name string, dic *di.Container) (uint64, errors.EdgeX) {
+	if name == "" {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	revision, err := dbClient.EntityRevision(entityType, name)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return revision, nil
+}
+
+// ifMatchRevision returns the revision of the entity matching the If-Match header value carried by the context, which
+// should be passed to the write of the entity so that the write is only applied if the entity is still at the revision.
+// A precondition failed error is returned if the entity has been changed since the client read it, and revision 0 of
+// the unconditional write is returned if the context carries no If-Match header value.
+func ifMatchRevision(ctx context.Context, entityType string, name string, dic *di.Container) (uint64, errors.EdgeX) {
+	ifMatch := utils.IfMatchFromContext(ctx)
+	if ifMatch == "" {
+		return 0, nil
+	}
+	revision, err := EntityRevision(entityType, name, dic)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	if !utils.ETagMatches(ifMatch, revision) {
+		return 0, utils.NewPreconditionFailedError(entityType, name, ifMatch)
+	}
+	return revision, nil
+}
+
+// rejectIfMatch returns a contract invalid error if the context carries the If-Match header value, since a bulk write of
+// many entities can't be conditioned on an entity tag which only identifies the revision of a single entity
+func rejectIfMatch(ctx context.Context) errors.EdgeX {
+	if utils.IfMatchFromContext(ctx) != "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the If-Match header is not supported by the bulk write of multiple entities", nil)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIfMatchRevision(t *testing.T) {
+	notFoundName := "notFoundName"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("EntityRevision", common.DeviceProfileSystemEventType, testProfileName).Return(uint64(3), nil)
+	dbClientMock.On("EntityRevision", common.DeviceProfileSystemEventType, notFoundName).Return(uint64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic := newRevisionTestDIC(dbClientMock, 0)
+
+	tests := []struct {
+		name              string
+		profileName       string
+		ifMatch           string
+		expectedRevision  uint64
+		errorExpected     bool
+		expectedErrorCode int
+	}{
+		{"Valid - no If-Match", testProfileName, "", 0, false, 0},
+		{"Valid - current revision", testProfileName, `"3"`, 3, false, 0},
+		{"Valid - any revision", testProfileName, "*", 3, false, 0},
+		{"Invalid - stale revision", testProfileName, `"2"`, 0, true, http.StatusPreconditionFailed},
+		{"Invalid - profile not found", notFoundName, `"3"`, 0, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := utils.WithIfMatch(context.Background(), testCase.ifMatch)
+			revision, err := ifMatchRevision(ctx, common.DeviceProfileSystemEventType, testCase.profileName, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorCode, utils.ErrorCode(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedRevision, revision)
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "EntityRevision", 4)
+}
@@ -0,0 +1,167 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// ignoredDiffFields are the fields of the entities excluded from the diff, since they are managed by the service rather
+// than changed by the users
+var ignoredDiffFields = []string{"id", "created", "modified"}
+
+// diffJSON compares the JSON representation of the entities except the ignored top-level fields, the elements of the
+// arrays of named objects are matched by name so that the changes are located regardless of their order. The nil entity
+// is absent, so the whole entity is added or removed.
+func diffJSON(from any, to any, ignoredFields []string) ([]metadataDTOs.FieldChange, errors.EdgeX) {
+	fromValue, err := toJSONValue(from)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	toValue, err := toJSONValue(to)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, field := range ignoredFields {
+		if object, ok := fromValue.(map[string]any); ok {
+			delete(object, field)
+		}
+		if object, ok := toValue.(map[string]any); ok {
+			delete(object, field)
+		}
+	}
+
+	changes := []metadataDTOs.FieldChange{}
+	diffJSONMembers("", fromValue, toValue, &changes)
+	return changes, nil
+}
+
+// toJSONValue converts the value to its generic JSON representation, i.e. the maps, slices and primitives produced by
+// unmarshalling its JSON encoding
+func toJSONValue(v any) (any, errors.EdgeX) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal the entity to JSON", err)
+	}
+	var value any
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to unmarshal the entity from JSON", err)
+	}
+	return value, nil
+}
+
+func diffJSONValues(path string, from any, to any, changes *[]metadataDTOs.FieldChange) {
+	switch fromValue := from.(type) {
+	case map[string]any:
+		toValue, ok := to.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(fromValue)+len(toValue))
+		for k := range fromValue {
+			keys = append(keys, k)
+		}
+		for k := range toValue {
+			if _, exists := fromValue[k]; !exists {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			diffJSONMembers(joinFieldPath(path, k), fromValue[k], toValue[k], changes)
+		}
+		return
+	case []any:
+		toValue, ok := to.([]any)
+		if !ok {
+			break
+		}
+		fromNames, fromNamed := namedElements(fromValue)
+		toNames, toNamed := namedElements(toValue)
+		if fromNamed && toNamed {
+			names := slices.Clone(fromNames)
+			for _, name := range toNames {
+				if !slices.Contains(fromNames, name) {
+					names = append(names, name)
+				}
+			}
+			for _, name := range names {
+				diffJSONMembers(fmt.Sprintf("%s[%s]", path, name), elementByName(fromValue, name), elementByName(toValue, name), changes)
+			}
+			return
+		}
+		for i := 0; i < max(len(fromValue), len(toValue)); i++ {
+			var fromElement, toElement any
+			if i < len(fromValue) {
+				fromElement = fromValue[i]
+			}
+			if i < len(toValue) {
+				toElement = toValue[i]
+			}
+			diffJSONMembers(path+"["+strconv.Itoa(i)+"]", fromElement, toElement, changes)
+		}
+		return
+	}
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, metadataDTOs.FieldChange{Path: path, Type: constants.FieldChangeChanged, OldValue: from, NewValue: to})
+	}
+}
+
+// diffJSONMembers compares the members of the objects or arrays, the nil member is absent
+func diffJSONMembers(path string, from any, to any, changes *[]metadataDTOs.FieldChange) {
+	switch {
+	case from == nil && to == nil:
+	case from == nil:
+		*changes = append(*changes, metadataDTOs.FieldChange{Path: path, Type: constants.FieldChangeAdded, NewValue: to})
+	case to == nil:
+		*changes = append(*changes, metadataDTOs.FieldChange{Path: path, Type: constants.FieldChangeRemoved, OldValue: from})
+	default:
+		diffJSONValues(path, from, to, changes)
+	}
+}
+
+func joinFieldPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// namedElements returns the names of the array elements if all the elements are objects with a unique name
+func namedElements(elements []any) ([]string, bool) {
+	names := make([]string, 0, len(elements))
+	for _, element := range elements {
+		object, ok := element.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := object["name"].(string)
+		if !ok || slices.Contains(names, name) {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+func elementByName(elements []any, name string) any {
+	for _, element := range elements {
+		if element.(map[string]any)["name"] == name {
+			return element
+		}
+	}
+	return nil
+}
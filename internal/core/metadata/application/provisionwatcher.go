@@ -49,6 +49,7 @@ func AddProvisionWatcher(pw models.ProvisionWatcher, ctx context.Context, dic *d
 		addProvisionWatcher.Id,
 		correlationId,
 	)
+	recordAudit(ctx, common.SystemEventActionAdd, common.ProvisionWatcherSystemEventType, addProvisionWatcher.Name, nil, dtos.FromProvisionWatcherModelToDTO(addProvisionWatcher), dic)
 	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
 	return addProvisionWatcher.Id, nil
 }
@@ -157,10 +158,15 @@ func DeleteProvisionWatcherByName(ctx context.Context, name string, dic *di.Cont
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	err = dbClient.DeleteProvisionWatcherByName(pw.Name)
+	ifRevision, err := ifMatchRevision(ctx, common.ProvisionWatcherSystemEventType, pw.Name, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	err = dbClient.DeleteProvisionWatcherByName(pw.Name, ifRevision)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	recordAudit(ctx, common.SystemEventActionDelete, common.ProvisionWatcherSystemEventType, pw.Name, dtos.FromProvisionWatcherModelToDTO(pw), nil, dic)
 	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionDelete, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
 	return nil
 }
@@ -168,30 +174,35 @@ func DeleteProvisionWatcherByName(ctx context.Context, name string, dic *di.Cont
 // PatchProvisionWatcher executes the PATCH operation with the provisionWatcher DTO to replace the old data
 func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
-	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
 	pw, err := provisionWatcherByDTO(dbClient, dto)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-
-	// Old service name is used for invoking callback
-	var oldServiceName string
-	if dto.ServiceName != nil && *dto.ServiceName != pw.ServiceName {
-		oldServiceName = pw.ServiceName
+	ifRevision, err := ifMatchRevision(ctx, common.ProvisionWatcherSystemEventType, pw.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	stored := pw
 	requests.ReplaceProvisionWatcherModelFieldsWithDTO(&pw, dto)
+	return updateProvisionWatcher(stored, pw, ifRevision, ctx, dic)
+}
 
-	err = dbClient.UpdateProvisionWatcher(pw)
+// updateProvisionWatcher replaces the stored provision watcher with the given one if the revision of the stored one is
+// ifRevision, or unconditionally if ifRevision is 0, and then records the change and publishes the system events
+func updateProvisionWatcher(stored models.ProvisionWatcher, pw models.ProvisionWatcher, ifRevision uint64, ctx context.Context, dic *di.Container) errors.EdgeX {
+	err := container.DBClientFrom(dic.Get).UpdateProvisionWatcher(pw, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	lc.Debugf("ProvisionWatcher patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("ProvisionWatcher updated on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	recordAudit(ctx, common.SystemEventActionUpdate, common.ProvisionWatcherSystemEventType, pw.Name, dtos.FromProvisionWatcherModelToDTO(stored), dtos.FromProvisionWatcherModelToDTO(pw), dic)
 
-	if oldServiceName != "" {
-		go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, oldServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+	// Old service name is used for invoking callback
+	if stored.ServiceName != pw.ServiceName {
+		go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, stored.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
 	}
 	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
 	return nil
@@ -29,6 +29,7 @@ type ConfigurationStruct struct {
 	Service    bootstrapConfig.ServiceInfo
 	MessageBus bootstrapConfig.MessageBusInfo
 	UoM        UoM
+	AuditLog   AuditLogInfo
 }
 
 type WritableInfo struct {
@@ -44,6 +45,19 @@ type WritableInfo struct {
 type ProfileChange struct {
 	StrictDeviceProfileChanges bool
 	StrictDeviceProfileDeletes bool
+	// StrictBreakingChanges rejects the breaking changes to the device profiles in use, such as removing or retyping a
+	// device resource used by the devices
+	StrictBreakingChanges bool
+	// MaxRevisions is the number of the latest revisions kept in the revision history of each device profile, 0 disables
+	// the revision history
+	MaxRevisions uint32
+}
+
+// AuditLogInfo defines whether the changes made to the metadata are recorded for auditing
+type AuditLogInfo struct {
+	Enabled bool
+	// Retention is how long an audit record is kept since the change is made
+	Retention string
 }
 
 type WritableUoM struct {
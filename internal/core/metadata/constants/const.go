@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX core-metadata service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiBundleRoute       = common.ApiBase + "/" + Bundle
+	ApiBundleExportRoute = ApiBundleRoute + "/" + Export
+	ApiBundleImportRoute = ApiBundleRoute + "/" + Import
+
+	ApiDeviceProfileRevisionRoute         = common.ApiDeviceProfileByNameRoute + "/" + Revision
+	ApiAllDeviceProfileRevisionRoute      = ApiDeviceProfileRevisionRoute + "/" + common.All
+	ApiDeviceProfileRevisionDiffRoute     = ApiDeviceProfileRevisionRoute + "/" + Diff
+	ApiDeviceProfileRevisionRollbackRoute = ApiDeviceProfileRevisionRoute + "/:" + Revision + "/" + Rollback
+
+	ApiAuditRoute    = common.ApiBase + "/" + Audit
+	ApiAllAuditRoute = ApiAuditRoute + "/" + common.All
+
+	ApiDeviceBulkRoute       = common.ApiDeviceRoute + "/" + Bulk
+	ApiDeviceUploadFileRoute = common.ApiDeviceRoute + "/" + UploadFile
+
+	ApiDeviceSubtreeByNameRoute   = common.ApiDeviceByNameRoute + "/" + Subtree
+	ApiDeviceAncestorsByNameRoute = common.ApiDeviceByNameRoute + "/" + Ancestors
+	ApiDeviceSearchRoute          = common.ApiDeviceRoute + "/" + Search
+
+	ApiDeviceTemplateRoute       = common.ApiBase + "/" + DeviceTemplate
+	ApiAllDeviceTemplateRoute    = ApiDeviceTemplateRoute + "/" + common.All
+	ApiDeviceTemplateByNameRoute = ApiDeviceTemplateRoute + "/" + common.Name + "/:" + common.Name
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	Bundle         = "bundle"
+	Export         = "export"
+	Import         = "import"
+	DryRun         = "dryRun"
+	ConflictPolicy = "conflictPolicy"
+	Revision       = "revision"
+	Diff           = "diff"
+	Rollback       = "rollback"
+	From           = "from"
+	To             = "to"
+	Audit          = "audit"
+	Actor          = "actor"
+	Action         = "action"
+	EntityType     = "entityType"
+	EntityName     = "entityName"
+	Bulk           = "bulk"
+	UploadFile     = "uploadfile"
+	DeviceTemplate = "devicetemplate"
+	Subtree        = "subtree"
+	Ancestors      = "ancestors"
+	Parent         = "parent"
+	Search         = "search"
+)
+
+// Constants related to the metadata bundle
+const (
+	// BundleSchemaVersion is the schema version of the metadata bundle exported by this service, and the only one accepted by the import
+	BundleSchemaVersion = "1"
+
+	ConflictPolicySkip      = "skip"
+	ConflictPolicyOverwrite = "overwrite"
+	ConflictPolicyFail      = "fail"
+
+	BundleImportActionCreated     = "created"
+	BundleImportActionOverwritten = "overwritten"
+	BundleImportActionSkipped     = "skipped"
+	BundleImportActionConflicted  = "conflicted"
+	BundleImportActionFailed      = "failed"
+)
+
+// Constants related to the device CSV upload
+const (
+	// DeviceCsvListSeparator separates the labels and the auto events in a cell of the device CSV
+	DeviceCsvListSeparator = ";"
+	// DeviceCsvAutoEventSeparator separates the source name and the interval of an auto event in the device CSV, e.g. "Temperature=10s"
+	DeviceCsvAutoEventSeparator = "="
+
+	DeviceCsvActionCreated = "created"
+	DeviceCsvActionUpdated = "updated"
+	DeviceCsvActionFailed  = "failed"
+)
+
+// Constants related to the device templates
+const (
+	// DeviceTemplateNameProperty is the device property linking the device to the device template by name
+	DeviceTemplateNameProperty = "deviceTemplateName"
+	// DeviceTemplateEntityType is the entity type of the device templates in the audit records
+	DeviceTemplateEntityType = DeviceTemplate
+)
+
+// Constants related to the device search
+const (
+	DeviceSortByName     = "name"
+	DeviceSortByCreated  = "created"
+	DeviceSortByModified = "modified"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// Constants related to the changes of the entity fields
+const (
+	FieldChangeAdded   = "added"
+	FieldChangeRemoved = "removed"
+	FieldChangeChanged = "changed"
+)
+
+// Constants related to the breaking changes of the device profiles
+const (
+	BreakingChangeResourceRemoved        = "resourceRemoved"
+	BreakingChangeResourceRetyped        = "resourceRetyped"
+	BreakingChangeResourceReadWrite      = "resourceReadWriteChanged"
+	BreakingChangeCommandRemoved         = "commandRemoved"
+	BreakingChangeCommandReadWrite       = "commandReadWriteChanged"
+	BreakingChangeCommandResourceMissing = "commandResourceMissing"
+	BreakingChangeAutoEventSourceRemoved = "autoEventSourceRemoved"
+)
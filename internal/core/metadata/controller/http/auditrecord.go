@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditRecordController struct {
+	dic *di.Container
+}
+
+// NewAuditRecordController creates and initializes an AuditRecordController
+func NewAuditRecordController(dic *di.Container) *AuditRecordController {
+	return &AuditRecordController{
+		dic: dic,
+	}
+}
+
+// AuditRecords returns the audit records filtered by the optional time range, actor, action, entity type and entity name
+// query parameters with offset and limit, the latest record comes first
+func (ac *AuditRecordController) AuditRecords(c echo.Context) error {
+	lc := container.LoggingClientFrom(ac.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(ac.dic.Get)
+
+	// parse URL query string for start, end, offset, limit, actor, action, entityType and entityName
+	start, end, offset, limit, err := utils.ParseQueryStringTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	query := models.AuditRecordQuery{
+		Actor:      utils.ParseQueryStringToString(r, constants.Actor, ""),
+		Action:     utils.ParseQueryStringToString(r, constants.Action, ""),
+		EntityType: utils.ParseQueryStringToString(r, constants.EntityType, ""),
+		EntityName: utils.ParseQueryStringToString(r, constants.EntityName, ""),
+		Start:      start,
+		End:        end,
+		Offset:     offset,
+		Limit:      limit,
+	}
+
+	records, totalCount, err := application.AuditRecords(query, ac.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiAuditRecordsResponse("", "", http.StatusOK, totalCount, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// AuditActor is the middleware putting the identity from the authorization header of the request into the request
+// context, so that the metadata changes made by the request are recorded in the audit log with the actor
+func AuditActor(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		r := c.Request()
+		actor := utils.CallerFromAuthorization(r.Header.Get(echo.HeaderAuthorization))
+		c.SetRequest(r.WithContext(application.WithAuditActor(r.Context(), actor)))
+		return next(c)
+	}
+}
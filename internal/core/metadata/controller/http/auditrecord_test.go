@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+func TestAuditRecords(t *testing.T) {
+	record := metadataModels.AuditRecord{
+		Id:         ExampleUUID,
+		Actor:      "admin",
+		Action:     common.SystemEventActionUpdate,
+		EntityType: common.DeviceSystemEventType,
+		EntityName: TestDeviceName,
+		Before:     map[string]any{"name": TestDeviceName, "adminState": "UNLOCKED"},
+		After:      map[string]any{"name": TestDeviceName, "adminState": "LOCKED"},
+		Created:    2000,
+	}
+	byDevice := mock.MatchedBy(func(q metadataModels.AuditRecordQuery) bool {
+		return q.EntityType == common.DeviceSystemEventType && q.EntityName == TestDeviceName && q.Actor == "admin" && q.Start == 1000 && q.End == 3000
+	})
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AuditRecordCount", byDevice).Return(uint32(1), nil)
+	dbClientMock.On("AuditRecords", byDevice).Return([]metadataModels.AuditRecord{record}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewAuditRecordController(dic)
+
+	tests := []struct {
+		name               string
+		queryParams        map[string]string
+		expectedStatusCode int
+		expectedCount      uint32
+	}{
+		{"Valid - by actor, entity and time range", map[string]string{constants.Actor: "admin", constants.EntityType: common.DeviceSystemEventType,
+			constants.EntityName: TestDeviceName, common.Start: "1000", common.End: "3000"}, http.StatusOK, 1},
+		{"Invalid - unknown action", map[string]string{constants.Action: "rename"}, http.StatusBadRequest, 0},
+		{"Invalid - unknown entity type", map[string]string{constants.EntityType: "event"}, http.StatusBadRequest, 0},
+		{"Invalid - end earlier than start", map[string]string{common.Start: "2000", common.End: "1000"}, http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiAllAuditRoute, http.NoBody)
+			query := req.URL.Query()
+			for k, v := range testCase.queryParams {
+				query.Add(k, v)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err := controller.AuditRecords(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responses.MultiAuditRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, res.TotalCount)
+			require.Len(t, res.Records, 1)
+			require.Len(t, res.Records[0].Changes, 1)
+			assert.Equal(t, "adminState", res.Records[0].Changes[0].Path)
+			assert.Equal(t, "LOCKED", res.Records[0].Changes[0].NewValue)
+		})
+	}
+}
+
+func TestAuditActor(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "admin"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	deviceService := dtos.ToDeviceServiceModel(buildTestDeviceServiceRequest().Service)
+
+	dic := mockDic()
+	container.ConfigurationFrom(dic.Get).AuditLog.Enabled = true
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceServiceByName", deviceService.Name).Return(deviceService, nil)
+	dbClientMock.On("DevicesByServiceName", 0, 1, deviceService.Name).Return(nil, nil)
+	dbClientMock.On("ProvisionWatchersByServiceName", 0, 1, deviceService.Name).Return(nil, nil)
+	dbClientMock.On("DeleteDeviceServiceByName", deviceService.Name, mock.Anything).Return(nil)
+	dbClientMock.On("AddAuditRecord", mock.Anything).Return(metadataModels.AuditRecord{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceServiceController(dic)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, common.ApiDeviceServiceByNameRoute, http.NoBody)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames(common.Name)
+	c.SetParamValues(deviceService.Name)
+	err = AuditActor(controller.DeleteDeviceServiceByName)(c)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	dbClientMock.AssertCalled(t, "AddAuditRecord", mock.MatchedBy(func(r metadataModels.AuditRecord) bool {
+		return r.Actor == "admin" && r.Action == common.SystemEventActionDelete && r.EntityType == common.DeviceServiceSystemEventType &&
+			r.EntityName == deviceService.Name && r.Before != nil && r.After == nil
+	}))
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BundleController struct {
+	reader        io.DtoReader
+	yamlDtoReader io.DtoReader
+	dic           *di.Container
+}
+
+// NewBundleController creates and initializes a BundleController
+func NewBundleController(dic *di.Container) *BundleController {
+	return &BundleController{
+		reader:        io.NewJsonDtoReader(),
+		yamlDtoReader: io.NewYamlDtoReader(),
+		dic:           dic,
+	}
+}
+
+// ExportMetadataBundle returns the metadata bundle as YAML if requested by the Accept header, otherwise as JSON
+func (bc *BundleController) ExportMetadataBundle(c echo.Context) error {
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	bundle, err := application.ExportMetadataBundle(ctx, bc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+
+	switch r.Header.Get(common.Accept) {
+	case common.ContentTypeYAML:
+		return pkg.EncodeAndWriteYamlResponse(bundle, w, lc)
+	default:
+		response := responses.NewMetadataBundleResponse("", "", http.StatusOK, bundle)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+}
+
+// ImportMetadataBundle imports the metadata bundle from the YAML or JSON request body, and returns the per-entity report
+func (bc *BundleController) ImportMetadataBundle(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	ctx := r.Context()
+
+	dryRun := utils.ParseQueryStringToString(r, constants.DryRun, common.ValueFalse) == common.ValueTrue
+	conflictPolicy := utils.ParseQueryStringToString(r, constants.ConflictPolicy, constants.ConflictPolicyFail)
+
+	var requestId string
+	var bundle metadataDTOs.MetadataBundle
+	switch r.Header.Get(common.ContentType) {
+	case common.ContentTypeYAML:
+		err := bc.yamlDtoReader.Read(r.Body, &bundle)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+	default:
+		var reqDTO requests.ImportMetadataBundleRequest
+		err := bc.reader.Read(r.Body, &reqDTO)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		requestId = reqDTO.RequestId
+		bundle = reqDTO.Bundle
+	}
+
+	report, err := application.ImportMetadataBundle(bundle, conflictPolicy, dryRun, ctx, bc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, requestId)
+	}
+
+	statusCode := http.StatusOK
+	for _, result := range report.Results {
+		if result.Action == constants.BundleImportActionConflicted && !dryRun {
+			statusCode = http.StatusConflict
+			break
+		}
+		if result.Action == constants.BundleImportActionFailed {
+			statusCode = http.StatusMultiStatus
+		}
+	}
+
+	response := responses.NewImportMetadataBundleResponse(requestId, "", statusCode, report)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
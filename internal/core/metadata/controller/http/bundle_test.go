@@ -0,0 +1,258 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+)
+
+const testNewDeviceServiceName = "newDeviceService"
+
+func buildTestMetadataBundle() metadataDTOs.MetadataBundle {
+	existing := buildTestDeviceServiceRequest().Service
+	newService := existing
+	newService.Id = ""
+	newService.Name = testNewDeviceServiceName
+	return metadataDTOs.MetadataBundle{
+		SchemaVersion:  constants.BundleSchemaVersion,
+		DeviceServices: []dtos.DeviceService{existing, newService},
+	}
+}
+
+func TestExportMetadataBundle(t *testing.T) {
+	ds := dtos.ToDeviceServiceModel(buildTestDeviceServiceRequest().Service)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceCountByLabels", []string(nil)).Return(uint32(1), nil)
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return([]models.DeviceService{ds}, nil)
+	dbClientMock.On("DeviceProfileCountByLabels", []string(nil)).Return(uint32(0), nil)
+	dbClientMock.On("DeviceCountByLabels", []string(nil)).Return(uint32(0), nil)
+	dbClientMock.On("ProvisionWatcherCountByLabels", []string(nil)).Return(uint32(0), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewBundleController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name   string
+		accept string
+	}{
+		{"Valid - json response", common.ContentTypeJSON},
+		{"Valid - yaml response", common.ContentTypeYAML},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiBundleExportRoute, http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set(common.Accept, testCase.accept)
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.ExportMetadataBundle(c)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+
+			var bundle metadataDTOs.MetadataBundle
+			if testCase.accept == common.ContentTypeJSON {
+				var res responses.MetadataBundleResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusOK, res.StatusCode, "BaseResponse status code not as expected")
+				bundle = res.Bundle
+			} else {
+				err = yaml.Unmarshal(recorder.Body.Bytes(), &bundle)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, constants.BundleSchemaVersion, bundle.SchemaVersion, "Schema version not as expected")
+			assert.NotZero(t, bundle.Created, "Created timestamp should be set")
+			require.Len(t, bundle.DeviceServices, 1, "Device service count not as expected")
+			assert.Equal(t, ds.Name, bundle.DeviceServices[0].Name, "Device service not as expected")
+			assert.Empty(t, bundle.DeviceProfiles, "Device profiles should be empty")
+			assert.Empty(t, bundle.Devices, "Devices should be empty")
+			assert.Empty(t, bundle.ProvisionWatchers, "Provision watchers should be empty")
+		})
+	}
+}
+
+func TestImportMetadataBundle(t *testing.T) {
+	bundle := buildTestMetadataBundle()
+	existing := dtos.ToDeviceServiceModel(bundle.DeviceServices[0])
+
+	invalidSchemaBundle := buildTestMetadataBundle()
+	invalidSchemaBundle.SchemaVersion = "0"
+	invalidEntityBundle := buildTestMetadataBundle()
+	invalidEntityBundle.DeviceServices[1].AdminState = "invalid"
+
+	tests := []struct {
+		name               string
+		bundle             metadataDTOs.MetadataBundle
+		contentType        string
+		dryRun             string
+		conflictPolicy     string
+		expectedStatusCode int
+		expectedActions    []string
+		expectedAdded      bool
+		expectedUpdated    bool
+	}{
+		{"Valid - dry run with conflicts", bundle, common.ContentTypeJSON, common.ValueTrue, "", http.StatusOK,
+			[]string{constants.BundleImportActionConflicted, constants.BundleImportActionCreated}, false, false},
+		{"Valid - skip existing", bundle, common.ContentTypeJSON, "", constants.ConflictPolicySkip, http.StatusOK,
+			[]string{constants.BundleImportActionSkipped, constants.BundleImportActionCreated}, true, false},
+		{"Valid - overwrite existing", bundle, common.ContentTypeJSON, "", constants.ConflictPolicyOverwrite, http.StatusOK,
+			[]string{constants.BundleImportActionOverwritten, constants.BundleImportActionCreated}, true, true},
+		{"Valid - yaml bundle", bundle, common.ContentTypeYAML, "", constants.ConflictPolicySkip, http.StatusOK,
+			[]string{constants.BundleImportActionSkipped, constants.BundleImportActionCreated}, true, false},
+		{"Valid - invalid entity", invalidEntityBundle, common.ContentTypeJSON, "", constants.ConflictPolicySkip, http.StatusMultiStatus,
+			[]string{constants.BundleImportActionSkipped, constants.BundleImportActionFailed}, false, false},
+		{"Invalid - conflicts abort the import", bundle, common.ContentTypeJSON, "", constants.ConflictPolicyFail, http.StatusConflict,
+			[]string{constants.BundleImportActionConflicted, constants.BundleImportActionCreated}, false, false},
+		{"Invalid - unsupported conflict policy", bundle, common.ContentTypeJSON, "", "replace", http.StatusBadRequest, nil, false, false},
+		{"Invalid - unsupported schema version", invalidSchemaBundle, common.ContentTypeJSON, "", "", http.StatusBadRequest, nil, false, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceServiceNameExists", existing.Name).Return(true, nil)
+			dbClientMock.On("DeviceServiceNameExists", testNewDeviceServiceName).Return(false, nil)
+			dbClientMock.On("DeviceServiceByName", existing.Name).Return(existing, nil)
+			dbClientMock.On("UpdateDeviceService", mock.Anything, mock.Anything).Return(nil)
+			dbClientMock.On("AddDeviceService", mock.Anything).Return(models.DeviceService{Id: ExampleUUID}, nil)
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+			controller := NewBundleController(dic)
+
+			var body []byte
+			var err error
+			if testCase.contentType == common.ContentTypeYAML {
+				body, err = yaml.Marshal(testCase.bundle)
+			} else {
+				body, err = json.Marshal(requests.ImportMetadataBundleRequest{
+					BaseRequest: commonDTO.BaseRequest{RequestId: ExampleUUID, Versionable: commonDTO.NewVersionable()},
+					Bundle:      testCase.bundle,
+				})
+			}
+			require.NoError(t, err)
+
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiBundleImportRoute, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(common.ContentType, testCase.contentType)
+			query := req.URL.Query()
+			if testCase.dryRun != "" {
+				query.Add(constants.DryRun, testCase.dryRun)
+			}
+			if testCase.conflictPolicy != "" {
+				query.Add(constants.ConflictPolicy, testCase.conflictPolicy)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.ImportMetadataBundle(c)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+
+			var res responses.ImportMetadataBundleResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+			if testCase.expectedActions == nil {
+				assert.NotEmpty(t, res.Message, "Message should not be empty")
+				return
+			}
+			require.Len(t, res.Report.Results, len(testCase.expectedActions), "Result count not as expected")
+			for i, action := range testCase.expectedActions {
+				assert.Equal(t, common.DeviceServiceSystemEventType, res.Report.Results[i].Type, "Result type not as expected")
+				assert.Equal(t, action, res.Report.Results[i].Action, "Result action not as expected")
+			}
+			assert.Equal(t, testCase.dryRun == common.ValueTrue, res.Report.DryRun, "DryRun not as expected")
+
+			if testCase.expectedAdded {
+				dbClientMock.AssertCalled(t, "AddDeviceService", mock.MatchedBy(func(ds models.DeviceService) bool {
+					return ds.Name == testNewDeviceServiceName && ds.Id == ""
+				}))
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddDeviceService", mock.Anything)
+			}
+			if testCase.expectedUpdated {
+				dbClientMock.AssertCalled(t, "UpdateDeviceService", mock.MatchedBy(func(ds models.DeviceService) bool {
+					return ds.Name == existing.Name && ds.Id == existing.Id
+				}), uint64(0))
+			} else {
+				dbClientMock.AssertNotCalled(t, "UpdateDeviceService", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestImportMetadataBundle_ApplyFailed(t *testing.T) {
+	bundle := buildTestMetadataBundle()
+	bundle.DeviceServices = bundle.DeviceServices[1:]
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", testNewDeviceServiceName).Return(false, nil)
+	dbClientMock.On("AddDeviceService", mock.Anything).Return(models.DeviceService{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "add failed", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewBundleController(dic)
+
+	body, err := yaml.Marshal(bundle)
+	require.NoError(t, err)
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodPost, constants.ApiBundleImportRoute, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set(common.ContentType, common.ContentTypeYAML)
+
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	err = controller.ImportMetadataBundle(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+
+	var res responses.ImportMetadataBundleResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Len(t, res.Report.Results, 1, "Result count not as expected")
+	assert.Equal(t, constants.BundleImportActionFailed, res.Report.Results[0].Action, "Result action not as expected")
+	assert.Contains(t, res.Report.Results[0].Message, "add failed", "Result message not as expected")
+}
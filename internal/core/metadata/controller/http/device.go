@@ -1,16 +1,22 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package http
 
 import (
+	"context"
 	"math"
 	"net/http"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataRequests "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/requests"
+	metadataResponses "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -18,11 +24,15 @@ import (
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/labstack/echo/v4"
 )
@@ -30,6 +40,8 @@ import (
 const (
 	bypassValidationQueryParam = "bypassValidation" // query param to specify whether to skip the Device Service Validation API call
 	forceQueryParam            = "force"            // query param to specify whether to force add a device
+	csvFileName                = "file"             // form field of the uploaded device CSV file
+	csvMappingName             = "mapping"          // form field of the column mapping template of the device CSV
 )
 
 type DeviceController struct {
@@ -209,7 +221,7 @@ func (dc *DeviceController) PatchDevice(c echo.Context) error {
 			response = commonDTO.NewBaseResponse(
 				reqId,
 				err.Message(),
-				err.Code())
+				utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
@@ -262,12 +274,18 @@ func (dc *DeviceController) DeviceByName(c echo.Context) error {
 	// URL parameters
 	name := c.Param(common.Name)
 
+	// the revision is read first, so that the entity tag is never newer than the returned device
+	revision, err := application.EntityRevision(common.DeviceSystemEventType, name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 	device, err := application.DeviceByName(name, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
 	response := responseDTO.NewDeviceResponse("", "", http.StatusOK, device)
+	w.Header().Set(utils.HeaderETag, utils.ETag(revision))
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -295,3 +313,229 @@ func (dc *DeviceController) DevicesByProfileName(c echo.Context) error {
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+func (dc *DeviceController) AddDevices(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	bypassValidation := utils.ParseQueryStringToString(r, bypassValidationQueryParam, common.ValueFalse) == common.ValueTrue
+
+	var reqDTO metadataRequests.AddDevicesRequest
+	err := dc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	devices := make([]models.Device, len(reqDTO.Devices))
+	for i, d := range reqDTO.Devices {
+		devices[i] = dtos.ToDeviceModel(d)
+	}
+
+	results, err := application.AddDevices(devices, ctx, dc.dic, bypassValidation)
+	return writeBulkDevicesResponse(w, ctx, lc, results, err, reqDTO.RequestId, http.StatusCreated)
+}
+
+func (dc *DeviceController) UpdateDevices(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	bypassValidation := utils.ParseQueryStringToString(r, bypassValidationQueryParam, common.ValueFalse) == common.ValueTrue
+
+	var reqDTO metadataRequests.UpdateDevicesRequest
+	err := dc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	results, err := application.UpdateDevices(reqDTO.Devices, ctx, dc.dic, bypassValidation)
+	return writeBulkDevicesResponse(w, ctx, lc, results, err, reqDTO.RequestId, http.StatusOK)
+}
+
+func (dc *DeviceController) DeleteDevices(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	var reqDTO metadataRequests.DeleteDevicesRequest
+	err := dc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	results, err := application.DeleteDevicesByNames(reqDTO.Names, ctx, dc.dic)
+	return writeBulkDevicesResponse(w, ctx, lc, results, err, reqDTO.RequestId, http.StatusOK)
+}
+
+func (dc *DeviceController) UploadDevicesCsv(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	bypassValidation := utils.ParseQueryStringToString(r, bypassValidationQueryParam, common.ValueFalse) == common.ValueTrue
+
+	file, _, fileErr := r.FormFile(csvFileName)
+	if fileErr == http.ErrMissingFile {
+		return utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "missing csv file", nil), "")
+	} else if fileErr != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindServerError, fileErr.Error(), nil), "")
+	}
+	defer func() { _ = file.Close() }()
+
+	var mapping metadataDTOs.DeviceCsvMapping
+	err := dc.reader.Read(strings.NewReader(r.FormValue(csvMappingName)), &mapping)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if validateErr := common.Validate(mapping); validateErr != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device csv mapping", validateErr), "")
+	}
+
+	results, err := application.UploadDevicesCsv(file, mapping, bypassValidation, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	statusCode := http.StatusOK
+	for _, result := range results {
+		if result.Action == constants.DeviceCsvActionFailed {
+			statusCode = http.StatusMultiStatus
+			break
+		}
+	}
+
+	response := metadataResponses.NewUploadDevicesCsvResponse("", "", statusCode, results)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceController) SearchDevices(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var reqDTO metadataRequests.SearchDevicesRequest
+	err = dc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	query := metadataRequests.SearchDevicesReqToDeviceQueryModel(reqDTO, offset, limit)
+	devices, totalCount, err := application.SearchDevices(query, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	response := responseDTO.NewMultiDevicesResponse(reqDTO.RequestId, "", http.StatusOK, totalCount, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceController) DeleteDeviceSubtreeByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+	dryRun := utils.ParseQueryStringToString(r, constants.DryRun, common.ValueFalse) == common.ValueTrue
+
+	devices, err := application.DeleteDeviceSubtreeByName(name, dryRun, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, uint32(len(devices)), devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceController) MoveDevice(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+	parent := utils.ParseQueryStringToString(r, constants.Parent, "")
+
+	devices, err := application.MoveDevice(name, parent, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, uint32(len(devices)), devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceController) DeviceAncestors(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	devices, err := application.DeviceAncestors(name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, uint32(len(devices)), devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// writeBulkDevicesResponse writes the per-device results of the bulk device request. If the batch is rejected because
+// some of the devices failed, the status code of the first failure is written along with the results.
+func writeBulkDevicesResponse(w *echo.Response, ctx context.Context, lc logger.LoggingClient, results []metadataDTOs.BulkDeviceResult, err errors.EdgeX, requestId string, statusCode int) error {
+	if err != nil && results == nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, requestId)
+	}
+	var message string
+	if err != nil {
+		lc.Error(err.Error(), common.CorrelationHeader, correlation.FromContext(ctx))
+		statusCode = err.Code()
+		message = err.Message()
+	}
+
+	response := metadataResponses.NewBulkDevicesResponse(requestId, message, statusCode, results)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -20,8 +20,14 @@ import (
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataRequests "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/requests"
+	metadataResponses "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -140,7 +146,7 @@ func TestAddDevice(t *testing.T) {
 	forceAddDm := dtos.ToDeviceModel(validForceAdd.Device)
 	dbClientMock.On("DeviceNameExists", validForceAdd.Device.Name).Return(true, nil)
 	dbClientMock.On("DeviceByName", validForceAdd.Device.Name).Return(forceAddDm, nil)
-	dbClientMock.On("UpdateDevice", forceAddDm).Return(nil)
+	dbClientMock.On("UpdateDevice", forceAddDm, mock.Anything).Return(nil)
 
 	notFoundProfile := testDevice
 	notFoundProfile.Device.ProfileName = "notFoundProfile"
@@ -329,8 +335,8 @@ func TestDeleteDeviceByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceTree", device.Name, 1, 0, 1, []string(nil)).Return(uint32(0), nil, nil)
-	dbClientMock.On("DeleteDeviceByName", device.Name).Return(nil)
-	dbClientMock.On("DeleteDeviceByName", notFoundName).Return(edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeleteDeviceByName", device.Name, mock.Anything).Return(nil)
+	dbClientMock.On("DeleteDeviceByName", notFoundName, mock.Anything).Return(edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dbClientMock.On("DeviceByName", notFoundName).Return(device, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
 	dbClientMock.On("DeviceByName", deviceParent.Name).Return(device, nil)
@@ -557,7 +563,7 @@ func TestPatchDevice(t *testing.T) {
 	valid := testReq
 	dbClientMock.On("DeviceServiceNameExists", *valid.Device.ServiceName).Return(true, nil)
 	dbClientMock.On("DeviceById", *valid.Device.Id).Return(dsModels, nil)
-	dbClientMock.On("UpdateDevice", dsModels).Return(nil)
+	dbClientMock.On("UpdateDevice", dsModels, mock.Anything).Return(nil)
 	dbClientMock.On("DeviceProfileByName", mock.Anything).Return(models.DeviceProfile{Name: "test-profile", DeviceResources: []models.DeviceResource{{Name: "TestResource"}}}, nil)
 
 	validWithNoReqID := testReq
@@ -584,7 +590,7 @@ func TestPatchDevice(t *testing.T) {
 	emptyProfile.Device.ProfileName = &emptyString
 	dm := dsModels
 	dm.ProfileName = *emptyProfile.Device.ProfileName
-	dbClientMock.On("UpdateDevice", dm).Return(nil)
+	dbClientMock.On("UpdateDevice", dm, mock.Anything).Return(nil)
 
 	invalidProtocols := testReq
 	invalidProtocols.Device.Protocols = map[string]dtos.ProtocolProperties{"others": {}}
@@ -617,7 +623,7 @@ func TestPatchDevice(t *testing.T) {
 	notFoundProfile.Device.ProfileName = &notFoundProfileName
 	notFoundProfileDeviceModel := dsModels
 	notFoundProfileDeviceModel.ProfileName = notFoundProfileName
-	dbClientMock.On("UpdateDevice", notFoundProfileDeviceModel).Return(
+	dbClientMock.On("UpdateDevice", notFoundProfileDeviceModel, mock.Anything).Return(
 		edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist,
 			fmt.Sprintf("device profile '%s' does not exists", notFoundProfileName), nil))
 
@@ -850,6 +856,8 @@ func TestDeviceByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
 	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("EntityRevision", common.DeviceSystemEventType, device.Name).Return(uint64(3), nil)
+	dbClientMock.On("EntityRevision", common.DeviceSystemEventType, notFoundName).Return(uint64(0), edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -903,6 +911,7 @@ func TestDeviceByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.deviceName, res.Device.Name, "Name not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, `"3"`, recorder.Header().Get(utils.HeaderETag), "ETag not as expected")
 			}
 		})
 	}
@@ -994,3 +1003,222 @@ func TestDevicesByProfileName(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteDevices(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	deviceParent := device
+	deviceParent.Name = "parentDevice"
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeviceByName", deviceParent.Name).Return(deviceParent, nil)
+	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceTree", device.Name, 1, 0, -1, []string(nil)).Return(uint32(0), []models.Device{}, nil)
+	dbClientMock.On("DeviceTree", deviceParent.Name, 1, 0, -1, []string(nil)).Return(uint32(1), []models.Device{device}, nil)
+	dbClientMock.On("DeleteDevicesByNames", []string{device.Name}, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		names               []string
+		expectedStatusCode  int
+		expectedResultCodes []int
+	}{
+		{"Valid - delete devices", []string{device.Name}, http.StatusOK, []int{http.StatusOK}},
+		{"Invalid - no device names", []string{}, http.StatusBadRequest, nil},
+		{"Invalid - empty device name", []string{device.Name, ""}, http.StatusBadRequest, nil},
+		{"Invalid - device not found", []string{device.Name, notFoundName}, http.StatusNotFound, []int{http.StatusFailedDependency, http.StatusNotFound}},
+		{"Invalid - device has children not in the batch", []string{deviceParent.Name}, http.StatusConflict, []int{http.StatusConflict}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqDTO := metadataRequests.DeleteDevicesRequest{BaseRequest: commonDTO.NewBaseRequest(), Names: testCase.names}
+			jsonData, err := json.Marshal(reqDTO)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodDelete, constants.ApiDeviceBulkRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.DeleteDevices(c)
+			require.NoError(t, err)
+			var res metadataResponses.BulkDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			require.Len(t, res.Results, len(testCase.expectedResultCodes))
+			for i, result := range res.Results {
+				assert.Equal(t, testCase.names[i], result.Name)
+				assert.Equal(t, testCase.expectedResultCodes[i], result.StatusCode)
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeleteDevicesByNames", 1)
+}
+
+func TestDeleteDeviceSubtreeByName(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	child := device
+	child.Name = "childDevice"
+	child.Parent = device.Name
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceTree", device.Name, 0, 0, -1, []string(nil)).Return(uint32(1), []models.Device{child}, nil)
+	dbClientMock.On("DeleteDevicesByNames", []string{child.Name, device.Name}, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		dryRun             string
+		expectedStatusCode int
+		expectedCount      int
+	}{
+		{"Valid - dry run", device.Name, common.ValueTrue, http.StatusOK, 2},
+		{"Valid - delete device along with its descendants", device.Name, common.ValueFalse, http.StatusOK, 2},
+		{"Invalid - name parameter is empty", "", common.ValueFalse, http.StatusBadRequest, 0},
+		{"Invalid - device not found by name", notFoundName, common.ValueFalse, http.StatusNotFound, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodDelete, constants.ApiDeviceSubtreeByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.DryRun, testCase.dryRun)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceName)
+			err = controller.DeleteDeviceSubtreeByName(c)
+			require.NoError(t, err)
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Len(t, res.Devices, testCase.expectedCount, "Device count not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeleteDevicesByNames", 1)
+}
+
+func TestSearchDevices(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	query := metadataModels.DeviceQuery{
+		NamePrefix:   "test",
+		AdminStates:  []string{models.Unlocked},
+		ServiceNames: []string{device.ServiceName},
+		AllLabels:    []string{testDeviceLabels[0]},
+		NoLabels:     []string{"retired"},
+		SortBy:       constants.DeviceSortByName,
+		Limit:        20,
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByQuery", query).Return(uint32(1), []models.Device{device}, nil)
+	lastConnectedQuery := query
+	lastConnectedQuery.LastConnectedFrom = 1000
+	lastConnectedQuery.LastConnectedTo = 2000
+	dbClientMock.On("DevicesByQuery", lastConnectedQuery).Return(uint32(1), []models.Device{device}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	valid := metadataRequests.SearchDevicesRequest{
+		BaseRequest:  commonDTO.NewBaseRequest(),
+		NamePrefix:   query.NamePrefix,
+		AdminStates:  query.AdminStates,
+		ServiceNames: query.ServiceNames,
+		Labels:       metadataDTOs.DeviceSearchLabels{AllOf: query.AllLabels, NoneOf: query.NoLabels},
+	}
+	invalidSortBy := valid
+	invalidSortBy.SortBy = "profile"
+	invalidAdminState := valid
+	invalidAdminState.AdminStates = []string{"ENABLED"}
+	lastConnected := valid
+	lastConnected.LastConnected = &metadataDTOs.DeviceSearchTimeRange{From: 1000, To: 2000}
+	invalidLastConnected := valid
+	invalidLastConnected.LastConnected = &metadataDTOs.DeviceSearchTimeRange{From: 2000, To: 1000}
+
+	tests := []struct {
+		name               string
+		request            metadataRequests.SearchDevicesRequest
+		expectedStatusCode int
+		expectedCount      int
+	}{
+		{"Valid - search devices", valid, http.StatusOK, 1},
+		{"Invalid - unknown sort field", invalidSortBy, http.StatusBadRequest, 0},
+		{"Valid - search devices by last connected range", lastConnected, http.StatusOK, 1},
+		{"Invalid - unknown admin state", invalidAdminState, http.StatusBadRequest, 0},
+		{"Invalid - last connected range ends before it starts", invalidLastConnected, http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, constants.ApiDeviceSearchRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			queryString := req.URL.Query()
+			queryString.Add(common.Limit, "20")
+			req.URL.RawQuery = queryString.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.SearchDevices(c)
+			require.NoError(t, err)
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Len(t, res.Devices, testCase.expectedCount, "Device count not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, uint32(1), res.TotalCount, "Total count not as expected")
+			}
+		})
+	}
+}
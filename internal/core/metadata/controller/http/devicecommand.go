@@ -9,6 +9,8 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataResponses "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -111,7 +113,7 @@ func (dc *DeviceCommandController) PatchDeviceProfileDeviceCommand(c echo.Contex
 			response = commonDTO.NewBaseResponse(
 				reqId,
 				err.Message(),
-				err.Code())
+				utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
@@ -135,6 +137,17 @@ func (dc *DeviceCommandController) DeleteDeviceCommandByName(c echo.Context) err
 	profileName := c.Param(common.Name)
 	commandName := c.Param(common.CommandName)
 
+	if utils.ParseQueryStringToString(r, constants.DryRun, common.ValueFalse) == common.ValueTrue {
+		// report the impact of the removal without applying it
+		impact, err := application.DeleteDeviceCommandImpact(profileName, commandName, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := metadataResponses.NewDeviceProfileImpactResponse("", "", http.StatusOK, impact)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+
 	err := application.DeleteDeviceCommandByName(profileName, commandName, ctx, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -81,7 +81,7 @@ func TestAddDeviceProfileDeviceCommand(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dic.Update(di.ServiceConstructorMap{
@@ -203,7 +203,7 @@ func TestPatchDeviceProfileDeviceCommand(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	dbClientMock.On("DeviceProfileByName", notFound).Return(deviceProfile, notFoundDBError)
 	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
@@ -286,9 +286,12 @@ func TestDeleteDeviceCommandByName(t *testing.T) {
 	dbClientMock.On("DevicesByProfileName", 0, mock.Anything, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(dpModel, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	dbClientMock.On("DevicesByProfileName", 0, 1, deviceExists).Return([]models.Device{models.Device{}}, nil)
+	inUseModel := dpModel
+	inUseModel.Name = deviceExists
+	dbClientMock.On("DeviceProfileByName", deviceExists).Return(inUseModel, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, deviceExists).Return([]models.Device{{Name: TestDeviceName}}, nil)
 
 	dbClientMock.On("DevicesByProfileName", 0, 1, notFoundName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
@@ -10,7 +10,9 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataResponses "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -103,8 +105,9 @@ func (dc *DeviceProfileController) UpdateDeviceProfile(c echo.Context) error {
 	ctx := r.Context()
 	correlationId := correlation.FromContext(ctx)
 
+	dryRun := utils.ParseQueryStringToString(r, constants.DryRun, common.ValueFalse) == common.ValueTrue
 	strictProfileChanges := metadataContainer.ConfigurationFrom(dc.dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges
-	if strictProfileChanges {
+	if strictProfileChanges && !dryRun {
 		return utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil), "")
 	}
 
@@ -119,6 +122,19 @@ func (dc *DeviceProfileController) UpdateDeviceProfile(c echo.Context) error {
 	for i, d := range deviceProfiles {
 		var response interface{}
 		reqId := reqDTOs[i].RequestId
+		if dryRun {
+			// report the impact of the profile change without applying it
+			impact, err := application.DeviceProfileChangeImpact(d, dc.dic)
+			if err != nil {
+				lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+				lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+				response = commonDTO.NewBaseResponse(reqId, err.Message(), utils.ErrorCode(err))
+			} else {
+				response = metadataResponses.NewDeviceProfileImpactResponse(reqId, "", http.StatusOK, impact)
+			}
+			responses = append(responses, response)
+			continue
+		}
 		err := application.UpdateDeviceProfile(d, ctx, dc.dic)
 		if err != nil {
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
@@ -126,7 +142,7 @@ func (dc *DeviceProfileController) UpdateDeviceProfile(c echo.Context) error {
 			response = commonDTO.NewBaseResponse(
 				reqId,
 				err.Message(),
-				err.Code())
+				utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
@@ -223,12 +239,18 @@ func (dc *DeviceProfileController) DeviceProfileByName(c echo.Context) error {
 	// URL parameters
 	name := c.Param(common.Name)
 
+	// the revision is read first, so that the entity tag is never newer than the returned device profile
+	revision, err := application.EntityRevision(common.DeviceProfileSystemEventType, name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 	deviceProfile, err := application.DeviceProfileByName(name, ctx, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
 	response := responseDTO.NewDeviceProfileResponse("", "", http.StatusOK, deviceProfile)
+	w.Header().Set(utils.HeaderETag, utils.ETag(revision))
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc) // encode and send out the response
 }
@@ -372,7 +394,7 @@ func (dc *DeviceProfileController) PatchDeviceProfileBasicInfo(c echo.Context) e
 		if err != nil {
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(reqId, "", http.StatusOK)
 		}
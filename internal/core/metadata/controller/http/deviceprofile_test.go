@@ -34,6 +34,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -179,7 +180,7 @@ func TestAddDeviceProfile_Created(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
+	dbClientMock.On("AddDeviceProfile", deviceProfileModel, mock.Anything).Return(deviceProfileModel, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -323,8 +324,8 @@ func TestAddDeviceProfile_Duplicated(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", duplicateNameModel).Return(duplicateNameModel, duplicateNameDBError)
-	dbClientMock.On("AddDeviceProfile", duplicateIdModel).Return(duplicateIdModel, duplicateIdDBError)
+	dbClientMock.On("AddDeviceProfile", duplicateNameModel, mock.Anything).Return(duplicateNameModel, duplicateNameDBError)
+	dbClientMock.On("AddDeviceProfile", duplicateIdModel, mock.Anything).Return(duplicateIdModel, duplicateIdDBError)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -394,8 +395,8 @@ func TestAddDeviceProfile_UnitsOfMeasure_Validation(t *testing.T) {
 	dic := mockDic()
 	container.ConfigurationFrom(dic.Get).Writable.UoM.Validation = true
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
-	dbClientMock.On("AddDeviceProfile", noUnitsModel).Return(noUnitsModel, nil)
+	dbClientMock.On("AddDeviceProfile", deviceProfileModel, mock.Anything).Return(deviceProfileModel, nil)
+	dbClientMock.On("AddDeviceProfile", noUnitsModel, mock.Anything).Return(noUnitsModel, nil)
 	uomMock := &mocks.UnitsOfMeasure{}
 	uomMock.On("Validate", TestUnits).Return(true)
 	uomMock.On("Validate", "").Return(true)
@@ -500,8 +501,9 @@ func TestUpdateDeviceProfile(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel, mock.Anything, mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel, mock.Anything, mock.Anything).Return(notFoundDBError)
+	dbClientMock.On("DeviceProfileByName", notFoundDeviceProfileModel.Name).Return(models.DeviceProfile{}, notFoundDBError)
 	dbClientMock.On("DeviceCountByProfileName", deviceProfileModel.Name).Return(uint32(1), nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, deviceProfileModel.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
 	dbClientMock.On("DeviceServiceByName", testDeviceServiceName).Return(models.DeviceService{}, nil)
@@ -643,7 +645,7 @@ func TestPatchDeviceProfileBasicInfo(t *testing.T) {
 	dbClientMock.On("DeviceProfileById", *valid.BasicInfo.Id).Return(dpModel, nil)
 	dbClientMock.On("DeviceProfileByName", *valid.BasicInfo.Name).Return(dpModel, nil)
 	dbClientMock.On("DeviceProfileByName", notFoundName).Return(dpModel, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	dbClientMock.On("DeviceCountByProfileName", *valid.BasicInfo.Name).Return(uint32(1), nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, *valid.BasicInfo.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
 	dic.Update(di.ServiceConstructorMap{
@@ -717,7 +719,7 @@ func TestAddDeviceProfileByYaml_Created(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
+	dbClientMock.On("AddDeviceProfile", deviceProfileModel, mock.Anything).Return(deviceProfileModel, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -834,7 +836,7 @@ func TestAddDeviceProfileByYaml_Duplicated(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, dbError)
+	dbClientMock.On("AddDeviceProfile", deviceProfileModel, mock.Anything).Return(deviceProfileModel, dbError)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -944,8 +946,9 @@ func TestUpdateDeviceProfileByYaml(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", validDeviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfile", validDeviceProfileModel, mock.Anything, mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel, mock.Anything, mock.Anything).Return(notFoundDBError)
+	dbClientMock.On("DeviceProfileByName", notFoundDeviceProfileModel.Name).Return(models.DeviceProfile{}, notFoundDBError)
 	dbClientMock.On("DeviceCountByProfileName", validDeviceProfileModel.Name).Return(uint32(1), nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, validDeviceProfileModel.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
 	dbClientMock.On("DeviceServiceByName", testDeviceServiceName).Return(models.DeviceService{}, nil)
@@ -1049,6 +1052,8 @@ func TestDeviceProfileByName(t *testing.T) {
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
 	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dbClientMock.On("EntityRevision", common.DeviceProfileSystemEventType, deviceProfile.Name).Return(uint64(3), nil)
+	dbClientMock.On("EntityRevision", common.DeviceProfileSystemEventType, notFoundName).Return(uint64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -1101,6 +1106,7 @@ func TestDeviceProfileByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.deviceProfileName, res.Profile.Name, "Event Id not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, `"3"`, recorder.Header().Get(utils.HeaderETag), "ETag not as expected")
 			}
 		})
 	}
@@ -1118,7 +1124,7 @@ func TestDeleteDeviceProfileByName(t *testing.T) {
 	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(models.DeviceProfile{}, nil)
 	dbClientMock.On("DevicesByProfileName", 0, 1, deviceProfile.Name).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByProfileName", 0, 1, deviceProfile.Name).Return([]models.ProvisionWatcher{}, nil)
-	dbClientMock.On("DeleteDeviceProfileByName", deviceProfile.Name).Return(nil)
+	dbClientMock.On("DeleteDeviceProfileByName", deviceProfile.Name, mock.Anything).Return(nil)
 
 	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
 
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DeviceProfileRevisions returns the revisions of the device profile with offset and limit, the latest revision comes first
+func (dc *DeviceProfileController) DeviceProfileRevisions(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	name := c.Param(common.Name)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	revisions, totalCount, err := application.DeviceProfileRevisions(name, offset, limit, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiDeviceProfileRevisionsResponse("", "", http.StatusOK, totalCount, revisions)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DiffDeviceProfileRevisions returns the changes from the revision specified by the from query parameter to the one
+// specified by the to query parameter
+func (dc *DeviceProfileController) DiffDeviceProfileRevisions(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	name := c.Param(common.Name)
+
+	from, err := utils.ParseQueryStringToInt64(c, constants.From, 0, 1, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	to, err := utils.ParseQueryStringToInt64(c, constants.To, 0, 1, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if from == 0 || to == 0 {
+		return utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "both from and to revisions are required", nil), "")
+	}
+
+	diff, err := application.DiffDeviceProfileRevisions(name, uint64(from), uint64(to), dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewDeviceProfileDiffResponse("", "", http.StatusOK, diff)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// RollbackDeviceProfile restores the device profile to the revision specified by the path parameter
+func (dc *DeviceProfileController) RollbackDeviceProfile(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	strictProfileChanges := metadataContainer.ConfigurationFrom(dc.dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges
+	if strictProfileChanges {
+		return utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil), "")
+	}
+
+	name := c.Param(common.Name)
+	revision, err := utils.ParsePathParamToInt64(c, constants.Revision, 1, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// the rollback is a POST request which the IfMatch middleware skips, but it overwrites the profile like a PUT request
+	if ifMatch := strings.TrimSpace(r.Header.Get(utils.HeaderIfMatch)); ifMatch != "" {
+		ctx = utils.WithIfMatch(ctx, ifMatch)
+	}
+	err = application.RollbackDeviceProfile(name, uint64(revision), ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
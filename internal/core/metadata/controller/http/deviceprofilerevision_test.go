@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+func TestDiffDeviceProfileRevisions(t *testing.T) {
+	profile := requests.DeviceProfileReqToDeviceProfileModel(buildTestDeviceProfileRequest())
+	changed := requests.DeviceProfileReqToDeviceProfileModel(buildTestDeviceProfileRequest())
+	changed.Model = "changed model"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", profile.Name, uint64(1)).Return(metadataModels.DeviceProfileRevision{Revision: 1, Profile: profile}, nil)
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", profile.Name, uint64(2)).Return(metadataModels.DeviceProfileRevision{Revision: 2, Profile: changed}, nil)
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", profile.Name, uint64(3)).Return(metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+
+	tests := []struct {
+		name               string
+		from               string
+		to                 string
+		expectedStatusCode int
+		expectedChanges    int
+	}{
+		{"Valid - diff two revisions", "1", "2", http.StatusOK, 1},
+		{"Valid - diff the same revision", "2", "2", http.StatusOK, 0},
+		{"Invalid - missing to revision", "1", "", http.StatusBadRequest, 0},
+		{"Invalid - revision is not a number", "1", "latest", http.StatusBadRequest, 0},
+		{"Invalid - revision not found", "1", "3", http.StatusNotFound, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiDeviceProfileRevisionDiffRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.From, testCase.from)
+			query.Add(constants.To, testCase.to)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(profile.Name)
+			err = controller.DiffDeviceProfileRevisions(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res responses.DeviceProfileDiffResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Len(t, res.Diff.Changes, testCase.expectedChanges)
+			}
+		})
+	}
+}
+
+func TestRollbackDeviceProfile(t *testing.T) {
+	current := requests.DeviceProfileReqToDeviceProfileModel(buildTestDeviceProfileRequest())
+	previous := requests.DeviceProfileReqToDeviceProfileModel(buildTestDeviceProfileRequest())
+	previous.Id = ""
+	previous.Description = "previous description"
+	invalid := requests.DeviceProfileReqToDeviceProfileModel(buildTestDeviceProfileRequest())
+	invalid.DeviceResources = []models.DeviceResource{{Name: TestDeviceResourceName}}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", current.Name).Return(current, nil)
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", current.Name, uint64(1)).Return(metadataModels.DeviceProfileRevision{Revision: 1, Profile: previous}, nil)
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", current.Name, uint64(2)).Return(metadataModels.DeviceProfileRevision{Revision: 2, Profile: invalid}, nil)
+	dbClientMock.On("DeviceProfileRevisionByProfileNameAndRevision", current.Name, uint64(3)).Return(metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("UpdateDeviceProfile", mock.MatchedBy(func(p models.DeviceProfile) bool {
+		// the rolled back profile keeps the id of the current profile
+		return p.Id == current.Id && p.Description == previous.Description
+	}), mock.Anything, mock.Anything).Return(nil)
+	dbClientMock.On("EntityRevision", common.DeviceProfileSystemEventType, current.Name).Return(uint64(3), nil)
+	dbClientMock.On("DeviceCountByProfileName", current.Name).Return(uint32(0), nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, current.Name).Return([]models.Device{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+
+	tests := []struct {
+		name               string
+		revision           string
+		strictChanges      bool
+		ifMatch            string
+		expectedStatusCode int
+	}{
+		{"Valid - rollback to the previous revision", "1", false, "", http.StatusOK},
+		{"Valid - If-Match matches the current entity tag", "1", false, `"3"`, http.StatusOK},
+		{"Invalid - If-Match doesn't match the current entity tag", "1", false, `"2"`, http.StatusPreconditionFailed},
+		{"Invalid - revision is not valid", "2", false, "", http.StatusBadRequest},
+		{"Invalid - revision not found", "3", false, "", http.StatusNotFound},
+		{"Invalid - revision is not a number", "first", false, "", http.StatusBadRequest},
+		{"Invalid - StrictDeviceProfileChanges is enabled", "1", true, "", http.StatusLocked},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges = testCase.strictChanges
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiDeviceProfileRevisionRollbackRoute, http.NoBody)
+			require.NoError(t, err)
+			if testCase.ifMatch != "" {
+				req.Header.Set(utils.HeaderIfMatch, testCase.ifMatch)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, constants.Revision)
+			c.SetParamValues(current.Name, testCase.revision)
+			err = controller.RollbackDeviceProfile(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
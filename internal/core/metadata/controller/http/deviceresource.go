@@ -9,6 +9,8 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataResponses "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -132,7 +134,7 @@ func (dc *DeviceResourceController) PatchDeviceProfileResource(c echo.Context) e
 			response = commonDTO.NewBaseResponse(
 				reqId,
 				err.Message(),
-				err.Code())
+				utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
@@ -157,6 +159,17 @@ func (dc *DeviceResourceController) DeleteDeviceResourceByName(c echo.Context) e
 	profileName := c.Param(common.Name)
 	resourceName := c.Param(common.ResourceName)
 
+	if utils.ParseQueryStringToString(r, constants.DryRun, common.ValueFalse) == common.ValueTrue {
+		// report the impact of the removal without applying it
+		impact, err := application.DeleteDeviceResourceImpact(profileName, resourceName, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := metadataResponses.NewDeviceProfileImpactResponse("", "", http.StatusOK, impact)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+
 	err := application.DeleteDeviceResourceByName(profileName, resourceName, ctx, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
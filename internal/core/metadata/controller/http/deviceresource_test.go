@@ -13,7 +13,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataResponses "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -158,7 +160,7 @@ func TestAddDeviceProfileResource(t *testing.T) {
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
 	dbClientMock.On("DeviceProfileByName", notFoundProfileName.ProfileName).Return(deviceProfile, notFoundDBError)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dic.Update(di.ServiceConstructorMap{
@@ -270,7 +272,7 @@ func TestAddDeviceProfileResource_UnitsOfMeasure_Validation(t *testing.T) {
 	container.ConfigurationFrom(dic.Get).Writable.UoM.Validation = true
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("DeviceProfileByName", validReq.ProfileName).Return(deviceProfile, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, validReq.ProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", validReq.ProfileName).Return(uint32(1), nil)
 	uomMock := &mocks.UnitsOfMeasure{}
@@ -357,7 +359,7 @@ func TestPatchDeviceProfileResource(t *testing.T) {
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
 	dbClientMock.On("DevicesByProfileName", 0, mock.Anything, valid.ProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	dbClientMock.On("DeviceProfileByName", deviceExistsProfileName).Return(deviceExistsProfile, nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, deviceExistsProfileName).Return([]models.Device{{}}, nil)
@@ -444,9 +446,12 @@ func TestDeleteDeviceResourceByName(t *testing.T) {
 	dbClientMock.On("DevicesByProfileName", 0, mock.Anything, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(dpModel, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	dbClientMock.On("DevicesByProfileName", 0, 1, deviceExists).Return([]models.Device{models.Device{}}, nil)
+	inUseModel := dpModel
+	inUseModel.Name = deviceExists
+	dbClientMock.On("DeviceProfileByName", deviceExists).Return(inUseModel, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, deviceExists).Return([]models.Device{{Name: TestDeviceName}}, nil)
 
 	dbClientMock.On("DevicesByProfileName", 0, 1, notFoundName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
@@ -503,6 +508,54 @@ func TestDeleteDeviceResourceByName(t *testing.T) {
 
 }
 
+func TestDeleteDeviceResourceByName_DryRun(t *testing.T) {
+	dpModel := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	resourceName := TestDeviceResourceName + "-dup"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(dpModel, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{{Name: TestDeviceName, ServiceName: TestDeviceServiceName}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceResourceController(dic)
+	require.NotNil(t, controller)
+
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodDelete, common.ApiDeviceProfileResourceByNameRoute, http.NoBody)
+	require.NoError(t, err)
+	query := req.URL.Query()
+	query.Add(constants.DryRun, common.ValueTrue)
+	req.URL.RawQuery = query.Encode()
+
+	// Act
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames(common.Name, common.ResourceName)
+	c.SetParamValues(TestDeviceProfileName, resourceName)
+	err = controller.DeleteDeviceResourceByName(c)
+	require.NoError(t, err)
+
+	var res metadataResponses.DeviceProfileImpactResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.True(t, res.Impact.InUse)
+	assert.True(t, res.Impact.Breaking)
+	require.Len(t, res.Impact.Changes, 1)
+	assert.Equal(t, constants.BreakingChangeResourceRemoved, res.Impact.Changes[0].Type)
+	assert.Equal(t, resourceName, res.Impact.Changes[0].ResourceName)
+	require.Len(t, res.Impact.AffectedDevices, 1)
+	assert.Equal(t, TestDeviceName, res.Impact.AffectedDevices[0].Name)
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestDeleteDeviceResourceByName_StrictProfileChanges(t *testing.T) {
 	dic := mockDic()
 	configuration := container.ConfigurationFrom(dic.Get)
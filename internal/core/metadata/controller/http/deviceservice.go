@@ -94,12 +94,18 @@ func (dc *DeviceServiceController) DeviceServiceByName(c echo.Context) error {
 	// URL parameters
 	name := c.Param(common.Name)
 
+	// the revision is read first, so that the entity tag is never newer than the returned device service
+	revision, err := application.EntityRevision(common.DeviceServiceSystemEventType, name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 	deviceService, err := application.DeviceServiceByName(name, ctx, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
 	response := responseDTO.NewDeviceServiceResponse("", "", http.StatusOK, deviceService)
+	w.Header().Set(utils.HeaderETag, utils.ETag(revision))
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -133,7 +139,7 @@ func (dc *DeviceServiceController) PatchDeviceService(c echo.Context) error {
 			response = commonDTO.NewBaseResponse(
 				reqId,
 				err.Message(),
-				err.Code())
+				utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
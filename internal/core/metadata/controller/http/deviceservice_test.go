@@ -15,6 +15,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -205,6 +206,8 @@ func TestDeviceServiceByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceServiceByName", deviceService.Name).Return(deviceService, nil)
 	dbClientMock.On("DeviceServiceByName", notFoundName).Return(models.DeviceService{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
+	dbClientMock.On("EntityRevision", common.DeviceServiceSystemEventType, deviceService.Name).Return(uint64(3), nil)
+	dbClientMock.On("EntityRevision", common.DeviceServiceSystemEventType, notFoundName).Return(uint64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -257,6 +260,7 @@ func TestDeviceServiceByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.deviceServiceName, res.Service.Name, "Name not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, `"3"`, recorder.Header().Get(utils.HeaderETag), "ETag not as expected")
 			}
 		})
 	}
@@ -277,7 +281,7 @@ func TestPatchDeviceService(t *testing.T) {
 
 	valid := testReq
 	dbClientMock.On("DeviceServiceById", *valid.Service.Id).Return(dsModels, nil)
-	dbClientMock.On("UpdateDeviceService", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceService", mock.Anything, mock.Anything).Return(nil)
 	validWithNoReqID := testReq
 	validWithNoReqID.RequestId = ""
 	validWithNoId := testReq
@@ -492,7 +496,7 @@ func TestDeleteDeviceServiceByName(t *testing.T) {
 	dbClientMock.On("DeviceServiceByName", deviceService.Name).Return(models.DeviceService{}, nil)
 	dbClientMock.On("DevicesByServiceName", 0, 1, deviceService.Name).Return([]models.Device{}, nil)
 	dbClientMock.On("ProvisionWatchersByServiceName", 0, 1, deviceService.Name).Return([]models.ProvisionWatcher{}, nil)
-	dbClientMock.On("DeleteDeviceServiceByName", deviceService.Name).Return(nil)
+	dbClientMock.On("DeleteDeviceServiceByName", deviceService.Name, mock.Anything).Return(nil)
 
 	dbClientMock.On("DeviceServiceByName", notFoundName).Return(models.DeviceService{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device service doesn't exist in the database", nil))
 
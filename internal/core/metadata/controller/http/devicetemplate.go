@@ -0,0 +1,177 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DeviceTemplateController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewDeviceTemplateController creates and initializes an DeviceTemplateController
+func NewDeviceTemplateController(dic *di.Container) *DeviceTemplateController {
+	return &DeviceTemplateController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+func (dc *DeviceTemplateController) AddDeviceTemplate(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requests.DeviceTemplateRequest
+	err := dc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	templates := requests.DeviceTemplateReqToDeviceTemplateModels(reqDTOs)
+
+	var addResponses []interface{}
+	for i, t := range templates {
+		var response interface{}
+		reqId := reqDTOs[i].RequestId
+		newId, err := application.AddDeviceTemplate(t, ctx, dc.dic)
+		if err == nil {
+			response = commonDTO.NewBaseWithIdResponse(reqId, "", http.StatusCreated, newId)
+		} else {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
+}
+
+// UpdateDeviceTemplate replaces the device templates as a whole, the changes are also applied to the linked devices
+func (dc *DeviceTemplateController) UpdateDeviceTemplate(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requests.DeviceTemplateRequest
+	err := dc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	templates := requests.DeviceTemplateReqToDeviceTemplateModels(reqDTOs)
+
+	var updateResponses []interface{}
+	for i, t := range templates {
+		var response interface{}
+		reqId := reqDTOs[i].RequestId
+		err := application.UpdateDeviceTemplate(t, ctx, dc.dic)
+		if err == nil {
+			response = commonDTO.NewBaseResponse(reqId, "", http.StatusOK)
+		} else {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		}
+		updateResponses = append(updateResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(updateResponses, w, lc)
+}
+
+func (dc *DeviceTemplateController) DeviceTemplateByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	template, err := application.DeviceTemplateByName(name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewDeviceTemplateResponse("", "", http.StatusOK, template)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceTemplateController) AllDeviceTemplates(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	templates, totalCount, err := application.AllDeviceTemplates(offset, limit, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiDeviceTemplatesResponse("", "", http.StatusOK, totalCount, templates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeviceTemplateController) DeleteDeviceTemplateByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteDeviceTemplateByName(name, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataRequests "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos/requests"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	edgexErr "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDeviceTemplate(t *testing.T) {
+	template := metadataDTOs.DeviceTemplate{Name: "test-template", ProfileName: TestDeviceProfileName, Labels: testDeviceLabels}
+	notFoundProfileTemplate := metadataDTOs.DeviceTemplate{Name: "notFoundProfileTemplate", ProfileName: "notFoundProfile"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", notFoundProfileTemplate.ProfileName).Return(false, nil)
+	dbClientMock.On("AddDeviceTemplate", mock.Anything).Return(func(t metadataModels.DeviceTemplate) metadataModels.DeviceTemplate {
+		t.Id = ExampleUUID
+		return t
+	}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		template           metadataDTOs.DeviceTemplate
+		expectedStatusCode int
+	}{
+		{"Valid", template, http.StatusCreated},
+		{"Invalid - device profile not found", notFoundProfileTemplate, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqs := []metadataRequests.DeviceTemplateRequest{{BaseRequest: commonDTO.NewBaseRequest(), Template: testCase.template}}
+			jsonData, err := json.Marshal(reqs)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, constants.ApiDeviceTemplateRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddDeviceTemplate(c)
+			require.NoError(t, err)
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res[0].Id)
+			} else {
+				assert.NotEmpty(t, res[0].Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestDeleteDeviceTemplateByName(t *testing.T) {
+	templateName := "test-template"
+	notFoundName := "notFoundName"
+	linkedName := "linkedTemplate"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceTemplateByName", templateName).Return(metadataModels.DeviceTemplate{Name: templateName}, nil)
+	dbClientMock.On("DeviceCountByTemplateName", templateName).Return(uint32(0), nil)
+	dbClientMock.On("DeleteDeviceTemplateByName", templateName).Return(nil)
+	dbClientMock.On("DeviceTemplateByName", notFoundName).Return(metadataModels.DeviceTemplate{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device template doesn't exist in the database", nil))
+	dbClientMock.On("DeviceTemplateByName", linkedName).Return(metadataModels.DeviceTemplate{Name: linkedName}, nil)
+	dbClientMock.On("DeviceCountByTemplateName", linkedName).Return(uint32(2), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		templateName       string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - delete device template by name", templateName, false, http.StatusOK},
+		{"Invalid - name parameter is empty", "", true, http.StatusBadRequest},
+		{"Invalid - device template not found by name", notFoundName, true, http.StatusNotFound},
+		{"Invalid - linked device exists", linkedName, true, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", constants.ApiDeviceTemplateRoute, common.Name, testCase.templateName)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.templateName)
+			err = controller.DeleteDeviceTemplateByName(c)
+			require.NoError(t, err)
+
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeleteDeviceTemplateByName", 1)
+}
@@ -93,12 +93,18 @@ func (pwc *ProvisionWatcherController) ProvisionWatcherByName(c echo.Context) er
 	// URL parameters
 	name := c.Param(common.Name)
 
+	// the revision is read first, so that the entity tag is never newer than the returned provision watcher
+	revision, err := application.EntityRevision(common.ProvisionWatcherSystemEventType, name, pwc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 	provisionWatcher, err := application.ProvisionWatcherByName(name, pwc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
 	response := responseDTO.NewProvisionWatcherResponse("", "", http.StatusOK, provisionWatcher)
+	w.Header().Set(utils.HeaderETag, utils.ETag(revision))
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -220,7 +226,7 @@ func (pwc *ProvisionWatcherController) PatchProvisionWatcher(c echo.Context) err
 			response = commonDTO.NewBaseResponse(
 				reqId,
 				err.Message(),
-				err.Code())
+				utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
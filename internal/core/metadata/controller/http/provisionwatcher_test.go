@@ -24,10 +24,12 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
 var testProvisionWatcherName = "TestProvisionWatcher"
@@ -298,6 +300,8 @@ func TestProvisionWatcherController_ProvisionWatcherByName(t *testing.T) {
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("ProvisionWatcherByName", provisionWatcher.Name).Return(provisionWatcher, nil)
 	dbClientMock.On("ProvisionWatcherByName", notFoundName).Return(models.ProvisionWatcher{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "provision watcher doesn't exist in the database", nil))
+	dbClientMock.On("EntityRevision", common.ProvisionWatcherSystemEventType, provisionWatcher.Name).Return(uint64(3), nil)
+	dbClientMock.On("EntityRevision", common.ProvisionWatcherSystemEventType, notFoundName).Return(uint64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "provision watcher doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -351,6 +355,7 @@ func TestProvisionWatcherController_ProvisionWatcherByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.provisionWatcherName, res.ProvisionWatcher.Name, "Name not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, `"3"`, recorder.Header().Get(utils.HeaderETag), "ETag not as expected")
 			}
 		})
 	}
@@ -618,7 +623,7 @@ func TestProvisionWatcherController_DeleteProvisionWatcherByName(t *testing.T) {
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("ProvisionWatcherByName", provisionWatcher.Name).Return(provisionWatcher, nil)
 	dbClientMock.On("ProvisionWatcherByName", notFoundName).Return(provisionWatcher, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "provision watcher doesn't exist in the database", nil))
-	dbClientMock.On("DeleteProvisionWatcherByName", provisionWatcher.Name).Return(nil)
+	dbClientMock.On("DeleteProvisionWatcherByName", provisionWatcher.Name, mock.Anything).Return(nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -694,7 +699,7 @@ func TestProvisionWatcherController_PatchProvisionWatcher(t *testing.T) {
 	dbClientMock.On("DeviceServiceNameExists", *valid.ProvisionWatcher.ServiceName).Return(true, nil)
 	dbClientMock.On("DeviceProfileNameExists", *valid.ProvisionWatcher.DiscoveredDevice.ProfileName).Return(true, nil)
 	dbClientMock.On("ProvisionWatcherByName", *valid.ProvisionWatcher.Name).Return(pwModels, nil)
-	dbClientMock.On("UpdateProvisionWatcher", pwModels).Return(nil)
+	dbClientMock.On("UpdateProvisionWatcher", pwModels, mock.Anything).Return(nil)
 	validWithNoReqID := testReq
 	validWithNoReqID.RequestId = ""
 	validWithNoId := testReq
@@ -741,7 +746,7 @@ func TestProvisionWatcherController_PatchProvisionWatcher(t *testing.T) {
 	notFoundServiceProvisionWatcherModel := pwModels
 	notFoundServiceProvisionWatcherModel.Name = notFountServiceName
 	dbClientMock.On("ProvisionWatcherByName", notFountServiceName).Return(notFoundServiceProvisionWatcherModel, nil)
-	dbClientMock.On("UpdateProvisionWatcher", notFoundServiceProvisionWatcherModel).Return(
+	dbClientMock.On("UpdateProvisionWatcher", notFoundServiceProvisionWatcherModel, mock.Anything).Return(
 		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device service '%s' does not exists",
 			notFountServiceName), nil))
 
@@ -752,7 +757,7 @@ func TestProvisionWatcherController_PatchProvisionWatcher(t *testing.T) {
 	notFoundProfileProvisionWatcherModel := pwModels
 	notFoundProfileProvisionWatcherModel.Name = notFountProfileName
 	dbClientMock.On("ProvisionWatcherByName", notFountProfileName).Return(notFoundProfileProvisionWatcherModel, nil)
-	dbClientMock.On("UpdateProvisionWatcher", notFoundProfileProvisionWatcherModel).Return(
+	dbClientMock.On("UpdateProvisionWatcher", notFoundProfileProvisionWatcherModel, mock.Anything).Return(
 		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exists",
 			notFountProfileName), nil))
 
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// AuditRecord defines the record of a change made to a device, device profile, device service or provision watcher. The
+// Changes are the differences from the Before to the After representation of the entity.
+type AuditRecord struct {
+	Id            string        `json:"id"`
+	Actor         string        `json:"actor,omitempty"`
+	Action        string        `json:"action"`
+	EntityType    string        `json:"entityType"`
+	EntityName    string        `json:"entityName"`
+	Before        any           `json:"before,omitempty"`
+	After         any           `json:"after,omitempty"`
+	Changes       []FieldChange `json:"changes"`
+	CorrelationId string        `json:"correlationId,omitempty"`
+	Created       int64         `json:"created"`
+}
+
+// FromAuditRecordModelToDTO transforms the AuditRecord Model to the AuditRecord DTO without the Changes
+func FromAuditRecordModelToDTO(record models.AuditRecord) AuditRecord {
+	return AuditRecord{
+		Id:            record.Id,
+		Actor:         record.Actor,
+		Action:        record.Action,
+		EntityType:    record.EntityType,
+		EntityName:    record.EntityName,
+		Before:        record.Before,
+		After:         record.After,
+		CorrelationId: record.CorrelationId,
+		Created:       record.Created,
+	}
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+// MetadataBundle defines the versioned bundle of the whole metadata inventory
+type MetadataBundle struct {
+	SchemaVersion     string                  `json:"schemaVersion" yaml:"schemaVersion" validate:"required"`
+	Created           int64                   `json:"created,omitempty" yaml:"created,omitempty"`
+	DeviceServices    []dtos.DeviceService    `json:"deviceServices" yaml:"deviceServices"`
+	DeviceProfiles    []dtos.DeviceProfile    `json:"deviceProfiles" yaml:"deviceProfiles"`
+	Devices           []dtos.Device           `json:"devices" yaml:"devices"`
+	ProvisionWatchers []dtos.ProvisionWatcher `json:"provisionWatchers" yaml:"provisionWatchers"`
+}
+
+// BundleImportResult defines the import result of an entity in the metadata bundle
+type BundleImportResult struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Action  string `json:"action"`
+	Message string `json:"message,omitempty"`
+}
+
+// BundleImportReport defines the per-entity results of importing a metadata bundle, the results are the planned ones
+// when DryRun is true
+type BundleImportReport struct {
+	DryRun         bool                 `json:"dryRun"`
+	ConflictPolicy string               `json:"conflictPolicy"`
+	Results        []BundleImportResult `json:"results"`
+}
@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// BulkDeviceResult defines the result of a device in the bulk device request
+type BulkDeviceResult struct {
+	Name       string `json:"name"`
+	Id         string `json:"id,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
+}
+
+// DeviceSearchLabels defines the label expression of the device search, which matches the devices having all the labels
+// of AllOf, any label of AnyOf and none of the labels of NoneOf
+type DeviceSearchLabels struct {
+	AllOf  []string `json:"allOf,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	AnyOf  []string `json:"anyOf,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	NoneOf []string `json:"noneOf,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+}
+
+// DeviceSearchTimeRange defines the time range of the device search in milliseconds, both bounds are inclusive and a zero
+// bound is open
+type DeviceSearchTimeRange struct {
+	From int64 `json:"from,omitempty" validate:"gte=0"`
+	To   int64 `json:"to,omitempty" validate:"omitempty,gtefield=From"`
+}
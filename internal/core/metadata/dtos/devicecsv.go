@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// DeviceCsvMapping defines the template mapping the columns of the device CSV to the device fields, where each field is
+// the header name of the mapped column and the unmapped fields are left empty. The protocol properties are mapped by the
+// protocol name and then the property name. The default profile and service names are used for the rows whose profile
+// or service name column is not mapped or empty.
+type DeviceCsvMapping struct {
+	Name               string                       `json:"name" validate:"required"`
+	Description        string                       `json:"description,omitempty"`
+	Parent             string                       `json:"parent,omitempty"`
+	ProfileName        string                       `json:"profileName,omitempty"`
+	ServiceName        string                       `json:"serviceName,omitempty"`
+	Labels             string                       `json:"labels,omitempty"`
+	ProtocolProperties map[string]map[string]string `json:"protocolProperties,omitempty"`
+	AutoEvents         string                       `json:"autoEvents,omitempty"`
+	DefaultProfileName string                       `json:"defaultProfileName,omitempty"`
+	DefaultServiceName string                       `json:"defaultServiceName,omitempty"`
+}
+
+// DeviceCsvRowResult defines the result of a row in the device CSV, Line is the line number of the row in the CSV
+type DeviceCsvRowResult struct {
+	Line    int    `json:"line"`
+	Name    string `json:"name,omitempty"`
+	Action  string `json:"action"`
+	Id      string `json:"id,omitempty"`
+	Message string `json:"message,omitempty"`
+}
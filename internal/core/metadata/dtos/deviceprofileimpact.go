@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// DeviceProfileImpact defines the impact of a device profile change on the devices using the profile
+type DeviceProfileImpact struct {
+	ProfileName string `json:"profileName"`
+	// InUse indicates whether any device uses the profile
+	InUse bool `json:"inUse"`
+	// Breaking indicates whether the change breaks the existing usages of the profile
+	Breaking        bool                          `json:"breaking"`
+	Changes         []DeviceProfileBreakingChange `json:"changes"`
+	AffectedDevices []AffectedDevice              `json:"affectedDevices"`
+}
+
+// DeviceProfileBreakingChange defines a change of the device profile which may break the devices using the profile
+type DeviceProfileBreakingChange struct {
+	Type         string `json:"type"`
+	ResourceName string `json:"resourceName,omitempty"`
+	CommandName  string `json:"commandName,omitempty"`
+	DeviceName   string `json:"deviceName,omitempty"`
+	OldValue     string `json:"oldValue,omitempty"`
+	NewValue     string `json:"newValue,omitempty"`
+	Message      string `json:"message"`
+}
+
+// AffectedDevice defines a device affected by the breaking changes of its device profile
+type AffectedDevice struct {
+	Name        string `json:"name"`
+	ServiceName string `json:"serviceName"`
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// DeviceProfileRevision defines the snapshot of a device profile stored each time the profile is added or changed
+type DeviceProfileRevision struct {
+	Id          string             `json:"id"`
+	ProfileName string             `json:"profileName"`
+	Revision    uint64             `json:"revision"`
+	Profile     dtos.DeviceProfile `json:"profile"`
+	Created     int64              `json:"created"`
+}
+
+// DeviceProfileDiff defines the changes from a revision of a device profile to another
+type DeviceProfileDiff struct {
+	ProfileName  string        `json:"profileName"`
+	FromRevision uint64        `json:"fromRevision"`
+	ToRevision   uint64        `json:"toRevision"`
+	Changes      []FieldChange `json:"changes"`
+}
+
+// FromDeviceProfileRevisionModelToDTO transforms the DeviceProfileRevision Model to the DeviceProfileRevision DTO
+func FromDeviceProfileRevisionModelToDTO(r models.DeviceProfileRevision) DeviceProfileRevision {
+	return DeviceProfileRevision{
+		Id:          r.Id,
+		ProfileName: r.ProfileName,
+		Revision:    r.Revision,
+		Profile:     dtos.FromDeviceProfileModelToDTO(r.Profile),
+		Created:     r.Created,
+	}
+}
@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// DeviceTemplate defines the default fields inherited by the devices linked to the template
+type DeviceTemplate struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string                             `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string                             `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description      string                             `json:"description,omitempty"`
+	ProfileName      string                             `json:"profileName,omitempty"`
+	Labels           []string                           `json:"labels,omitempty"`
+	AutoEvents       []dtos.AutoEvent                   `json:"autoEvents,omitempty" validate:"dive"`
+	Protocols        map[string]dtos.ProtocolProperties `json:"protocols,omitempty"`
+	Properties       map[string]any                     `json:"properties,omitempty"`
+}
+
+// ToDeviceTemplateModel transforms the DeviceTemplate DTO to the DeviceTemplate Model
+func ToDeviceTemplateModel(dto DeviceTemplate) models.DeviceTemplate {
+	return models.DeviceTemplate{
+		Id:          dto.Id,
+		Name:        dto.Name,
+		Description: dto.Description,
+		ProfileName: dto.ProfileName,
+		Labels:      dto.Labels,
+		AutoEvents:  dtos.ToAutoEventModels(dto.AutoEvents),
+		Protocols:   dtos.ToProtocolModels(dto.Protocols),
+		Properties:  dto.Properties,
+	}
+}
+
+// FromDeviceTemplateModelToDTO transforms the DeviceTemplate Model to the DeviceTemplate DTO
+func FromDeviceTemplateModelToDTO(t models.DeviceTemplate) DeviceTemplate {
+	return DeviceTemplate{
+		DBTimestamp: dtos.DBTimestamp(t.DBTimestamp),
+		Id:          t.Id,
+		Name:        t.Name,
+		Description: t.Description,
+		ProfileName: t.ProfileName,
+		Labels:      t.Labels,
+		AutoEvents:  dtos.FromAutoEventModelsToDTOs(t.AutoEvents),
+		Protocols:   dtos.FromProtocolModelsToDTOs(t.Protocols),
+		Properties:  t.Properties,
+	}
+}
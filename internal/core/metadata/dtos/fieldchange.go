@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// FieldChange defines a difference between two versions of an entity. The Path locates the changed field in the JSON
+// representation of the entity, where the elements of an array of named objects are located by name, e.g.
+// deviceResources[temperature].properties.maximum.
+type FieldChange struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	OldValue any    `json:"oldValue,omitempty"`
+	NewValue any    `json:"newValue,omitempty"`
+}
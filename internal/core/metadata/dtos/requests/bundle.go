@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// ImportMetadataBundleRequest defines the Request Content for POST metadata bundle
+type ImportMetadataBundleRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Bundle                dtos.MetadataBundle `json:"bundle"`
+}
+
+// Validate satisfies the Validator interface
+func (r ImportMetadataBundleRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ImportMetadataBundleRequest", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the ImportMetadataBundleRequest type
+func (r *ImportMetadataBundleRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Bundle dtos.MetadataBundle
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = ImportMetadataBundleRequest(alias)
+
+	// validate ImportMetadataBundleRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// AddDevicesRequest defines the Request Content for POST bulk devices
+type AddDevicesRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Devices               []dtos.Device `json:"devices" validate:"gt=0,dive"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddDevicesRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddDevicesRequest", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDevicesRequest type
+func (r *AddDevicesRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Devices []dtos.Device
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	for i := range alias.Devices {
+		if alias.Devices[i].Properties == nil {
+			alias.Devices[i].Properties = make(map[string]any)
+		}
+	}
+
+	*r = AddDevicesRequest(alias)
+
+	// validate AddDevicesRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateDevicesRequest defines the Request Content for PATCH bulk devices
+type UpdateDevicesRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Devices               []dtos.UpdateDevice `json:"devices" validate:"gt=0,dive"`
+}
+
+// Validate satisfies the Validator interface
+func (r UpdateDevicesRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid UpdateDevicesRequest", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateDevicesRequest type
+func (r *UpdateDevicesRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Devices []dtos.UpdateDevice
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = UpdateDevicesRequest(alias)
+
+	// validate UpdateDevicesRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteDevicesRequest defines the Request Content for DELETE bulk devices
+type DeleteDevicesRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Names                 []string `json:"names" validate:"gt=0,dive,edgex-dto-none-empty-string"`
+}
+
+// Validate satisfies the Validator interface
+func (r DeleteDevicesRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid DeleteDevicesRequest", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the DeleteDevicesRequest type
+func (r *DeleteDevicesRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Names []string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = DeleteDevicesRequest(alias)
+
+	// validate DeleteDevicesRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SearchDevicesRequest defines the Request Content for POST device search, the devices matching all the filters are
+// returned and an empty filter matches any device. The protocol properties are keyed by the protocol name and then the
+// property name.
+type SearchDevicesRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	NamePrefix            string                              `json:"namePrefix,omitempty"`
+	AdminStates           []string                            `json:"adminStates,omitempty" validate:"omitempty,dive,oneof='LOCKED' 'UNLOCKED'"`
+	OperatingStates       []string                            `json:"operatingStates,omitempty" validate:"omitempty,dive,oneof='UP' 'DOWN' 'UNKNOWN'"`
+	ProfileNames          []string                            `json:"profileNames,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	ServiceNames          []string                            `json:"serviceNames,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	Labels                metadataDTOs.DeviceSearchLabels     `json:"labels"`
+	ProtocolProperties    map[string]map[string]string        `json:"protocolProperties,omitempty"`
+	LastConnected         *metadataDTOs.DeviceSearchTimeRange `json:"lastConnected,omitempty"`
+	SortBy                string                              `json:"sortBy,omitempty" validate:"omitempty,oneof='name' 'created' 'modified'"`
+	SortOrder             string                              `json:"sortOrder,omitempty" validate:"omitempty,oneof='asc' 'desc'"`
+}
+
+// Validate satisfies the Validator interface
+func (r SearchDevicesRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid SearchDevicesRequest", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the SearchDevicesRequest type
+func (r *SearchDevicesRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		NamePrefix         string
+		AdminStates        []string
+		OperatingStates    []string
+		ProfileNames       []string
+		ServiceNames       []string
+		Labels             metadataDTOs.DeviceSearchLabels
+		ProtocolProperties map[string]map[string]string
+		LastConnected      *metadataDTOs.DeviceSearchTimeRange
+		SortBy             string
+		SortOrder          string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = SearchDevicesRequest(alias)
+
+	// validate SearchDevicesRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SearchDevicesReqToDeviceQueryModel transforms the SearchDevicesRequest DTO to the DeviceQuery model of the page
+// by offset and limit, the devices are sorted by name in ascending order by default
+func SearchDevicesReqToDeviceQueryModel(req SearchDevicesRequest, offset int, limit int) models.DeviceQuery {
+	query := models.DeviceQuery{
+		NamePrefix:         req.NamePrefix,
+		AdminStates:        req.AdminStates,
+		OperatingStates:    req.OperatingStates,
+		ProfileNames:       req.ProfileNames,
+		ServiceNames:       req.ServiceNames,
+		AllLabels:          req.Labels.AllOf,
+		AnyLabels:          req.Labels.AnyOf,
+		NoLabels:           req.Labels.NoneOf,
+		ProtocolProperties: req.ProtocolProperties,
+		SortBy:             req.SortBy,
+		Descending:         req.SortOrder == constants.SortOrderDesc,
+		Offset:             offset,
+		Limit:              limit,
+	}
+	if req.LastConnected != nil {
+		query.LastConnectedFrom = req.LastConnected.From
+		query.LastConnectedTo = req.LastConnected.To
+	}
+	if query.SortBy == "" {
+		query.SortBy = constants.DeviceSortByName
+	}
+	return query
+}
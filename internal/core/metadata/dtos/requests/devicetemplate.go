@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// DeviceTemplateRequest defines the Request Content for POST and PUT device template, the template is replaced as a
+// whole on PUT
+type DeviceTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Template              dtos.DeviceTemplate `json:"template"`
+}
+
+// Validate satisfies the Validator interface
+func (r DeviceTemplateRequest) Validate() error {
+	err := common.Validate(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid DeviceTemplateRequest", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the DeviceTemplateRequest type
+func (r *DeviceTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Template dtos.DeviceTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = DeviceTemplateRequest(alias)
+
+	// validate DeviceTemplateRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeviceTemplateReqToDeviceTemplateModels transforms the DeviceTemplateRequest DTOs to the DeviceTemplate models
+func DeviceTemplateReqToDeviceTemplateModels(reqs []DeviceTemplateRequest) []models.DeviceTemplate {
+	templates := make([]models.DeviceTemplate, len(reqs))
+	for i, req := range reqs {
+		templates[i] = dtos.ToDeviceTemplateModel(req.Template)
+	}
+	return templates
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// MetadataBundleResponse defines the Response Content for GET metadata bundle
+type MetadataBundleResponse struct {
+	common.BaseResponse `json:",inline"`
+	Bundle              dtos.MetadataBundle `json:"bundle"`
+}
+
+func NewMetadataBundleResponse(requestId string, message string, statusCode int, bundle dtos.MetadataBundle) MetadataBundleResponse {
+	return MetadataBundleResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Bundle:       bundle,
+	}
+}
+
+// ImportMetadataBundleResponse defines the Response Content for POST metadata bundle
+type ImportMetadataBundleResponse struct {
+	common.BaseResponse `json:",inline"`
+	Report              dtos.BundleImportReport `json:"report"`
+}
+
+func NewImportMetadataBundleResponse(requestId string, message string, statusCode int, report dtos.BundleImportReport) ImportMetadataBundleResponse {
+	return ImportMetadataBundleResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Report:       report,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// BulkDevicesResponse defines the Response Content for POST, PATCH and DELETE bulk devices
+type BulkDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Results             []dtos.BulkDeviceResult `json:"results"`
+}
+
+func NewBulkDevicesResponse(requestId string, message string, statusCode int, results []dtos.BulkDeviceResult) BulkDevicesResponse {
+	return BulkDevicesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Results:      results,
+	}
+}
+
+// UploadDevicesCsvResponse defines the Response Content for POST device CSV upload
+type UploadDevicesCsvResponse struct {
+	common.BaseResponse `json:",inline"`
+	Results             []dtos.DeviceCsvRowResult `json:"results"`
+}
+
+func NewUploadDevicesCsvResponse(requestId string, message string, statusCode int, results []dtos.DeviceCsvRowResult) UploadDevicesCsvResponse {
+	return UploadDevicesCsvResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Results:      results,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// DeviceProfileImpactResponse defines the Response Content for the impact of a device profile change
+type DeviceProfileImpactResponse struct {
+	common.BaseResponse `json:",inline"`
+	Impact              dtos.DeviceProfileImpact `json:"impact"`
+}
+
+func NewDeviceProfileImpactResponse(requestId string, message string, statusCode int, impact dtos.DeviceProfileImpact) DeviceProfileImpactResponse {
+	return DeviceProfileImpactResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Impact:       impact,
+	}
+}
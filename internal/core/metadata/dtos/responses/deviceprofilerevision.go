@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// MultiDeviceProfileRevisionsResponse defines the Response Content for GET multiple DeviceProfileRevision DTOs
+type MultiDeviceProfileRevisionsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Revisions                         []dtos.DeviceProfileRevision `json:"revisions"`
+}
+
+func NewMultiDeviceProfileRevisionsResponse(requestId string, message string, statusCode int, totalCount uint32, revisions []dtos.DeviceProfileRevision) MultiDeviceProfileRevisionsResponse {
+	return MultiDeviceProfileRevisionsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Revisions:                  revisions,
+	}
+}
+
+// DeviceProfileDiffResponse defines the Response Content for GET the diff of two device profile revisions
+type DeviceProfileDiffResponse struct {
+	common.BaseResponse `json:",inline"`
+	Diff                dtos.DeviceProfileDiff `json:"diff"`
+}
+
+func NewDeviceProfileDiffResponse(requestId string, message string, statusCode int, diff dtos.DeviceProfileDiff) DeviceProfileDiffResponse {
+	return DeviceProfileDiffResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Diff:         diff,
+	}
+}
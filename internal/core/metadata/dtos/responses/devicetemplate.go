@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// DeviceTemplateResponse defines the Response Content for GET device template
+type DeviceTemplateResponse struct {
+	common.BaseResponse `json:",inline"`
+	Template            dtos.DeviceTemplate `json:"template"`
+}
+
+func NewDeviceTemplateResponse(requestId string, message string, statusCode int, template dtos.DeviceTemplate) DeviceTemplateResponse {
+	return DeviceTemplateResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Template:     template,
+	}
+}
+
+// MultiDeviceTemplatesResponse defines the Response Content for GET multiple device templates
+type MultiDeviceTemplatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Templates                         []dtos.DeviceTemplate `json:"templates"`
+}
+
+func NewMultiDeviceTemplatesResponse(requestId string, message string, statusCode int, totalCount uint32, templates []dtos.DeviceTemplate) MultiDeviceTemplatesResponse {
+	return MultiDeviceTemplatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Templates:                  templates,
+	}
+}
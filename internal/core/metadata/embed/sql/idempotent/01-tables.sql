@@ -26,3 +26,21 @@ CREATE TABLE IF NOT EXISTS core_metadata.provision_watcher (
     id UUID PRIMARY KEY,
     content JSONB NOT NULL
 );
+
+-- core_metadata.device_profile_revision is used to store the revision history of the device profiles
+CREATE TABLE IF NOT EXISTS core_metadata.device_profile_revision (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- core_metadata.audit_record is used to store the audit records of the changes made to the metadata
+CREATE TABLE IF NOT EXISTS core_metadata.audit_record (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- core_metadata.device_template is used to store the device templates carrying the default fields of the devices
+CREATE TABLE IF NOT EXISTS core_metadata.device_template (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
@@ -0,0 +1,11 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- revision is increased by every update of the entity and is used as the ETag for the optimistic concurrency control,
+-- the existing entities start from revision 1
+ALTER TABLE core_metadata.device_service ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE core_metadata.device_profile ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE core_metadata.device ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE core_metadata.provision_watcher ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,12 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- idx_device_name_pattern is used by the device search to match the devices by name prefix
+CREATE INDEX IF NOT EXISTS idx_device_name_pattern
+    ON core_metadata.device((content->>'Name') text_pattern_ops);
+
+-- idx_device_labels is used by the device search to match the label expression of all, any and none of the labels
+CREATE INDEX IF NOT EXISTS idx_device_labels
+    ON core_metadata.device USING GIN ((content->'Labels'));
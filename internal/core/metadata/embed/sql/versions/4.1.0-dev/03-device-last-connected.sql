@@ -0,0 +1,12 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- last_connected is the time in milliseconds the operating state of the device last changed to UP, which is NULL if the
+-- device has never connected since the column is introduced.
+ALTER TABLE core_metadata.device ADD COLUMN IF NOT EXISTS last_connected BIGINT;
+
+-- idx_device_last_connected is used by the device search to match the devices by the time range they last connected
+CREATE INDEX IF NOT EXISTS idx_device_last_connected
+    ON core_metadata.device(last_connected);
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2022 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,17 +8,19 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 )
 
 type DBClient interface {
 	CloseSession()
 
-	AddDeviceProfile(e model.DeviceProfile) (model.DeviceProfile, errors.EdgeX)
-	UpdateDeviceProfile(e model.DeviceProfile) errors.EdgeX
+	AddDeviceProfile(e model.DeviceProfile, maxRevisions uint32) (model.DeviceProfile, errors.EdgeX)
+	UpdateDeviceProfile(e model.DeviceProfile, ifRevision uint64, maxRevisions uint32) errors.EdgeX
 	DeviceProfileById(id string) (model.DeviceProfile, errors.EdgeX)
 	DeviceProfileByName(name string) (model.DeviceProfile, errors.EdgeX)
 	DeleteDeviceProfileById(id string) errors.EdgeX
-	DeleteDeviceProfileByName(name string) errors.EdgeX
+	DeleteDeviceProfileByName(name string, ifRevision uint64) errors.EdgeX
 	DeviceProfileNameExists(name string) (bool, errors.EdgeX)
 	AllDeviceProfiles(offset int, limit int, labels []string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX)
@@ -30,19 +32,23 @@ type DBClient interface {
 	DeviceProfileCountByManufacturerAndModel(manufacturer string, model string) (uint32, errors.EdgeX)
 	InUseResourceCount() (uint32, errors.EdgeX)
 
+	DeviceProfileRevisionsByProfileName(offset int, limit int, profileName string) ([]models.DeviceProfileRevision, errors.EdgeX)
+	DeviceProfileRevisionCountByProfileName(profileName string) (uint32, errors.EdgeX)
+	DeviceProfileRevisionByProfileNameAndRevision(profileName string, revision uint64) (models.DeviceProfileRevision, errors.EdgeX)
+
 	AddDeviceService(ds model.DeviceService) (model.DeviceService, errors.EdgeX)
 	DeviceServiceById(id string) (model.DeviceService, errors.EdgeX)
 	DeviceServiceByName(name string) (model.DeviceService, errors.EdgeX)
 	DeleteDeviceServiceById(id string) errors.EdgeX
-	DeleteDeviceServiceByName(name string) errors.EdgeX
+	DeleteDeviceServiceByName(name string, ifRevision uint64) errors.EdgeX
 	DeviceServiceNameExists(name string) (bool, errors.EdgeX)
 	AllDeviceServices(offset int, limit int, labels []string) ([]model.DeviceService, errors.EdgeX)
-	UpdateDeviceService(ds model.DeviceService) errors.EdgeX
+	UpdateDeviceService(ds model.DeviceService, ifRevision uint64) errors.EdgeX
 	DeviceServiceCountByLabels(labels []string) (uint32, errors.EdgeX)
 
 	AddDevice(d model.Device) (model.Device, errors.EdgeX)
 	DeleteDeviceById(id string) errors.EdgeX
-	DeleteDeviceByName(name string) errors.EdgeX
+	DeleteDeviceByName(name string, ifRevision uint64) errors.EdgeX
 	DevicesByServiceName(offset int, limit int, name string) ([]model.Device, errors.EdgeX)
 	DeviceIdExists(id string) (bool, errors.EdgeX)
 	DeviceNameExists(name string) (bool, errors.EdgeX)
@@ -50,20 +56,41 @@ type DBClient interface {
 	DeviceByName(name string) (model.Device, errors.EdgeX)
 	AllDevices(offset int, limit int, labels []string) ([]model.Device, errors.EdgeX)
 	DevicesByProfileName(offset int, limit int, profileName string) ([]model.Device, errors.EdgeX)
-	UpdateDevice(d model.Device) errors.EdgeX
+	UpdateDevice(d model.Device, ifRevision uint64) errors.EdgeX
 	DeviceCountByLabels(labels []string) (uint32, errors.EdgeX)
 	DeviceCountByProfileName(profileName string) (uint32, errors.EdgeX)
 	DeviceCountByServiceName(serviceName string) (uint32, errors.EdgeX)
 	DeviceTree(parent string, levels int, offset int, limit int, labels []string) (uint32, []model.Device, errors.EdgeX)
+	DeviceAncestors(name string) ([]model.Device, errors.EdgeX)
+	DevicesByQuery(query models.DeviceQuery) (uint32, []model.Device, errors.EdgeX)
+	AddDevices(ds []model.Device) ([]model.Device, errors.EdgeX)
+	UpdateDevices(ds []model.Device) errors.EdgeX
+	DeleteDevicesByNames(names []string, ifRevisions map[string]uint64) errors.EdgeX
+	DevicesByTemplateName(templateName string) ([]model.Device, errors.EdgeX)
+	DeviceCountByTemplateName(templateName string) (uint32, errors.EdgeX)
 	AddProvisionWatcher(pw model.ProvisionWatcher) (model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatcherById(id string) (model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatcherByName(name string) (model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatchersByServiceName(offset int, limit int, name string) ([]model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatchersByProfileName(offset int, limit int, name string) ([]model.ProvisionWatcher, errors.EdgeX)
 	AllProvisionWatchers(offset int, limit int, labels []string) ([]model.ProvisionWatcher, errors.EdgeX)
-	DeleteProvisionWatcherByName(name string) errors.EdgeX
-	UpdateProvisionWatcher(pw model.ProvisionWatcher) errors.EdgeX
+	DeleteProvisionWatcherByName(name string, ifRevision uint64) errors.EdgeX
+	UpdateProvisionWatcher(pw model.ProvisionWatcher, ifRevision uint64) errors.EdgeX
 	ProvisionWatcherCountByLabels(labels []string) (uint32, errors.EdgeX)
 	ProvisionWatcherCountByServiceName(name string) (uint32, errors.EdgeX)
 	ProvisionWatcherCountByProfileName(name string) (uint32, errors.EdgeX)
+
+	AddDeviceTemplate(t models.DeviceTemplate) (models.DeviceTemplate, errors.EdgeX)
+	UpdateDeviceTemplate(t models.DeviceTemplate, linkedDevices []model.Device) errors.EdgeX
+	DeviceTemplateByName(name string) (models.DeviceTemplate, errors.EdgeX)
+	AllDeviceTemplates(offset int, limit int) ([]models.DeviceTemplate, errors.EdgeX)
+	DeviceTemplateCount() (uint32, errors.EdgeX)
+	DeleteDeviceTemplateByName(name string) errors.EdgeX
+
+	AddAuditRecord(r models.AuditRecord) (models.AuditRecord, errors.EdgeX)
+	AuditRecords(query models.AuditRecordQuery) ([]models.AuditRecord, errors.EdgeX)
+	AuditRecordCount(query models.AuditRecordQuery) (uint32, errors.EdgeX)
+	DeleteAuditRecordsByAge(age int64) errors.EdgeX
+
+	EntityRevision(entityType string, name string) (uint64, errors.EdgeX)
 }
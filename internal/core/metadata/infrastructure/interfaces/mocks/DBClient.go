@@ -1,4 +1,4 @@
-// Code generated by mockery v2.49.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -7,7 +7,9 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	models "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	v4models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -15,26 +17,56 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddAuditRecord provides a mock function with given fields: r
+func (_m *DBClient) AddAuditRecord(r models.AuditRecord) (models.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditRecord")
+	}
+
+	var r0 models.AuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.AuditRecord) (models.AuditRecord, errors.EdgeX)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(models.AuditRecord) models.AuditRecord); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(models.AuditRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.AuditRecord) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDevice provides a mock function with given fields: d
-func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
+func (_m *DBClient) AddDevice(d v4models.Device) (v4models.Device, errors.EdgeX) {
 	ret := _m.Called(d)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddDevice")
 	}
 
-	var r0 models.Device
+	var r0 v4models.Device
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.Device) (models.Device, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(v4models.Device) (v4models.Device, errors.EdgeX)); ok {
 		return rf(d)
 	}
-	if rf, ok := ret.Get(0).(func(models.Device) models.Device); ok {
+	if rf, ok := ret.Get(0).(func(v4models.Device) v4models.Device); ok {
 		r0 = rf(d)
 	} else {
-		r0 = ret.Get(0).(models.Device)
+		r0 = ret.Get(0).(v4models.Device)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Device) errors.EdgeX); ok {
+	if rf, ok := ret.Get(1).(func(v4models.Device) errors.EdgeX); ok {
 		r1 = rf(d)
 	} else {
 		if ret.Get(1) != nil {
@@ -45,27 +77,27 @@ func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
 	return r0, r1
 }
 
-// AddDeviceProfile provides a mock function with given fields: e
-func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
-	ret := _m.Called(e)
+// AddDeviceProfile provides a mock function with given fields: e, maxRevisions
+func (_m *DBClient) AddDeviceProfile(e v4models.DeviceProfile, maxRevisions uint32) (v4models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(e, maxRevisions)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddDeviceProfile")
 	}
 
-	var r0 models.DeviceProfile
+	var r0 v4models.DeviceProfile
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.DeviceProfile) (models.DeviceProfile, errors.EdgeX)); ok {
-		return rf(e)
+	if rf, ok := ret.Get(0).(func(v4models.DeviceProfile, uint32) (v4models.DeviceProfile, errors.EdgeX)); ok {
+		return rf(e, maxRevisions)
 	}
-	if rf, ok := ret.Get(0).(func(models.DeviceProfile) models.DeviceProfile); ok {
-		r0 = rf(e)
+	if rf, ok := ret.Get(0).(func(v4models.DeviceProfile, uint32) v4models.DeviceProfile); ok {
+		r0 = rf(e, maxRevisions)
 	} else {
-		r0 = ret.Get(0).(models.DeviceProfile)
+		r0 = ret.Get(0).(v4models.DeviceProfile)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.DeviceProfile) errors.EdgeX); ok {
-		r1 = rf(e)
+	if rf, ok := ret.Get(1).(func(v4models.DeviceProfile, uint32) errors.EdgeX); ok {
+		r1 = rf(e, maxRevisions)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -76,25 +108,87 @@ func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfi
 }
 
 // AddDeviceService provides a mock function with given fields: ds
-func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceService, errors.EdgeX) {
+func (_m *DBClient) AddDeviceService(ds v4models.DeviceService) (v4models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(ds)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddDeviceService")
 	}
 
-	var r0 models.DeviceService
+	var r0 v4models.DeviceService
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.DeviceService) (models.DeviceService, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(v4models.DeviceService) (v4models.DeviceService, errors.EdgeX)); ok {
 		return rf(ds)
 	}
-	if rf, ok := ret.Get(0).(func(models.DeviceService) models.DeviceService); ok {
+	if rf, ok := ret.Get(0).(func(v4models.DeviceService) v4models.DeviceService); ok {
 		r0 = rf(ds)
 	} else {
-		r0 = ret.Get(0).(models.DeviceService)
+		r0 = ret.Get(0).(v4models.DeviceService)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.DeviceService) errors.EdgeX); ok {
+	if rf, ok := ret.Get(1).(func(v4models.DeviceService) errors.EdgeX); ok {
+		r1 = rf(ds)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDeviceTemplate provides a mock function with given fields: t
+func (_m *DBClient) AddDeviceTemplate(t models.DeviceTemplate) (models.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeviceTemplate")
+	}
+
+	var r0 models.DeviceTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.DeviceTemplate) (models.DeviceTemplate, errors.EdgeX)); ok {
+		return rf(t)
+	}
+	if rf, ok := ret.Get(0).(func(models.DeviceTemplate) models.DeviceTemplate); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(models.DeviceTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.DeviceTemplate) errors.EdgeX); ok {
+		r1 = rf(t)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDevices provides a mock function with given fields: ds
+func (_m *DBClient) AddDevices(ds []v4models.Device) ([]v4models.Device, errors.EdgeX) {
+	ret := _m.Called(ds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDevices")
+	}
+
+	var r0 []v4models.Device
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]v4models.Device) ([]v4models.Device, errors.EdgeX)); ok {
+		return rf(ds)
+	}
+	if rf, ok := ret.Get(0).(func([]v4models.Device) []v4models.Device); ok {
+		r0 = rf(ds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Device)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]v4models.Device) errors.EdgeX); ok {
 		r1 = rf(ds)
 	} else {
 		if ret.Get(1) != nil {
@@ -106,25 +200,25 @@ func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceServ
 }
 
 // AddProvisionWatcher provides a mock function with given fields: pw
-func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.ProvisionWatcher, errors.EdgeX) {
+func (_m *DBClient) AddProvisionWatcher(pw v4models.ProvisionWatcher) (v4models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(pw)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddProvisionWatcher")
 	}
 
-	var r0 models.ProvisionWatcher
+	var r0 v4models.ProvisionWatcher
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.ProvisionWatcher) (models.ProvisionWatcher, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(v4models.ProvisionWatcher) (v4models.ProvisionWatcher, errors.EdgeX)); ok {
 		return rf(pw)
 	}
-	if rf, ok := ret.Get(0).(func(models.ProvisionWatcher) models.ProvisionWatcher); ok {
+	if rf, ok := ret.Get(0).(func(v4models.ProvisionWatcher) v4models.ProvisionWatcher); ok {
 		r0 = rf(pw)
 	} else {
-		r0 = ret.Get(0).(models.ProvisionWatcher)
+		r0 = ret.Get(0).(v4models.ProvisionWatcher)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.ProvisionWatcher) errors.EdgeX); ok {
+	if rf, ok := ret.Get(1).(func(v4models.ProvisionWatcher) errors.EdgeX); ok {
 		r1 = rf(pw)
 	} else {
 		if ret.Get(1) != nil {
@@ -136,23 +230,23 @@ func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.Prov
 }
 
 // AllDeviceProfiles provides a mock function with given fields: offset, limit, labels
-func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([]models.DeviceProfile, errors.EdgeX) {
+func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([]v4models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AllDeviceProfiles")
 	}
 
-	var r0 []models.DeviceProfile
+	var r0 []v4models.DeviceProfile
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, []string) ([]models.DeviceProfile, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) ([]v4models.DeviceProfile, errors.EdgeX)); ok {
 		return rf(offset, limit, labels)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, []string) []models.DeviceProfile); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) []v4models.DeviceProfile); ok {
 		r0 = rf(offset, limit, labels)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceProfile)
+			r0 = ret.Get(0).([]v4models.DeviceProfile)
 		}
 	}
 
@@ -168,23 +262,23 @@ func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([
 }
 
 // AllDeviceServices provides a mock function with given fields: offset, limit, labels
-func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([]models.DeviceService, errors.EdgeX) {
+func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([]v4models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AllDeviceServices")
 	}
 
-	var r0 []models.DeviceService
+	var r0 []v4models.DeviceService
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, []string) ([]models.DeviceService, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) ([]v4models.DeviceService, errors.EdgeX)); ok {
 		return rf(offset, limit, labels)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, []string) []models.DeviceService); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) []v4models.DeviceService); ok {
 		r0 = rf(offset, limit, labels)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceService)
+			r0 = ret.Get(0).([]v4models.DeviceService)
 		}
 	}
 
@@ -199,24 +293,56 @@ func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([
 	return r0, r1
 }
 
+// AllDeviceTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceTemplates(offset int, limit int) ([]models.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllDeviceTemplates")
+	}
+
+	var r0 []models.DeviceTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]models.DeviceTemplate, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []models.DeviceTemplate); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDevices provides a mock function with given fields: offset, limit, labels
-func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models.Device, errors.EdgeX) {
+func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]v4models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AllDevices")
 	}
 
-	var r0 []models.Device
+	var r0 []v4models.Device
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, []string) ([]models.Device, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) ([]v4models.Device, errors.EdgeX)); ok {
 		return rf(offset, limit, labels)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, []string) []models.Device); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) []v4models.Device); ok {
 		r0 = rf(offset, limit, labels)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Device)
+			r0 = ret.Get(0).([]v4models.Device)
 		}
 	}
 
@@ -232,23 +358,23 @@ func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models
 }
 
 // AllProvisionWatchers provides a mock function with given fields: offset, limit, labels
-func (_m *DBClient) AllProvisionWatchers(offset int, limit int, labels []string) ([]models.ProvisionWatcher, errors.EdgeX) {
+func (_m *DBClient) AllProvisionWatchers(offset int, limit int, labels []string) ([]v4models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AllProvisionWatchers")
 	}
 
-	var r0 []models.ProvisionWatcher
+	var r0 []v4models.ProvisionWatcher
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, []string) ([]models.ProvisionWatcher, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) ([]v4models.ProvisionWatcher, errors.EdgeX)); ok {
 		return rf(offset, limit, labels)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, []string) []models.ProvisionWatcher); ok {
+	if rf, ok := ret.Get(0).(func(int, int, []string) []v4models.ProvisionWatcher); ok {
 		r0 = rf(offset, limit, labels)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ProvisionWatcher)
+			r0 = ret.Get(0).([]v4models.ProvisionWatcher)
 		}
 	}
 
@@ -263,11 +389,93 @@ func (_m *DBClient) AllProvisionWatchers(offset int, limit int, labels []string)
 	return r0, r1
 }
 
-// CloseSession provides a mock function with given fields:
+// AuditRecordCount provides a mock function with given fields: query
+func (_m *DBClient) AuditRecordCount(query models.AuditRecordQuery) (uint32, errors.EdgeX) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditRecordCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.AuditRecordQuery) (uint32, errors.EdgeX)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(models.AuditRecordQuery) uint32); ok {
+		r0 = rf(query)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.AuditRecordQuery) errors.EdgeX); ok {
+		r1 = rf(query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuditRecords provides a mock function with given fields: query
+func (_m *DBClient) AuditRecords(query models.AuditRecordQuery) ([]models.AuditRecord, errors.EdgeX) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditRecords")
+	}
+
+	var r0 []models.AuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.AuditRecordQuery) ([]models.AuditRecord, errors.EdgeX)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(models.AuditRecordQuery) []models.AuditRecord); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.AuditRecordQuery) errors.EdgeX); ok {
+		r1 = rf(query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CloseSession provides a mock function with no fields
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteAuditRecordsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteAuditRecordsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAuditRecordsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteDeviceById provides a mock function with given fields: id
 func (_m *DBClient) DeleteDeviceById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -288,17 +496,17 @@ func (_m *DBClient) DeleteDeviceById(id string) errors.EdgeX {
 	return r0
 }
 
-// DeleteDeviceByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteDeviceByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteDeviceByName provides a mock function with given fields: name, ifRevision
+func (_m *DBClient) DeleteDeviceByName(name string, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(name, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteDeviceByName")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, uint64) errors.EdgeX); ok {
+		r0 = rf(name, ifRevision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -328,17 +536,17 @@ func (_m *DBClient) DeleteDeviceProfileById(id string) errors.EdgeX {
 	return r0
 }
 
-// DeleteDeviceProfileByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteDeviceProfileByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteDeviceProfileByName provides a mock function with given fields: name, ifRevision
+func (_m *DBClient) DeleteDeviceProfileByName(name string, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(name, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteDeviceProfileByName")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, uint64) errors.EdgeX); ok {
+		r0 = rf(name, ifRevision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -368,17 +576,17 @@ func (_m *DBClient) DeleteDeviceServiceById(id string) errors.EdgeX {
 	return r0
 }
 
-// DeleteDeviceServiceByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteDeviceServiceByName provides a mock function with given fields: name, ifRevision
+func (_m *DBClient) DeleteDeviceServiceByName(name string, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(name, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteDeviceServiceByName")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, uint64) errors.EdgeX); ok {
+		r0 = rf(name, ifRevision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -388,12 +596,12 @@ func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
 	return r0
 }
 
-// DeleteProvisionWatcherByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteProvisionWatcherByName(name string) errors.EdgeX {
+// DeleteDeviceTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceTemplateByName(name string) errors.EdgeX {
 	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteProvisionWatcherByName")
+		panic("no return value specified for DeleteDeviceTemplateByName")
 	}
 
 	var r0 errors.EdgeX
@@ -408,23 +616,95 @@ func (_m *DBClient) DeleteProvisionWatcherByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteDevicesByNames provides a mock function with given fields: names, ifRevisions
+func (_m *DBClient) DeleteDevicesByNames(names []string, ifRevisions map[string]uint64) errors.EdgeX {
+	ret := _m.Called(names, ifRevisions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDevicesByNames")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]string, map[string]uint64) errors.EdgeX); ok {
+		r0 = rf(names, ifRevisions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteProvisionWatcherByName provides a mock function with given fields: name, ifRevision
+func (_m *DBClient) DeleteProvisionWatcherByName(name string, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(name, ifRevision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProvisionWatcherByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, uint64) errors.EdgeX); ok {
+		r0 = rf(name, ifRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeviceAncestors provides a mock function with given fields: name
+func (_m *DBClient) DeviceAncestors(name string) ([]v4models.Device, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceAncestors")
+	}
+
+	var r0 []v4models.Device
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) ([]v4models.Device, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []v4models.Device); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Device)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceById provides a mock function with given fields: id
-func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
+func (_m *DBClient) DeviceById(id string) (v4models.Device, errors.EdgeX) {
 	ret := _m.Called(id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceById")
 	}
 
-	var r0 models.Device
+	var r0 v4models.Device
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.Device, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.Device, errors.EdgeX)); ok {
 		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.Device); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.Device); ok {
 		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(models.Device)
+		r0 = ret.Get(0).(v4models.Device)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -439,22 +719,22 @@ func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
 }
 
 // DeviceByName provides a mock function with given fields: name
-func (_m *DBClient) DeviceByName(name string) (models.Device, errors.EdgeX) {
+func (_m *DBClient) DeviceByName(name string) (v4models.Device, errors.EdgeX) {
 	ret := _m.Called(name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceByName")
 	}
 
-	var r0 models.Device
+	var r0 v4models.Device
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.Device, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.Device, errors.EdgeX)); ok {
 		return rf(name)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.Device); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.Device); ok {
 		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.Device)
+		r0 = ret.Get(0).(v4models.Device)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -558,6 +838,36 @@ func (_m *DBClient) DeviceCountByServiceName(serviceName string) (uint32, errors
 	return r0, r1
 }
 
+// DeviceCountByTemplateName provides a mock function with given fields: templateName
+func (_m *DBClient) DeviceCountByTemplateName(templateName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(templateName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceCountByTemplateName")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint32, errors.EdgeX)); ok {
+		return rf(templateName)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(templateName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(templateName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceIdExists provides a mock function with given fields: id
 func (_m *DBClient) DeviceIdExists(id string) (bool, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -619,22 +929,22 @@ func (_m *DBClient) DeviceNameExists(name string) (bool, errors.EdgeX) {
 }
 
 // DeviceProfileById provides a mock function with given fields: id
-func (_m *DBClient) DeviceProfileById(id string) (models.DeviceProfile, errors.EdgeX) {
+func (_m *DBClient) DeviceProfileById(id string) (v4models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceProfileById")
 	}
 
-	var r0 models.DeviceProfile
+	var r0 v4models.DeviceProfile
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.DeviceProfile, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.DeviceProfile, errors.EdgeX)); ok {
 		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.DeviceProfile); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.DeviceProfile); ok {
 		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(models.DeviceProfile)
+		r0 = ret.Get(0).(v4models.DeviceProfile)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -649,22 +959,22 @@ func (_m *DBClient) DeviceProfileById(id string) (models.DeviceProfile, errors.E
 }
 
 // DeviceProfileByName provides a mock function with given fields: name
-func (_m *DBClient) DeviceProfileByName(name string) (models.DeviceProfile, errors.EdgeX) {
+func (_m *DBClient) DeviceProfileByName(name string) (v4models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceProfileByName")
 	}
 
-	var r0 models.DeviceProfile
+	var r0 v4models.DeviceProfile
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.DeviceProfile, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.DeviceProfile, errors.EdgeX)); ok {
 		return rf(name)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.DeviceProfile); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.DeviceProfile); ok {
 		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.DeviceProfile)
+		r0 = ret.Get(0).(v4models.DeviceProfile)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -828,24 +1138,116 @@ func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceProfileRevisionByProfileNameAndRevision provides a mock function with given fields: profileName, revision
+func (_m *DBClient) DeviceProfileRevisionByProfileNameAndRevision(profileName string, revision uint64) (models.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(profileName, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfileRevisionByProfileNameAndRevision")
+	}
+
+	var r0 models.DeviceProfileRevision
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, uint64) (models.DeviceProfileRevision, errors.EdgeX)); ok {
+		return rf(profileName, revision)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint64) models.DeviceProfileRevision); ok {
+		r0 = rf(profileName, revision)
+	} else {
+		r0 = ret.Get(0).(models.DeviceProfileRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint64) errors.EdgeX); ok {
+		r1 = rf(profileName, revision)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileRevisionCountByProfileName provides a mock function with given fields: profileName
+func (_m *DBClient) DeviceProfileRevisionCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(profileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfileRevisionCountByProfileName")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint32, errors.EdgeX)); ok {
+		return rf(profileName)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(profileName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileRevisionsByProfileName provides a mock function with given fields: offset, limit, profileName
+func (_m *DBClient) DeviceProfileRevisionsByProfileName(offset int, limit int, profileName string) ([]models.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(offset, limit, profileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfileRevisionsByProfileName")
+	}
+
+	var r0 []models.DeviceProfileRevision
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.DeviceProfileRevision, errors.EdgeX)); ok {
+		return rf(offset, limit, profileName)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfileRevision); ok {
+		r0 = rf(offset, limit, profileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfileRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
-func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
+func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]v4models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, manufacturer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceProfilesByManufacturer")
 	}
 
-	var r0 []models.DeviceProfile
+	var r0 []v4models.DeviceProfile
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.DeviceProfile, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.DeviceProfile, errors.EdgeX)); ok {
 		return rf(offset, limit, manufacturer)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.DeviceProfile); ok {
 		r0 = rf(offset, limit, manufacturer)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceProfile)
+			r0 = ret.Get(0).([]v4models.DeviceProfile)
 		}
 	}
 
@@ -861,23 +1263,23 @@ func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufact
 }
 
 // DeviceProfilesByManufacturerAndModel provides a mock function with given fields: offset, limit, manufacturer, model
-func (_m *DBClient) DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer string, model string) ([]models.DeviceProfile, errors.EdgeX) {
+func (_m *DBClient) DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer string, model string) ([]v4models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, manufacturer, model)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceProfilesByManufacturerAndModel")
 	}
 
-	var r0 []models.DeviceProfile
+	var r0 []v4models.DeviceProfile
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]models.DeviceProfile, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]v4models.DeviceProfile, errors.EdgeX)); ok {
 		return rf(offset, limit, manufacturer, model)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string, string) []models.DeviceProfile); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []v4models.DeviceProfile); ok {
 		r0 = rf(offset, limit, manufacturer, model)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceProfile)
+			r0 = ret.Get(0).([]v4models.DeviceProfile)
 		}
 	}
 
@@ -893,23 +1295,23 @@ func (_m *DBClient) DeviceProfilesByManufacturerAndModel(offset int, limit int,
 }
 
 // DeviceProfilesByModel provides a mock function with given fields: offset, limit, model
-func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) ([]models.DeviceProfile, errors.EdgeX) {
+func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) ([]v4models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, model)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceProfilesByModel")
 	}
 
-	var r0 []models.DeviceProfile
+	var r0 []v4models.DeviceProfile
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.DeviceProfile, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.DeviceProfile, errors.EdgeX)); ok {
 		return rf(offset, limit, model)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.DeviceProfile); ok {
 		r0 = rf(offset, limit, model)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DeviceProfile)
+			r0 = ret.Get(0).([]v4models.DeviceProfile)
 		}
 	}
 
@@ -925,22 +1327,22 @@ func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) (
 }
 
 // DeviceServiceById provides a mock function with given fields: id
-func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.EdgeX) {
+func (_m *DBClient) DeviceServiceById(id string) (v4models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceServiceById")
 	}
 
-	var r0 models.DeviceService
+	var r0 v4models.DeviceService
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.DeviceService, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.DeviceService, errors.EdgeX)); ok {
 		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.DeviceService); ok {
 		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(models.DeviceService)
+		r0 = ret.Get(0).(v4models.DeviceService)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -955,22 +1357,22 @@ func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.E
 }
 
 // DeviceServiceByName provides a mock function with given fields: name
-func (_m *DBClient) DeviceServiceByName(name string) (models.DeviceService, errors.EdgeX) {
+func (_m *DBClient) DeviceServiceByName(name string) (v4models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeviceServiceByName")
 	}
 
-	var r0 models.DeviceService
+	var r0 v4models.DeviceService
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.DeviceService, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.DeviceService, errors.EdgeX)); ok {
 		return rf(name)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.DeviceService); ok {
 		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.DeviceService)
+		r0 = ret.Get(0).(v4models.DeviceService)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -1044,8 +1446,68 @@ func (_m *DBClient) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceTemplateByName(name string) (models.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceTemplateByName")
+	}
+
+	var r0 models.DeviceTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (models.DeviceTemplate, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.DeviceTemplate); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(models.DeviceTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceTemplateCount provides a mock function with no fields
+func (_m *DBClient) DeviceTemplateCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceTemplateCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (uint32, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceTree provides a mock function with given fields: parent, levels, offset, limit, labels
-func (_m *DBClient) DeviceTree(parent string, levels int, offset int, limit int, labels []string) (uint32, []models.Device, errors.EdgeX) {
+func (_m *DBClient) DeviceTree(parent string, levels int, offset int, limit int, labels []string) (uint32, []v4models.Device, errors.EdgeX) {
 	ret := _m.Called(parent, levels, offset, limit, labels)
 
 	if len(ret) == 0 {
@@ -1053,9 +1515,9 @@ func (_m *DBClient) DeviceTree(parent string, levels int, offset int, limit int,
 	}
 
 	var r0 uint32
-	var r1 []models.Device
+	var r1 []v4models.Device
 	var r2 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string, int, int, int, []string) (uint32, []models.Device, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string, int, int, int, []string) (uint32, []v4models.Device, errors.EdgeX)); ok {
 		return rf(parent, levels, offset, limit, labels)
 	}
 	if rf, ok := ret.Get(0).(func(string, int, int, int, []string) uint32); ok {
@@ -1064,11 +1526,11 @@ func (_m *DBClient) DeviceTree(parent string, levels int, offset int, limit int,
 		r0 = ret.Get(0).(uint32)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int, int, int, []string) []models.Device); ok {
+	if rf, ok := ret.Get(1).(func(string, int, int, int, []string) []v4models.Device); ok {
 		r1 = rf(parent, levels, offset, limit, labels)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]models.Device)
+			r1 = ret.Get(1).([]v4models.Device)
 		}
 	}
 
@@ -1084,23 +1546,23 @@ func (_m *DBClient) DeviceTree(parent string, levels int, offset int, limit int,
 }
 
 // DevicesByProfileName provides a mock function with given fields: offset, limit, profileName
-func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName string) ([]models.Device, errors.EdgeX) {
+func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName string) ([]v4models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, profileName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DevicesByProfileName")
 	}
 
-	var r0 []models.Device
+	var r0 []v4models.Device
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.Device, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.Device, errors.EdgeX)); ok {
 		return rf(offset, limit, profileName)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.Device); ok {
 		r0 = rf(offset, limit, profileName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Device)
+			r0 = ret.Get(0).([]v4models.Device)
 		}
 	}
 
@@ -1115,24 +1577,63 @@ func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName stri
 	return r0, r1
 }
 
+// DevicesByQuery provides a mock function with given fields: query
+func (_m *DBClient) DevicesByQuery(query models.DeviceQuery) (uint32, []v4models.Device, errors.EdgeX) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DevicesByQuery")
+	}
+
+	var r0 uint32
+	var r1 []v4models.Device
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.DeviceQuery) (uint32, []v4models.Device, errors.EdgeX)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(models.DeviceQuery) uint32); ok {
+		r0 = rf(query)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.DeviceQuery) []v4models.Device); ok {
+		r1 = rf(query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]v4models.Device)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(models.DeviceQuery) errors.EdgeX); ok {
+		r2 = rf(query)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // DevicesByServiceName provides a mock function with given fields: offset, limit, name
-func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]models.Device, errors.EdgeX) {
+func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]v4models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DevicesByServiceName")
 	}
 
-	var r0 []models.Device
+	var r0 []v4models.Device
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.Device, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.Device, errors.EdgeX)); ok {
 		return rf(offset, limit, name)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.Device); ok {
 		r0 = rf(offset, limit, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Device)
+			r0 = ret.Get(0).([]v4models.Device)
 		}
 	}
 
@@ -1147,7 +1648,69 @@ func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]
 	return r0, r1
 }
 
-// InUseResourceCount provides a mock function with given fields:
+// DevicesByTemplateName provides a mock function with given fields: templateName
+func (_m *DBClient) DevicesByTemplateName(templateName string) ([]v4models.Device, errors.EdgeX) {
+	ret := _m.Called(templateName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DevicesByTemplateName")
+	}
+
+	var r0 []v4models.Device
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) ([]v4models.Device, errors.EdgeX)); ok {
+		return rf(templateName)
+	}
+	if rf, ok := ret.Get(0).(func(string) []v4models.Device); ok {
+		r0 = rf(templateName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v4models.Device)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(templateName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// EntityRevision provides a mock function with given fields: entityType, name
+func (_m *DBClient) EntityRevision(entityType string, name string) (uint64, errors.EdgeX) {
+	ret := _m.Called(entityType, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EntityRevision")
+	}
+
+	var r0 uint64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) (uint64, errors.EdgeX)); ok {
+		return rf(entityType, name)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) uint64); ok {
+		r0 = rf(entityType, name)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) errors.EdgeX); ok {
+		r1 = rf(entityType, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// InUseResourceCount provides a mock function with no fields
 func (_m *DBClient) InUseResourceCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
 
@@ -1178,22 +1741,22 @@ func (_m *DBClient) InUseResourceCount() (uint32, errors.EdgeX) {
 }
 
 // ProvisionWatcherById provides a mock function with given fields: id
-func (_m *DBClient) ProvisionWatcherById(id string) (models.ProvisionWatcher, errors.EdgeX) {
+func (_m *DBClient) ProvisionWatcherById(id string) (v4models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProvisionWatcherById")
 	}
 
-	var r0 models.ProvisionWatcher
+	var r0 v4models.ProvisionWatcher
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.ProvisionWatcher, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.ProvisionWatcher, errors.EdgeX)); ok {
 		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.ProvisionWatcher); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.ProvisionWatcher); ok {
 		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(models.ProvisionWatcher)
+		r0 = ret.Get(0).(v4models.ProvisionWatcher)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -1208,22 +1771,22 @@ func (_m *DBClient) ProvisionWatcherById(id string) (models.ProvisionWatcher, er
 }
 
 // ProvisionWatcherByName provides a mock function with given fields: name
-func (_m *DBClient) ProvisionWatcherByName(name string) (models.ProvisionWatcher, errors.EdgeX) {
+func (_m *DBClient) ProvisionWatcherByName(name string) (v4models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProvisionWatcherByName")
 	}
 
-	var r0 models.ProvisionWatcher
+	var r0 v4models.ProvisionWatcher
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) (models.ProvisionWatcher, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(string) (v4models.ProvisionWatcher, errors.EdgeX)); ok {
 		return rf(name)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.ProvisionWatcher); ok {
+	if rf, ok := ret.Get(0).(func(string) v4models.ProvisionWatcher); ok {
 		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.ProvisionWatcher)
+		r0 = ret.Get(0).(v4models.ProvisionWatcher)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
@@ -1328,23 +1891,23 @@ func (_m *DBClient) ProvisionWatcherCountByServiceName(name string) (uint32, err
 }
 
 // ProvisionWatchersByProfileName provides a mock function with given fields: offset, limit, name
-func (_m *DBClient) ProvisionWatchersByProfileName(offset int, limit int, name string) ([]models.ProvisionWatcher, errors.EdgeX) {
+func (_m *DBClient) ProvisionWatchersByProfileName(offset int, limit int, name string) ([]v4models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProvisionWatchersByProfileName")
 	}
 
-	var r0 []models.ProvisionWatcher
+	var r0 []v4models.ProvisionWatcher
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.ProvisionWatcher, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.ProvisionWatcher, errors.EdgeX)); ok {
 		return rf(offset, limit, name)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.ProvisionWatcher); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.ProvisionWatcher); ok {
 		r0 = rf(offset, limit, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ProvisionWatcher)
+			r0 = ret.Get(0).([]v4models.ProvisionWatcher)
 		}
 	}
 
@@ -1360,23 +1923,23 @@ func (_m *DBClient) ProvisionWatchersByProfileName(offset int, limit int, name s
 }
 
 // ProvisionWatchersByServiceName provides a mock function with given fields: offset, limit, name
-func (_m *DBClient) ProvisionWatchersByServiceName(offset int, limit int, name string) ([]models.ProvisionWatcher, errors.EdgeX) {
+func (_m *DBClient) ProvisionWatchersByServiceName(offset int, limit int, name string) ([]v4models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProvisionWatchersByServiceName")
 	}
 
-	var r0 []models.ProvisionWatcher
+	var r0 []v4models.ProvisionWatcher
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.ProvisionWatcher, errors.EdgeX)); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]v4models.ProvisionWatcher, errors.EdgeX)); ok {
 		return rf(offset, limit, name)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string) []models.ProvisionWatcher); ok {
+	if rf, ok := ret.Get(0).(func(int, int, string) []v4models.ProvisionWatcher); ok {
 		r0 = rf(offset, limit, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ProvisionWatcher)
+			r0 = ret.Get(0).([]v4models.ProvisionWatcher)
 		}
 	}
 
@@ -1391,17 +1954,17 @@ func (_m *DBClient) ProvisionWatchersByServiceName(offset int, limit int, name s
 	return r0, r1
 }
 
-// UpdateDevice provides a mock function with given fields: d
-func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
-	ret := _m.Called(d)
+// UpdateDevice provides a mock function with given fields: d, ifRevision
+func (_m *DBClient) UpdateDevice(d v4models.Device, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(d, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateDevice")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.Device) errors.EdgeX); ok {
-		r0 = rf(d)
+	if rf, ok := ret.Get(0).(func(v4models.Device, uint64) errors.EdgeX); ok {
+		r0 = rf(d, ifRevision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -1411,17 +1974,17 @@ func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
 	return r0
 }
 
-// UpdateDeviceProfile provides a mock function with given fields: e
-func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
-	ret := _m.Called(e)
+// UpdateDeviceProfile provides a mock function with given fields: e, ifRevision, maxRevisions
+func (_m *DBClient) UpdateDeviceProfile(e v4models.DeviceProfile, ifRevision uint64, maxRevisions uint32) errors.EdgeX {
+	ret := _m.Called(e, ifRevision, maxRevisions)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateDeviceProfile")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.DeviceProfile) errors.EdgeX); ok {
-		r0 = rf(e)
+	if rf, ok := ret.Get(0).(func(v4models.DeviceProfile, uint64, uint32) errors.EdgeX); ok {
+		r0 = rf(e, ifRevision, maxRevisions)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -1431,16 +1994,56 @@ func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
 	return r0
 }
 
-// UpdateDeviceService provides a mock function with given fields: ds
-func (_m *DBClient) UpdateDeviceService(ds models.DeviceService) errors.EdgeX {
-	ret := _m.Called(ds)
+// UpdateDeviceService provides a mock function with given fields: ds, ifRevision
+func (_m *DBClient) UpdateDeviceService(ds v4models.DeviceService, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(ds, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateDeviceService")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.DeviceService) errors.EdgeX); ok {
+	if rf, ok := ret.Get(0).(func(v4models.DeviceService, uint64) errors.EdgeX); ok {
+		r0 = rf(ds, ifRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDeviceTemplate provides a mock function with given fields: t, linkedDevices
+func (_m *DBClient) UpdateDeviceTemplate(t models.DeviceTemplate, linkedDevices []v4models.Device) errors.EdgeX {
+	ret := _m.Called(t, linkedDevices)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDeviceTemplate")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.DeviceTemplate, []v4models.Device) errors.EdgeX); ok {
+		r0 = rf(t, linkedDevices)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDevices provides a mock function with given fields: ds
+func (_m *DBClient) UpdateDevices(ds []v4models.Device) errors.EdgeX {
+	ret := _m.Called(ds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDevices")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]v4models.Device) errors.EdgeX); ok {
 		r0 = rf(ds)
 	} else {
 		if ret.Get(0) != nil {
@@ -1451,17 +2054,17 @@ func (_m *DBClient) UpdateDeviceService(ds models.DeviceService) errors.EdgeX {
 	return r0
 }
 
-// UpdateProvisionWatcher provides a mock function with given fields: pw
-func (_m *DBClient) UpdateProvisionWatcher(pw models.ProvisionWatcher) errors.EdgeX {
-	ret := _m.Called(pw)
+// UpdateProvisionWatcher provides a mock function with given fields: pw, ifRevision
+func (_m *DBClient) UpdateProvisionWatcher(pw v4models.ProvisionWatcher, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(pw, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateProvisionWatcher")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.ProvisionWatcher) errors.EdgeX); ok {
-		r0 = rf(pw)
+	if rf, ok := ret.Get(0).(func(v4models.ProvisionWatcher, uint64) errors.EdgeX); ok {
+		r0 = rf(pw, ifRevision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
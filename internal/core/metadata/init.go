@@ -20,9 +20,11 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
@@ -53,5 +55,10 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 			return capacityCheckLock
 		},
 	})
+
+	if err := application.AsyncPurgeAuditRecords(ctx, dic); err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("Failed to purge the audit records periodically, %v", err)
+		return false
+	}
 	return true
 }
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// AuditRecord is the record of a change made to a device, device profile, device service or provision watcher
+type AuditRecord struct {
+	Id string
+	// Actor is the identity of the JWT authenticating the HTTP request, it is empty if the request carries no JWT
+	Actor      string
+	Action     string
+	EntityType string
+	EntityName string
+	// Before and After are the JSON representations of the entity before and after the change, Before is nil for an
+	// added entity and After is nil for a deleted entity
+	Before        any
+	After         any
+	CorrelationId string
+	// Created is the time the change is made in milliseconds
+	Created int64
+}
+
+// AuditRecordQuery represents a page of the audit records sorted in descending order of creation
+type AuditRecordQuery struct {
+	// Actor, Action, EntityType and EntityName narrow the records to query, an empty value matches any record
+	Actor      string
+	Action     string
+	EntityType string
+	EntityName string
+	// Start and End are the inclusive creation time range of the records to query in milliseconds
+	Start  int64
+	End    int64
+	Offset int
+	Limit  int
+}
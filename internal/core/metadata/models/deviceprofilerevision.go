@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// DeviceProfileRevision is the snapshot of a device profile stored each time the profile is added or changed, the
+// revision number is the entity revision of the profile, which is also returned as the ETag of the profile
+type DeviceProfileRevision struct {
+	Id          string
+	ProfileName string
+	Revision    uint64
+	Profile     models.DeviceProfile
+	Created     int64
+}
@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeviceQuery represents a page of the devices matching all the filters of the query, an empty filter matches any device
+type DeviceQuery struct {
+	// NamePrefix matches the devices whose name starts with it
+	NamePrefix string
+	// AdminStates, OperatingStates, ProfileNames and ServiceNames match the devices having any of the values
+	AdminStates     []string
+	OperatingStates []string
+	ProfileNames    []string
+	ServiceNames    []string
+	// AllLabels, AnyLabels and NoLabels match the devices having all, any and none of the labels respectively
+	AllLabels []string
+	AnyLabels []string
+	NoLabels  []string
+	// ProtocolProperties matches the devices whose protocol properties have the values, which are keyed by the protocol
+	// name and then the property name
+	ProtocolProperties map[string]map[string]string
+	// LastConnectedFrom and LastConnectedTo match the devices last connected within the range in milliseconds, both
+	// bounds are inclusive and a zero bound is open. The devices never connected don't match any range.
+	LastConnectedFrom int64
+	LastConnectedTo   int64
+	// SortBy is the field to sort the devices by, which is one of the DeviceSortBy constants, and the devices of the same
+	// value are sorted by name
+	SortBy     string
+	Descending bool
+	Offset     int
+	Limit      int
+}
+
+// HasLastConnectedRange checks if the query filters the devices by the time they last connected
+func (q DeviceQuery) HasLastConnectedRange() bool {
+	return q.LastConnectedFrom > 0 || q.LastConnectedTo > 0
+}
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// DeviceTemplate carries the default fields of the devices repeating the same definition. A device is linked to the
+// template by the template name in its properties, and only overrides the fields it sets.
+type DeviceTemplate struct {
+	models.DBTimestamp
+	Id          string
+	Name        string
+	Description string
+	ProfileName string
+	Labels      []string
+	AutoEvents  []models.AutoEvent
+	Protocols   map[string]models.ProtocolProperties
+	Properties  map[string]any
+}
@@ -14,13 +14,16 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
 )
 
 func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	authenticationHook := handlers.AutoConfigAuthenticationFunc(dic)
+	r.Use(metadataController.AuditActor, utils.IfMatch)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -43,6 +46,9 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiDeviceProfileByManufacturerAndModelRoute, dc.DeviceProfilesByManufacturerAndModel, authenticationHook)
 	r.PATCH(common.ApiDeviceProfileBasicInfoRoute, dc.PatchDeviceProfileBasicInfo, authenticationHook)
 	r.GET(common.ApiAllDeviceProfileBasicInfoRoute, dc.AllDeviceProfileBasicInfos, authenticationHook)
+	r.GET(constants.ApiAllDeviceProfileRevisionRoute, dc.DeviceProfileRevisions, authenticationHook)
+	r.GET(constants.ApiDeviceProfileRevisionDiffRoute, dc.DiffDeviceProfileRevisions, authenticationHook)
+	r.POST(constants.ApiDeviceProfileRevisionRollbackRoute, dc.RollbackDeviceProfile, authenticationHook)
 
 	// Device Resource
 	dr := metadataController.NewDeviceResourceController(dic)
@@ -75,6 +81,22 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiAllDeviceRoute, d.AllDevices, authenticationHook)
 	r.GET(common.ApiDeviceByNameRoute, d.DeviceByName, authenticationHook)
 	r.GET(common.ApiDeviceByProfileNameRoute, d.DevicesByProfileName, authenticationHook)
+	r.POST(constants.ApiDeviceBulkRoute, d.AddDevices, authenticationHook)
+	r.PATCH(constants.ApiDeviceBulkRoute, d.UpdateDevices, authenticationHook)
+	r.DELETE(constants.ApiDeviceBulkRoute, d.DeleteDevices, authenticationHook)
+	r.POST(constants.ApiDeviceUploadFileRoute, d.UploadDevicesCsv, authenticationHook)
+	r.DELETE(constants.ApiDeviceSubtreeByNameRoute, d.DeleteDeviceSubtreeByName, authenticationHook)
+	r.PUT(constants.ApiDeviceSubtreeByNameRoute, d.MoveDevice, authenticationHook)
+	r.GET(constants.ApiDeviceAncestorsByNameRoute, d.DeviceAncestors, authenticationHook)
+	r.POST(constants.ApiDeviceSearchRoute, d.SearchDevices, authenticationHook)
+
+	// Device Template
+	dtc := metadataController.NewDeviceTemplateController(dic)
+	r.POST(constants.ApiDeviceTemplateRoute, dtc.AddDeviceTemplate, authenticationHook)
+	r.PUT(constants.ApiDeviceTemplateRoute, dtc.UpdateDeviceTemplate, authenticationHook)
+	r.GET(constants.ApiAllDeviceTemplateRoute, dtc.AllDeviceTemplates, authenticationHook)
+	r.GET(constants.ApiDeviceTemplateByNameRoute, dtc.DeviceTemplateByName, authenticationHook)
+	r.DELETE(constants.ApiDeviceTemplateByNameRoute, dtc.DeleteDeviceTemplateByName, authenticationHook)
 
 	// ProvisionWatcher
 	pwc := metadataController.NewProvisionWatcherController(dic)
@@ -85,4 +107,13 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiAllProvisionWatcherRoute, pwc.AllProvisionWatchers, authenticationHook)
 	r.DELETE(common.ApiProvisionWatcherByNameRoute, pwc.DeleteProvisionWatcherByName, authenticationHook)
 	r.PATCH(common.ApiProvisionWatcherRoute, pwc.PatchProvisionWatcher, authenticationHook)
+
+	// Metadata Bundle
+	bc := metadataController.NewBundleController(dic)
+	r.GET(constants.ApiBundleExportRoute, bc.ExportMetadataBundle, authenticationHook)
+	r.POST(constants.ApiBundleImportRoute, bc.ImportMetadataBundle, authenticationHook)
+
+	// Audit Log
+	ac := metadataController.NewAuditRecordController(dic)
+	r.GET(constants.ApiAllAuditRoute, ac.AuditRecords, authenticationHook)
 }
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// NumericValueTypes lists the reading value types whose values can be interpreted as a number
+var NumericValueTypes = []string{
+	common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+	common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+	common.ValueTypeFloat32, common.ValueTypeFloat64,
+}
+
+// IsNumericValueType checks whether the reading value type is one of the NumericValueTypes
+func IsNumericValueType(valueType string) bool {
+	return slices.Contains(NumericValueTypes, valueType)
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddAuditRecord adds a new audit record to the database, the Created time is kept if it is set
+func (c *Client) AddAuditRecord(r models.AuditRecord) (models.AuditRecord, errors.EdgeX) {
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+	if r.Created == 0 {
+		r.Created = pkgCommon.MakeTimestamp()
+	}
+	dataBytes, err := json.Marshal(r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal audit record for Postgres persistence", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(auditRecordTableName, idCol, contentCol), r.Id, dataBytes)
+	if err != nil {
+		return r, pgClient.WrapDBError("failed to insert row to core_metadata.audit_record table", err)
+	}
+	return r, nil
+}
+
+// AuditRecords queries the audit records matching the query in descending order of creation
+func (c *Client) AuditRecords(query models.AuditRecordQuery) ([]models.AuditRecord, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(query.Offset, query.Limit)
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentWithTimeRangeAndPaginationDesc(auditRecordTableName),
+		query.Start, query.End, auditRecordQueryObj(query), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from core_metadata.audit_record table", err)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.AuditRecord, error) {
+		var r models.AuditRecord
+		scanErr := row.Scan(&r)
+		return r, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to AuditRecord model", err)
+	}
+	return records, nil
+}
+
+// AuditRecordCount returns the count of the audit records matching the query, the offset and limit are ignored
+func (c *Client) AuditRecordCount(query models.AuditRecordQuery) (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByTimeRangeAndJSONField(auditRecordTableName), query.Start, query.End, auditRecordQueryObj(query))
+}
+
+// DeleteAuditRecordsByAge deletes the audit records created before the age in milliseconds
+func (c *Client) DeleteAuditRecordsByAge(age int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByContentAge(auditRecordTableName), age)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete audit records by age", err)
+	}
+	return nil
+}
+
+// auditRecordQueryObj returns the JSON query object matching the actor, action and entity of the query, an empty object
+// matches any record
+func auditRecordQueryObj(query models.AuditRecordQuery) map[string]any {
+	queryObj := map[string]any{}
+	if query.Actor != "" {
+		queryObj[actorField] = query.Actor
+	}
+	if query.Action != "" {
+		queryObj[actionField] = query.Action
+	}
+	if query.EntityType != "" {
+		queryObj[entityTypeField] = query.EntityType
+	}
+	if query.EntityName != "" {
+		queryObj[entityNameField] = query.EntityName
+	}
+	return queryObj
+}
@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddCommandJob adds a new command job to the database
+func (c *Client) AddCommandJob(ctx context.Context, j models.CommandJob) (models.CommandJob, errors.EdgeX) {
+	if len(j.Id) == 0 {
+		j.Id = uuid.New().String()
+	}
+
+	timestamp := time.Now().UTC().UnixMilli()
+	j.Created = timestamp
+	j.Modified = timestamp
+	dataBytes, err := json.Marshal(j)
+	if err != nil {
+		return j, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal CommandJob model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(commandJobTableName, idCol, contentCol), j.Id, dataBytes)
+	if err != nil {
+		return j, pgClient.WrapDBError("failed to insert row to core_command.job table", err)
+	}
+	return j, nil
+}
+
+// UpdateCommandJob updates the command job
+func (c *Client) UpdateCommandJob(ctx context.Context, j models.CommandJob) errors.EdgeX {
+	j.Modified = time.Now().UTC().UnixMilli()
+	dataBytes, err := json.Marshal(j)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal CommandJob model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlUpdateContentById(commandJobTableName), dataBytes, j.Id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row by command job id '%s' from core_command.job table", j.Id), err)
+	}
+	return nil
+}
+
+// CommandJobById queries the command job by id
+func (c *Client) CommandJobById(ctx context.Context, id string) (models.CommandJob, errors.EdgeX) {
+	var job models.CommandJob
+	err := c.ConnPool.QueryRow(ctx, sqlQueryContentById(commandJobTableName), id).Scan(&job)
+	if err != nil {
+		return job, pgClient.WrapDBError(fmt.Sprintf("failed to query command job by id '%s'", id), err)
+	}
+	return job, nil
+}
+
+// AllCommandJobs queries the command jobs with the given offset and limit
+func (c *Client) AllCommandJobs(ctx context.Context, offset, limit int) ([]models.CommandJob, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	jobs, err := queryCommandJobs(ctx, c.ConnPool, sqlQueryContentWithPagination(commandJobTableName), offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query all command jobs", err)
+	}
+	return jobs, nil
+}
+
+// CommandJobsByStatus queries the command jobs by status with the given offset and limit
+func (c *Client) CommandJobsByStatus(ctx context.Context, status string, offset, limit int) ([]models.CommandJob, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	queryObj := map[string]any{statusField: status}
+	jobs, err := queryCommandJobs(ctx, c.ConnPool, sqlQueryContentByJSONFieldWithPagination(commandJobTableName), queryObj, offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query command jobs by status '%s'", status), err)
+	}
+	return jobs, nil
+}
+
+// CommandJobTotalCount returns the total count of the command jobs
+func (c *Client) CommandJobTotalCount(ctx context.Context) (uint32, errors.EdgeX) {
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCount(commandJobTableName))
+}
+
+// CommandJobCountByStatus returns the count of the command jobs by status
+func (c *Client) CommandJobCountByStatus(ctx context.Context, status string) (uint32, errors.EdgeX) {
+	queryObj := map[string]any{statusField: status}
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCountByJSONField(commandJobTableName), queryObj)
+}
+
+// DeleteCommandJobsByAge deletes the command jobs created before the age in milliseconds
+func (c *Client) DeleteCommandJobsByAge(ctx context.Context, age int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByContentAge(commandJobTableName), age)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete command jobs by age", err)
+	}
+	return nil
+}
+
+func queryCommandJobs(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]models.CommandJob, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from core_command.job table", err)
+	}
+
+	jobs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.CommandJob, error) {
+		var j models.CommandJob
+		scanErr := row.Scan(&j)
+		return j, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to CommandJob model", err)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddCommandRecord adds a new command record to the database, the Created time is kept if it is set
+func (c *Client) AddCommandRecord(ctx context.Context, r models.CommandRecord) (models.CommandRecord, errors.EdgeX) {
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+	if r.Created == 0 {
+		r.Created = time.Now().UTC().UnixMilli()
+	}
+	dataBytes, err := json.Marshal(r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal CommandRecord model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(commandRecordTableName, idCol, contentCol), r.Id, dataBytes)
+	if err != nil {
+		return r, pgClient.WrapDBError("failed to insert row to core_command.record table", err)
+	}
+	return r, nil
+}
+
+// CommandRecords queries the command records matching the query in descending order of creation
+func (c *Client) CommandRecords(ctx context.Context, query models.CommandRecordQuery) ([]models.CommandRecord, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(query.Offset, query.Limit)
+	rows, err := c.ConnPool.Query(ctx, sqlQueryContentWithTimeRangeAndPaginationDesc(commandRecordTableName),
+		query.Start, query.End, commandRecordQueryObj(query), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from core_command.record table", err)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.CommandRecord, error) {
+		var r models.CommandRecord
+		scanErr := row.Scan(&r)
+		return r, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to CommandRecord model", err)
+	}
+	return records, nil
+}
+
+// CommandRecordCount returns the count of the command records matching the query, the offset and limit are ignored
+func (c *Client) CommandRecordCount(ctx context.Context, query models.CommandRecordQuery) (uint32, errors.EdgeX) {
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCountByTimeRangeAndJSONField(commandRecordTableName), query.Start, query.End, commandRecordQueryObj(query))
+}
+
+// DeleteCommandRecordsByAge deletes the command records created before the age in milliseconds
+func (c *Client) DeleteCommandRecordsByAge(ctx context.Context, age int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByContentAge(commandRecordTableName), age)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete command records by age", err)
+	}
+	return nil
+}
+
+// commandRecordQueryObj returns the JSON query object matching the device name and outcome of the query, an empty
+// object matches any record
+func commandRecordQueryObj(query models.CommandRecordQuery) map[string]any {
+	queryObj := map[string]any{}
+	if query.DeviceName != "" {
+		queryObj[deviceNameField] = query.DeviceName
+	}
+	if query.Outcome != "" {
+		queryObj[outcomeField] = query.Outcome
+	}
+	return queryObj
+}
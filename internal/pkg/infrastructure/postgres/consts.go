@@ -6,6 +6,7 @@
 package postgres
 
 import (
+	command "github.com/edgexfoundry/edgex-go/internal/core/command/embed"
 	data "github.com/edgexfoundry/edgex-go/internal/core/data/embed"
 	keeper "github.com/edgexfoundry/edgex-go/internal/core/keeper/embed"
 	metadata "github.com/edgexfoundry/edgex-go/internal/core/metadata/embed"
@@ -16,21 +17,27 @@ import (
 
 // constants relate to the postgres db table names
 const (
-	configTableName               = keeper.SchemaName + ".config"
-	eventTableName                = data.SchemaName + ".event"
-	deviceInfoTableName           = data.SchemaName + ".device_info"
-	deviceServiceTableName        = metadata.SchemaName + ".device_service"
-	deviceProfileTableName        = metadata.SchemaName + ".device_profile"
-	deviceTableName               = metadata.SchemaName + ".device"
-	provisionWatcherTableName     = metadata.SchemaName + ".provision_watcher"
-	notificationTableName         = notifications.SchemaName + ".notification"
-	readingTableName              = data.SchemaName + ".reading"
-	registryTableName             = keeper.SchemaName + ".registry"
-	scheduleActionRecordTableName = scheduler.SchemaName + ".record"
-	scheduleJobTableName          = scheduler.SchemaName + ".job"
-	subscriptionTableName         = notifications.SchemaName + ".subscription"
-	transmissionTableName         = notifications.SchemaName + ".transmission"
-	keyStoreTableName             = proxyauth.SchemaName + ".key_store"
+	auditRecordTableName           = metadata.SchemaName + ".audit_record"
+	commandJobTableName            = command.SchemaName + ".job"
+	commandRecordTableName         = command.SchemaName + ".record"
+	configTableName                = keeper.SchemaName + ".config"
+	eventTableName                 = data.SchemaName + ".event"
+	deviceInfoTableName            = data.SchemaName + ".device_info"
+	deviceServiceTableName         = metadata.SchemaName + ".device_service"
+	deviceProfileTableName         = metadata.SchemaName + ".device_profile"
+	deviceProfileRevisionTableName = metadata.SchemaName + ".device_profile_revision"
+	deviceTableName                = metadata.SchemaName + ".device"
+	deviceTemplateTableName        = metadata.SchemaName + ".device_template"
+	provisionWatcherTableName      = metadata.SchemaName + ".provision_watcher"
+	notificationTableName          = notifications.SchemaName + ".notification"
+	readingTableName               = data.SchemaName + ".reading"
+	readingRollupTableName         = data.SchemaName + ".reading_rollup"
+	registryTableName              = keeper.SchemaName + ".registry"
+	scheduleActionRecordTableName  = scheduler.SchemaName + ".record"
+	scheduleJobTableName           = scheduler.SchemaName + ".job"
+	subscriptionTableName          = notifications.SchemaName + ".subscription"
+	transmissionTableName          = notifications.SchemaName + ".transmission"
+	keyStoreTableName              = proxyauth.SchemaName + ".key_store"
 )
 
 // constants relate to the common db table column names
@@ -39,6 +46,7 @@ const (
 	createdCol  = "created"
 	idCol       = "id"
 	modifiedCol = "modified"
+	revisionCol = "revision"
 	statusCol   = "status"
 	nameCol     = "name"
 )
@@ -61,11 +69,22 @@ const (
 	objectValueCol    = "objectvalue"
 )
 
+// constants relate to the reading rollup postgres db table column names
+const (
+	bucketCol         = "bucket"
+	bucketIntervalCol = "bucketinterval"
+)
+
 // constants relate to the keeper postgres db table column names
 const (
 	keyCol = "key"
 )
 
+// constants relate to the device postgres db table column names
+const (
+	lastConnectedCol = "last_connected"
+)
+
 // constants relate to the schedule action record postgres db table column names
 const (
 	actionCol      = "action"
@@ -77,21 +96,34 @@ const (
 // constants relate to the notification postgres db table column names
 const (
 	notificationIdCol = "notification_id"
+	nextAttemptCol    = "next_attempt"
 )
 
 // constants relate to the field names in the content column
 const (
+	actionField           = "Action"
+	actorField            = "Actor"
+	adminStateField       = "AdminState"
 	categoryField         = "Category"
 	categoriesField       = "Categories"
 	createdField          = "Created"
+	deviceNameField       = "DeviceName"
+	entityNameField       = "EntityName"
+	entityTypeField       = "EntityType"
 	labelsField           = "Labels"
 	parentField           = "Parent"
 	manufacturerField     = "Manufacturer"
 	modelField            = "Model"
+	modifiedField         = "Modified"
 	nameField             = "Name"
 	notificationIdField   = "NotificationId"
+	operatingStateField   = "OperatingState"
+	outcomeField          = "Outcome"
 	profileNameField      = "ProfileName"
+	propertiesField       = "Properties"
+	protocolsField        = "Protocols"
 	receiverField         = "Receiver"
+	revisionField         = "Revision"
 	serviceIdField        = "ServiceId"
 	serviceNameField      = "ServiceName"
 	statusField           = "Status"
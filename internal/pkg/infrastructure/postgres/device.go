@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -10,14 +10,20 @@ import (
 	"encoding/json"
 	stdErrs "errors"
 	"fmt"
+	"maps"
 	"math"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
@@ -44,7 +50,7 @@ func (c *Client) AddDevice(d model.Device) (model.Device, errors.EdgeX) {
 		return model.Device{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device for Postgres persistence", err)
 	}
 
-	_, err = c.ConnPool.Exec(ctx, sqlInsert(deviceTableName, idCol, contentCol), d.Id, deviceJSONBytes)
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(deviceTableName, idCol, contentCol, lastConnectedCol), d.Id, deviceJSONBytes, deviceConnectedAt(d))
 	if err != nil {
 		return model.Device{}, pgClient.WrapDBError("failed to insert device", err)
 	}
@@ -63,16 +69,16 @@ func (c *Client) DeleteDeviceById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceByName deletes a device by name
-func (c *Client) DeleteDeviceByName(name string) errors.EdgeX {
+// DeleteDeviceByName deletes a device by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteDeviceByName(name string, ifRevision uint64) errors.EdgeX {
 	ctx := context.Background()
 
 	queryObj := map[string]any{nameField: name}
-	_, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONField(deviceTableName), queryObj)
+	result, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONFieldIfRevision(deviceTableName), queryObj, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to delete device by name %s", name), err)
 	}
-	return nil
+	return checkIfRevisionWritten(result, common.DeviceSystemEventType, name, ifRevision)
 }
 
 // DevicesByServiceName query devices by offset, limit and name
@@ -154,8 +160,8 @@ func (c *Client) DevicesByProfileName(offset int, limit int, profileName string)
 	return queryDevices(ctx, c.ConnPool, sqlQueryContentByJSONFieldWithPagination(deviceTableName), queryObj, offset, validLimit)
 }
 
-// UpdateDevice updates a device
-func (c *Client) UpdateDevice(d model.Device) errors.EdgeX {
+// UpdateDevice updates a device, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateDevice(d model.Device, ifRevision uint64) errors.EdgeX {
 	ctx := context.Background()
 
 	// Check if the device exists
@@ -175,10 +181,138 @@ func (c *Client) UpdateDevice(d model.Device) errors.EdgeX {
 	}
 
 	queryObj := map[string]any{nameField: d.Name}
-	_, err = c.ConnPool.Exec(ctx, sqlUpdateColsByJSONCondCol(deviceTableName, contentCol), updatedDeviceJSONBytes, queryObj)
+	result, err := c.ConnPool.Exec(ctx, sqlUpdateDeviceContentAndIncrRevisionByJSONFieldIfRevision(), updatedDeviceJSONBytes, queryObj, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to update device by name '%s' from %s table", d.Name, deviceTableName), err)
 	}
+	if edgeXErr = checkIfRevisionWritten(result, common.DeviceSystemEventType, d.Name, ifRevision); edgeXErr != nil {
+		return edgeXErr
+	}
+
+	return nil
+}
+
+// AddDevices adds the new devices in a single transaction, none of the devices is added if any of them fails
+func (c *Client) AddDevices(ds []model.Device) ([]model.Device, errors.EdgeX) {
+	ctx := context.Background()
+	timestamp := pkgCommon.MakeTimestamp()
+	addedDevices := make([]model.Device, len(ds))
+
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		for i, d := range ds {
+			if len(d.Id) == 0 {
+				d.Id = uuid.New().String()
+			}
+
+			var exists bool
+			queryObj := map[string]any{nameField: d.Name}
+			err := tx.QueryRow(ctx, sqlCheckExistsByJSONField(deviceTableName), queryObj).Scan(&exists)
+			if err != nil {
+				return pgClient.WrapDBError(fmt.Sprintf("failed to query device by name '%s' from %s table", d.Name, deviceTableName), err)
+			} else if exists {
+				return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s already exists", d.Name), nil)
+			}
+
+			d.Created = timestamp
+			d.Modified = timestamp
+			deviceJSONBytes, err := json.Marshal(d)
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device for Postgres persistence", err)
+			}
+			_, err = tx.Exec(ctx, sqlInsert(deviceTableName, idCol, contentCol, lastConnectedCol), d.Id, deviceJSONBytes, deviceConnectedAt(d))
+			if err != nil {
+				return pgClient.WrapDBError(fmt.Sprintf("failed to insert device '%s'", d.Name), err)
+			}
+			addedDevices[i] = d
+		}
+		return nil
+	})
+	if txErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(txErr)
+	}
+
+	return addedDevices, nil
+}
+
+// UpdateDevices updates the devices in a single transaction, none of the devices is updated if any of them fails
+func (c *Client) UpdateDevices(ds []model.Device) errors.EdgeX {
+	ctx := context.Background()
+
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		return updateDevicesInTx(ctx, tx, ds)
+	})
+	if txErr != nil {
+		return errors.NewCommonEdgeXWrapper(txErr)
+	}
+
+	return nil
+}
+
+// updateDevicesInTx updates the devices with the given transaction
+func updateDevicesInTx(ctx context.Context, tx pgx.Tx, ds []model.Device) errors.EdgeX {
+	timestamp := pkgCommon.MakeTimestamp()
+	for _, d := range ds {
+		d.Modified = timestamp
+		updatedDeviceJSONBytes, err := json.Marshal(d)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device for Postgres persistence", err)
+		}
+
+		queryObj := map[string]any{nameField: d.Name}
+		result, err := tx.Exec(ctx, sqlUpdateDeviceContentAndIncrRevisionByJSONField(), updatedDeviceJSONBytes, queryObj)
+		if err != nil {
+			return pgClient.WrapDBError(fmt.Sprintf("failed to update device by name '%s' from %s table", d.Name, deviceTableName), err)
+		} else if result.RowsAffected() == 0 {
+			return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device '%s' does not exist", d.Name), nil)
+		}
+	}
+	return nil
+}
+
+// DeleteDevicesByNames deletes the devices by names in a single transaction, none of the devices is deleted if any of
+// them fails. A device having child devices can only be deleted together with all its children. The device found in
+// ifRevisions is only deleted if it is still at the revision.
+func (c *Client) DeleteDevicesByNames(names []string, ifRevisions map[string]uint64) errors.EdgeX {
+	ctx := context.Background()
+	toDelete := make(map[string]bool, len(names))
+	for _, name := range names {
+		toDelete[name] = true
+	}
+
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		for _, name := range names {
+			rows, err := tx.Query(ctx, sqlQueryContentByJSONField(deviceTableName), map[string]any{parentField: name})
+			if err != nil {
+				return pgClient.WrapDBError(fmt.Sprintf("failed to query the child devices of device '%s'", name), err)
+			}
+			children, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Device, error) {
+				var d model.Device
+				scanErr := row.Scan(&d)
+				return d, scanErr
+			})
+			if err != nil {
+				return pgClient.WrapDBError("failed to collect rows to Device model", err)
+			}
+			for _, child := range children {
+				if !toDelete[child.Name] {
+					return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("cannot delete device '%s', it has child devices", name), nil)
+				}
+			}
+
+			result, err := tx.Exec(ctx, sqlDeleteByJSONFieldIfRevision(deviceTableName), map[string]any{nameField: name}, ifRevisions[name])
+			if err != nil {
+				return pgClient.WrapDBError(fmt.Sprintf("failed to delete device by name %s", name), err)
+			} else if edgeXerr := checkIfRevisionWritten(result, common.DeviceSystemEventType, name, ifRevisions[name]); edgeXerr != nil {
+				return edgeXerr
+			} else if result.RowsAffected() == 0 {
+				return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device '%s' does not exist", name), nil)
+			}
+		}
+		return nil
+	})
+	if txErr != nil {
+		return errors.NewCommonEdgeXWrapper(txErr)
+	}
 
 	return nil
 }
@@ -272,6 +406,135 @@ func (c *Client) DeviceTree(parent string, levels int, offset int, limit int, la
 	return uint32(len(all_devices)), all_devices[offset : offset+numToReturn], nil
 }
 
+// DeviceAncestors returns the ancestors of the device from its parent up to the root of the device tree. The ancestors
+// stop at the parent that doesn't exist, and an error is returned if the parents form a cycle.
+func (c *Client) DeviceAncestors(name string) ([]model.Device, errors.EdgeX) {
+	device, err := c.DeviceByName(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	ancestors := []model.Device{}
+	visited := map[string]bool{device.Name: true}
+	for device.Parent != "" {
+		if visited[device.Parent] {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("the parents of device %s form a cycle at device %s, stopping ancestors query", name, device.Parent), nil)
+		}
+		visited[device.Parent] = true
+		device, err = c.DeviceByName(device.Parent)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			break
+		} else if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		ancestors = append(ancestors, device)
+	}
+	return ancestors, nil
+}
+
+// DevicesByQuery returns the total count of the devices matching the filters of the query along with the page of them,
+// which are sorted and paged as the query specifies
+func (c *Client) DevicesByQuery(query models.DeviceQuery) (uint32, []model.Device, errors.EdgeX) {
+	condition, args := deviceQueryCondition(query)
+	count, err := getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByCond(deviceTableName, condition), args...)
+	if err != nil {
+		return 0, nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to count devices by the search filters", err)
+	}
+	offset, validLimit := getValidOffsetAndLimit(query.Offset, query.Limit)
+	if count == 0 || offset >= int(count) {
+		return count, []model.Device{}, nil
+	}
+	args = append(args, offset, validLimit)
+
+	devices, err := queryDevices(context.Background(), c.ConnPool, sqlQueryContentByCondWithOrderAndPagination(deviceTableName, condition, deviceQueryOrder(query), len(args)-2), args...)
+	if err != nil {
+		return 0, nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query devices by the search filters", err)
+	}
+	return count, devices, nil
+}
+
+// deviceQueryCondition returns the WHERE condition matching all the filters of the device query along with its arguments,
+// the condition is TRUE if the query has no filter
+func deviceQueryCondition(query models.DeviceQuery) (string, []any) {
+	var conditions []string
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	anyOf := func(field string, values []string) {
+		if len(values) > 0 {
+			conditions = append(conditions, fmt.Sprintf("content->>'%s' = ANY(%s)", field, arg(values)))
+		}
+	}
+
+	if query.NamePrefix != "" {
+		conditions = append(conditions, fmt.Sprintf("content->>'%s' LIKE %s", nameField, arg(likePrefixPattern(query.NamePrefix))))
+	}
+	anyOf(adminStateField, query.AdminStates)
+	anyOf(operatingStateField, query.OperatingStates)
+	anyOf(profileNameField, query.ProfileNames)
+	anyOf(serviceNameField, query.ServiceNames)
+	if len(query.AllLabels) > 0 {
+		conditions = append(conditions, fmt.Sprintf("content->'%s' ?& %s", labelsField, arg(query.AllLabels)))
+	}
+	if len(query.AnyLabels) > 0 {
+		conditions = append(conditions, fmt.Sprintf("content->'%s' ?| %s", labelsField, arg(query.AnyLabels)))
+	}
+	if len(query.NoLabels) > 0 {
+		conditions = append(conditions, fmt.Sprintf("NOT COALESCE(content->'%s' ?| %s, false)", labelsField, arg(query.NoLabels)))
+	}
+	if query.LastConnectedFrom > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s >= %s", lastConnectedCol, arg(query.LastConnectedFrom)))
+	}
+	if query.LastConnectedTo > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s <= %s", lastConnectedCol, arg(query.LastConnectedTo)))
+	}
+	for _, protocol := range slices.Sorted(maps.Keys(query.ProtocolProperties)) {
+		properties := query.ProtocolProperties[protocol]
+		for _, property := range slices.Sorted(maps.Keys(properties)) {
+			conditions = append(conditions, fmt.Sprintf("content->'%s'->%s::text->>%s::text = %s", protocolsField, arg(protocol), arg(property), arg(properties[property])))
+		}
+	}
+
+	if len(conditions) == 0 {
+		return "TRUE", args
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// deviceQueryOrder returns the ORDER BY expression of the device query, the devices of the same sort value are ordered by name
+func deviceQueryOrder(query models.DeviceQuery) string {
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+	var field string
+	switch query.SortBy {
+	case constants.DeviceSortByCreated:
+		field = createdField
+	case constants.DeviceSortByModified:
+		field = modifiedField
+	default:
+		return fmt.Sprintf("content->>'%s' %s", nameField, direction)
+	}
+	return fmt.Sprintf("COALESCE((content->>'%s')::bigint, 0) %s, content->>'%s' %s", field, direction, nameField, direction)
+}
+
+// deviceConnectedAt returns the last connected time of the device to add, which is the time it is added if its operating
+// state is UP, otherwise nil as it has never connected
+func deviceConnectedAt(d model.Device) *int64 {
+	if d.OperatingState != model.Up {
+		return nil
+	}
+	return &d.Modified
+}
+
+// likePrefixPattern returns the LIKE pattern matching the strings starting with the prefix, in which the wildcards are escaped
+func likePrefixPattern(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix) + "%"
+}
+
 func deviceNameExists(ctx context.Context, connPool *pgxpool.Pool, name string) (bool, errors.EdgeX) {
 	var exists bool
 	queryObj := map[string]any{nameField: name}
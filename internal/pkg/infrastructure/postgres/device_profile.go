@@ -17,12 +17,14 @@ import (
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
-// AddDeviceProfile adds a new device profile
-func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile, errors.EdgeX) {
+// AddDeviceProfile adds a new device profile, which is recorded as the first revision of the profile if maxRevisions is not 0
+func (c *Client) AddDeviceProfile(dp model.DeviceProfile, maxRevisions uint32) (model.DeviceProfile, errors.EdgeX) {
 	ctx := context.Background()
 
 	if len(dp.Id) == 0 {
@@ -45,16 +47,27 @@ func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile,
 		return model.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile for Postgres persistence", err)
 	}
 
-	_, err = c.ConnPool.Exec(ctx, sqlInsert(deviceProfileTableName, idCol, contentCol), dp.Id, deviceProfileJSONBytes)
-	if err != nil {
-		return model.DeviceProfile{}, pgClient.WrapDBError("failed to insert device profile", err)
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sqlInsert(deviceProfileTableName, idCol, contentCol), dp.Id, deviceProfileJSONBytes)
+		if err != nil {
+			return pgClient.WrapDBError("failed to insert device profile", err)
+		}
+		if maxRevisions > 0 {
+			return addDeviceProfileRevisionInTx(ctx, tx, dp, 1)
+		}
+		return nil
+	})
+	if txErr != nil {
+		return model.DeviceProfile{}, errors.NewCommonEdgeXWrapper(txErr)
 	}
 
 	return dp, nil
 }
 
-// UpdateDeviceProfile updates a new device profile
-func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile) errors.EdgeX {
+// UpdateDeviceProfile updates a new device profile, only if it is still at ifRevision unless ifRevision is 0. The updated
+// profile is recorded as a new revision in the same transaction if maxRevisions is not 0, and the revisions beyond
+// maxRevisions are removed.
+func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile, ifRevision uint64, maxRevisions uint32) errors.EdgeX {
 	ctx := context.Background()
 
 	// Check if the device profile exists
@@ -74,11 +87,38 @@ func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile) errors.EdgeX {
 	}
 
 	queryObj := map[string]any{nameField: dp.Name}
-	_, err = c.ConnPool.Exec(ctx, sqlUpdateColsByJSONCondCol(deviceProfileTableName, contentCol), updatedDeviceProfileJSONBytes, queryObj)
-	if err != nil {
-		return pgClient.WrapDBError(fmt.Sprintf("failed to update device profile by name '%s' from %s table", dp.Name, deviceProfileTableName), err)
+	if maxRevisions == 0 {
+		result, err := c.ConnPool.Exec(ctx, sqlUpdateContentAndIncrRevisionByJSONFieldIfRevision(deviceProfileTableName), updatedDeviceProfileJSONBytes, queryObj, ifRevision)
+		if err != nil {
+			return pgClient.WrapDBError(fmt.Sprintf("failed to update device profile by name '%s' from %s table", dp.Name, deviceProfileTableName), err)
+		}
+		return checkIfRevisionWritten(result, common.DeviceProfileSystemEventType, dp.Name, ifRevision)
 	}
 
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		// lock the profile row, so that the revision history is written based on the revision being updated
+		var current model.DeviceProfile
+		var revision int64
+		err := tx.QueryRow(ctx, sqlQueryContentAndColByJSONFieldForUpdate(deviceProfileTableName, revisionCol), queryObj).Scan(&current, &revision)
+		if err != nil {
+			if stdErrs.Is(err, pgx.ErrNoRows) {
+				return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exist", dp.Name), err)
+			}
+			return pgClient.WrapDBError(fmt.Sprintf("failed to query device profile by name '%s' from %s table", dp.Name, deviceProfileTableName), err)
+		}
+		if ifRevision > 0 && uint64(revision) != ifRevision {
+			return utils.NewPreconditionFailedError(common.DeviceProfileSystemEventType, dp.Name, utils.ETag(ifRevision))
+		}
+
+		_, err = tx.Exec(ctx, sqlUpdateContentAndIncrRevisionByJSONField(deviceProfileTableName), updatedDeviceProfileJSONBytes, queryObj)
+		if err != nil {
+			return pgClient.WrapDBError(fmt.Sprintf("failed to update device profile by name '%s' from %s table", dp.Name, deviceProfileTableName), err)
+		}
+		return recordDeviceProfileRevisionInTx(ctx, tx, current, uint64(revision), dp, maxRevisions)
+	})
+	if txErr != nil {
+		return errors.NewCommonEdgeXWrapper(txErr)
+	}
 	return nil
 }
 
@@ -115,21 +155,40 @@ func (c *Client) DeviceProfileByName(name string) (model.DeviceProfile, errors.E
 func (c *Client) DeleteDeviceProfileById(id string) errors.EdgeX {
 	ctx := context.Background()
 
-	_, err := c.ConnPool.Exec(ctx, sqlDeleteById(deviceProfileTableName), id)
-	if err != nil {
-		return pgClient.WrapDBError(fmt.Sprintf("failed to delete device profile by id %s", id), err)
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		var profile model.DeviceProfile
+		err := tx.QueryRow(ctx, sqlDeleteByIdReturningContent(deviceProfileTableName), id).Scan(&profile)
+		if err != nil {
+			if stdErrs.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return pgClient.WrapDBError(fmt.Sprintf("failed to delete device profile by id %s", id), err)
+		}
+		return deleteDeviceProfileRevisionsInTx(ctx, tx, profile.Name)
+	})
+	if txErr != nil {
+		return errors.NewCommonEdgeXWrapper(txErr)
 	}
 	return nil
 }
 
-// DeleteDeviceProfileByName deletes a device profile by name
-func (c *Client) DeleteDeviceProfileByName(name string) errors.EdgeX {
+// DeleteDeviceProfileByName deletes a device profile by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteDeviceProfileByName(name string, ifRevision uint64) errors.EdgeX {
 	ctx := context.Background()
 
 	queryObj := map[string]any{nameField: name}
-	_, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONField(deviceProfileTableName), queryObj)
-	if err != nil {
-		return pgClient.WrapDBError(fmt.Sprintf("failed to delete device profile by name %s", name), err)
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, sqlDeleteByJSONFieldIfRevision(deviceProfileTableName), queryObj, ifRevision)
+		if err != nil {
+			return pgClient.WrapDBError(fmt.Sprintf("failed to delete device profile by name %s", name), err)
+		}
+		if edgeXErr := checkIfRevisionWritten(result, common.DeviceProfileSystemEventType, name, ifRevision); edgeXErr != nil {
+			return edgeXErr
+		}
+		return deleteDeviceProfileRevisionsInTx(ctx, tx, name)
+	})
+	if txErr != nil {
+		return errors.NewCommonEdgeXWrapper(txErr)
 	}
 	return nil
 }
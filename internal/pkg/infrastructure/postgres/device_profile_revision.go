@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// addDeviceProfileRevisionInTx adds the revision of the device profile in the transaction of the profile change
+func addDeviceProfileRevisionInTx(ctx context.Context, tx pgx.Tx, profile model.DeviceProfile, revision uint64) errors.EdgeX {
+	r := models.DeviceProfileRevision{
+		Id:          uuid.New().String(),
+		ProfileName: profile.Name,
+		Revision:    revision,
+		Profile:     profile,
+		Created:     pkgCommon.MakeTimestamp(),
+	}
+	dataBytes, err := json.Marshal(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile revision for Postgres persistence", err)
+	}
+
+	_, err = tx.Exec(ctx, sqlInsert(deviceProfileRevisionTableName, idCol, contentCol), r.Id, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to insert revision %d of device profile '%s'", r.Revision, r.ProfileName), err)
+	}
+	return nil
+}
+
+// recordDeviceProfileRevisionInTx records the changed device profile at the revision following the current one in the
+// transaction of the profile change, and removes the revisions beyond maxRevisions. The current profile is recorded as
+// well if the profile is changed for the first time since the revision history was enabled.
+func recordDeviceProfileRevisionInTx(ctx context.Context, tx pgx.Tx, current model.DeviceProfile, currentRevision uint64, changed model.DeviceProfile, maxRevisions uint32) errors.EdgeX {
+	revision := currentRevision + 1
+	oldest := uint64(1)
+	if revision > uint64(maxRevisions) {
+		oldest = revision - uint64(maxRevisions) + 1
+	}
+
+	queryObj := map[string]any{profileNameField: changed.Name}
+	var count uint32
+	err := tx.QueryRow(ctx, sqlQueryCountByJSONField(deviceProfileRevisionTableName), queryObj).Scan(&count)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to count revisions of device profile '%s'", changed.Name), err)
+	}
+	if count == 0 && currentRevision >= oldest {
+		if edgeXErr := addDeviceProfileRevisionInTx(ctx, tx, current, currentRevision); edgeXErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXErr)
+		}
+	}
+	if edgeXErr := addDeviceProfileRevisionInTx(ctx, tx, changed, revision); edgeXErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+
+	_, err = tx.Exec(ctx, sqlDeleteByJSONFieldAndUpperLimitField(deviceProfileRevisionTableName, revisionField), queryObj, oldest)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete revisions of device profile '%s' before revision %d", changed.Name, oldest), err)
+	}
+	return nil
+}
+
+// deleteDeviceProfileRevisionsInTx deletes all the revisions of the device profile in the transaction of the profile deletion
+func deleteDeviceProfileRevisionsInTx(ctx context.Context, tx pgx.Tx, profileName string) errors.EdgeX {
+	queryObj := map[string]any{profileNameField: profileName}
+	_, err := tx.Exec(ctx, sqlDeleteByJSONField(deviceProfileRevisionTableName), queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete revisions of device profile '%s'", profileName), err)
+	}
+	return nil
+}
+
+// DeviceProfileRevisionsByProfileName queries the revisions of the device profile with offset and limit, the latest revision comes first
+func (c *Client) DeviceProfileRevisionsByProfileName(offset int, limit int, profileName string) ([]models.DeviceProfileRevision, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	queryObj := map[string]any{profileNameField: profileName}
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentByJSONFieldWithPaginationDescByField(deviceProfileRevisionTableName, revisionField), queryObj, offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query revisions of device profile '%s'", profileName), err)
+	}
+	return collectDeviceProfileRevisions(rows)
+}
+
+// DeviceProfileRevisionCountByProfileName returns the count of the revisions of the device profile
+func (c *Client) DeviceProfileRevisionCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	queryObj := map[string]any{profileNameField: profileName}
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByJSONField(deviceProfileRevisionTableName), queryObj)
+}
+
+// DeviceProfileRevisionByProfileNameAndRevision gets the revision of the device profile
+func (c *Client) DeviceProfileRevisionByProfileNameAndRevision(profileName string, revision uint64) (models.DeviceProfileRevision, errors.EdgeX) {
+	queryObj := map[string]any{profileNameField: profileName, revisionField: revision}
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentByJSONField(deviceProfileRevisionTableName), queryObj)
+	if err != nil {
+		return models.DeviceProfileRevision{}, pgClient.WrapDBError(fmt.Sprintf("failed to query revision %d of device profile '%s'", revision, profileName), err)
+	}
+	revisions, edgeXErr := collectDeviceProfileRevisions(rows)
+	if edgeXErr != nil {
+		return models.DeviceProfileRevision{}, errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+	if len(revisions) == 0 {
+		return models.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("revision %d of device profile '%s' does not exist", revision, profileName), nil)
+	}
+	return revisions[0], nil
+}
+
+func collectDeviceProfileRevisions(rows pgx.Rows) ([]models.DeviceProfileRevision, errors.EdgeX) {
+	revisions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.DeviceProfileRevision, error) {
+		var r models.DeviceProfileRevision
+		scanErr := row.Scan(&r)
+		return r, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DeviceProfileRevision model", err)
+	}
+	return revisions, nil
+}
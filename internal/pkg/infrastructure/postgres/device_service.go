@@ -17,6 +17,7 @@ import (
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
@@ -93,16 +94,16 @@ func (c *Client) DeleteDeviceServiceById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceServiceByName deletes a device service by name
-func (c *Client) DeleteDeviceServiceByName(name string) errors.EdgeX {
+// DeleteDeviceServiceByName deletes a device service by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteDeviceServiceByName(name string, ifRevision uint64) errors.EdgeX {
 	ctx := context.Background()
 
 	queryObj := map[string]any{nameField: name}
-	_, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONField(deviceServiceTableName), queryObj)
+	result, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONFieldIfRevision(deviceServiceTableName), queryObj, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to delete device service by name %s", name), err)
 	}
-	return nil
+	return checkIfRevisionWritten(result, common.DeviceServiceSystemEventType, name, ifRevision)
 }
 
 // DeviceServiceNameExists checks the device service exists by name
@@ -136,8 +137,8 @@ func (c *Client) AllDeviceServices(offset int, limit int, labels []string) (devi
 	return deviceServices, nil
 }
 
-// UpdateDeviceService updates a device service
-func (c *Client) UpdateDeviceService(ds model.DeviceService) errors.EdgeX {
+// UpdateDeviceService updates a device service, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateDeviceService(ds model.DeviceService, ifRevision uint64) errors.EdgeX {
 	ctx := context.Background()
 
 	// Check if the device service exists
@@ -157,10 +158,13 @@ func (c *Client) UpdateDeviceService(ds model.DeviceService) errors.EdgeX {
 	}
 
 	queryObj := map[string]any{nameField: ds.Name}
-	_, err = c.ConnPool.Exec(ctx, sqlUpdateColsByJSONCondCol(deviceServiceTableName, contentCol), updatedDeviceServiceJSONBytes, queryObj)
+	result, err := c.ConnPool.Exec(ctx, sqlUpdateContentAndIncrRevisionByJSONFieldIfRevision(deviceServiceTableName), updatedDeviceServiceJSONBytes, queryObj, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to update device service by name '%s' from %s table", ds.Name, deviceServiceTableName), err)
 	}
+	if edgeXErr = checkIfRevisionWritten(result, common.DeviceServiceSystemEventType, ds.Name, ifRevision); edgeXErr != nil {
+		return edgeXErr
+	}
 
 	return nil
 }
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+func TestDeviceQueryConditionLastConnected(t *testing.T) {
+	tests := []struct {
+		name              string
+		query             models.DeviceQuery
+		expectedCondition string
+		expectedArgs      []any
+	}{
+		{"no range", models.DeviceQuery{}, "TRUE", nil},
+		{"from", models.DeviceQuery{LastConnectedFrom: 100}, "last_connected >= $1", []any{int64(100)}},
+		{"to", models.DeviceQuery{LastConnectedTo: 200}, "last_connected <= $1", []any{int64(200)}},
+		{"from and to", models.DeviceQuery{LastConnectedFrom: 100, LastConnectedTo: 200}, "last_connected >= $1 AND last_connected <= $2", []any{int64(100), int64(200)}},
+		{"with other filters", models.DeviceQuery{ServiceNames: []string{"ds"}, LastConnectedFrom: 100},
+			"content->>'ServiceName' = ANY($1) AND last_connected >= $2", []any{[]string{"ds"}, int64(100)}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			condition, args := deviceQueryCondition(testCase.query)
+			assert.Equal(t, testCase.expectedCondition, condition)
+			assert.Equal(t, testCase.expectedArgs, args)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	stdErrs "errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddDeviceTemplate adds a new device template
+func (c *Client) AddDeviceTemplate(t models.DeviceTemplate) (models.DeviceTemplate, errors.EdgeX) {
+	ctx := context.Background()
+
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	exists, edgeXErr := deviceTemplateNameExists(ctx, c.ConnPool, t.Name)
+	if edgeXErr != nil {
+		return models.DeviceTemplate{}, errors.NewCommonEdgeXWrapper(edgeXErr)
+	} else if exists {
+		return models.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device template name %s already exists", t.Name), nil)
+	}
+
+	timestamp := pkgCommon.MakeTimestamp()
+	t.Created = timestamp
+	t.Modified = timestamp
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return models.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device template for Postgres persistence", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(deviceTemplateTableName, idCol, contentCol), t.Id, dataBytes)
+	if err != nil {
+		return models.DeviceTemplate{}, pgClient.WrapDBError("failed to insert device template", err)
+	}
+	return t, nil
+}
+
+// UpdateDeviceTemplate updates a device template along with the linked devices in a single transaction, neither the
+// template nor the devices are updated if any of them fails
+func (c *Client) UpdateDeviceTemplate(t models.DeviceTemplate, linkedDevices []model.Device) errors.EdgeX {
+	ctx := context.Background()
+
+	exists, edgeXErr := deviceTemplateNameExists(ctx, c.ConnPool, t.Name)
+	if edgeXErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXErr)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device template '%s' does not exist", t.Name), nil)
+	}
+
+	t.Modified = pkgCommon.MakeTimestamp()
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device template for Postgres persistence", err)
+	}
+
+	queryObj := map[string]any{nameField: t.Name}
+	txErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sqlUpdateColsByJSONCondCol(deviceTemplateTableName, contentCol), dataBytes, queryObj)
+		if err != nil {
+			return pgClient.WrapDBError(fmt.Sprintf("failed to update device template by name '%s' from %s table", t.Name, deviceTemplateTableName), err)
+		}
+		return updateDevicesInTx(ctx, tx, linkedDevices)
+	})
+	if txErr != nil {
+		return errors.NewCommonEdgeXWrapper(txErr)
+	}
+	return nil
+}
+
+// DeviceTemplateByName gets a device template by name
+func (c *Client) DeviceTemplateByName(name string) (models.DeviceTemplate, errors.EdgeX) {
+	ctx := context.Background()
+
+	queryObj := map[string]any{nameField: name}
+	var template models.DeviceTemplate
+	err := c.ConnPool.QueryRow(ctx, sqlQueryContentByJSONField(deviceTemplateTableName), queryObj).Scan(&template)
+	if err != nil {
+		if stdErrs.Is(err, pgx.ErrNoRows) {
+			return template, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no device template with name '%s' found", name), err)
+		}
+		return template, pgClient.WrapDBError("failed to scan row to device template model", err)
+	}
+	return template, nil
+}
+
+// AllDeviceTemplates returns the device templates with the given offset and limit
+func (c *Client) AllDeviceTemplates(offset int, limit int) ([]models.DeviceTemplate, errors.EdgeX) {
+	ctx := context.Background()
+
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	rows, err := c.ConnPool.Query(ctx, sqlQueryContentWithPagination(deviceTemplateTableName), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query all device templates", err)
+	}
+	templates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.DeviceTemplate, error) {
+		var t models.DeviceTemplate
+		scanErr := row.Scan(&t)
+		return t, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DeviceTemplate model", err)
+	}
+	return templates, nil
+}
+
+// DeviceTemplateCount returns the total count of the device templates
+func (c *Client) DeviceTemplateCount() (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(deviceTemplateTableName))
+}
+
+// DeleteDeviceTemplateByName deletes a device template by name
+func (c *Client) DeleteDeviceTemplateByName(name string) errors.EdgeX {
+	ctx := context.Background()
+
+	queryObj := map[string]any{nameField: name}
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONField(deviceTemplateTableName), queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete device template by name %s", name), err)
+	}
+	return nil
+}
+
+// DevicesByTemplateName returns all the devices linked to the device template
+func (c *Client) DevicesByTemplateName(templateName string) ([]model.Device, errors.EdgeX) {
+	queryObj := map[string]any{propertiesField: map[string]any{constants.DeviceTemplateNameProperty: templateName}}
+	return queryDevices(context.Background(), c.ConnPool, sqlQueryContentByJSONField(deviceTableName), queryObj)
+}
+
+// DeviceCountByTemplateName returns the count of the devices linked to the device template
+func (c *Client) DeviceCountByTemplateName(templateName string) (uint32, errors.EdgeX) {
+	queryObj := map[string]any{propertiesField: map[string]any{constants.DeviceTemplateNameProperty: templateName}}
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByJSONField(deviceTableName), queryObj)
+}
+
+func deviceTemplateNameExists(ctx context.Context, connPool *pgxpool.Pool, name string) (bool, errors.EdgeX) {
+	var exists bool
+	queryObj := map[string]any{nameField: name}
+	err := connPool.QueryRow(ctx, sqlCheckExistsByJSONField(deviceTemplateTableName), queryObj).Scan(&exists)
+	if err != nil {
+		return false, pgClient.WrapDBError(fmt.Sprintf("failed to query device template by name '%s' from %s table", name, deviceTemplateTableName), err)
+	}
+	return exists, nil
+}
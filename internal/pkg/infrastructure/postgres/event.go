@@ -9,8 +9,11 @@ import (
 	"context"
 	stdErrs "errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -21,6 +24,11 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+var (
+	// insertEventCols defines the event table columns in slice used in inserting events in batch
+	insertEventCols = []string{idCol, deviceInfoIdFKCol, originCol}
+)
+
 // AllEvents queries the events with the given range, offset, and limit
 func (c *Client) AllEvents(offset, limit int) ([]model.Event, errors.EdgeX) {
 	ctx := context.Background()
@@ -34,6 +42,44 @@ func (c *Client) AllEvents(offset, limit int) ([]model.Event, errors.EdgeX) {
 	return events, nil
 }
 
+// EventsByCursor queries the events by the given cursor query in descending order of origin and id, and returns the cursor
+// of the next page, which is empty if there are no more events
+func (c *Client) EventsByCursor(query dataModels.CursorQuery) ([]model.Event, string, errors.EdgeX) {
+	var columns []string
+	queryArgs := []any{query.Start, query.End}
+	if query.DeviceName != "" {
+		columns = append(columns, deviceNameCol)
+		queryArgs = append(queryArgs, query.DeviceName)
+	}
+	if query.Cursor != "" {
+		keys, err := parseCursor(query.Cursor, 2)
+		if err != nil {
+			return nil, "", errors.NewCommonEdgeXWrapper(err)
+		}
+		origin, parseErr := strconv.ParseInt(keys[0], 10, 64)
+		_, uuidErr := uuid.Parse(keys[1])
+		if parseErr != nil || uuidErr != nil {
+			return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid event cursor '%s'", query.Cursor), nil)
+		}
+		queryArgs = append(queryArgs, origin, keys[1])
+	}
+	// query one more event than the limit to know whether there is a next page
+	queryArgs = append(queryArgs, query.Limit+1)
+
+	events, err := queryEvents(context.Background(), c.ConnPool, sqlQueryAllEventWithKeysetAndTimeRange(query.Cursor != "", columns...), queryArgs...)
+	if err != nil {
+		return nil, "", errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to query events by cursor", err)
+	}
+
+	var nextCursor string
+	if len(events) > query.Limit {
+		events = events[:query.Limit]
+		last := events[len(events)-1]
+		nextCursor = formatCursor(last.Origin, last.Id)
+	}
+	return events, nextCursor, nil
+}
+
 // AddEvent adds a new event model to DB
 func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	ctx := context.Background()
@@ -86,6 +132,62 @@ func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return event, nil
 }
 
+// AddEvents adds the event models to DB in batch, the events and their readings are inserted with the COPY protocol in a
+// single transaction, so either all the events are added or none of them
+func (c *Client) AddEvents(events []model.Event) ([]model.Event, errors.EdgeX) {
+	ctx := context.Background()
+
+	addedEvents := make([]model.Event, len(events))
+	eventRows := make([][]any, len(events))
+	for i, e := range events {
+		if e.Id == "" {
+			e.Id = uuid.NewString()
+		} else {
+			_, err := uuid.Parse(e.Id)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindInvalidId, fmt.Sprintf("failed to parse event id '%s' as an UUID", e.Id), err)
+			}
+		}
+
+		deviceInfoId, err := c.deviceInfoIdByEvent(e)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+
+		eventRows[i] = []any{e.Id, deviceInfoId, e.Origin}
+		// return the events with readings to ensure readingsPersistedCounter will be increased
+		addedEvents[i] = model.Event{
+			Id:          e.Id,
+			DeviceName:  e.DeviceName,
+			ProfileName: e.ProfileName,
+			SourceName:  e.SourceName,
+			Origin:      e.Origin,
+			Tags:        e.Tags,
+			Readings:    e.Readings,
+		}
+	}
+
+	pgxErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		// insert events in batch
+		_, pgxErr := tx.CopyFrom(ctx, strings.Split(eventTableName, "."), insertEventCols, pgx.CopyFromRows(eventRows))
+		if pgxErr != nil {
+			return pgClient.WrapDBError("failed to insert events in batch", pgxErr)
+		}
+
+		// insert the readings of all events in batch
+		pgxErr = c.addEventsReadingsInTx(tx, addedEvents)
+		if pgxErr != nil {
+			return errors.NewCommonEdgeXWrapper(pgxErr)
+		}
+		return nil
+	})
+	if pgxErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(pgxErr)
+	}
+
+	return addedEvents, nil
+}
+
 // EventById gets an event by id
 func (c *Client) EventById(id string) (model.Event, errors.EdgeX) {
 	ctx := context.Background()
@@ -261,18 +363,25 @@ func (c *Client) deleteEventsByConditions(cols []string, values []any) errors.Ed
 // DeleteEventsByAge deletes events and their corresponding readings that are older than age
 // This function is implemented to starts up two goroutines to delete readings and events in the background to achieve better performance
 func (c *Client) DeleteEventsByAge(age int64) errors.EdgeX {
-	return c.deleteEventsByAgeAndConditions(age, nil, nil)
+	return c.deleteEventsByAgeAndConditions(age, nil, nil, nil)
 }
 
 // DeleteEventsByAgeAndDeviceNameAndSourceName deletes events and their corresponding readings that are older than age
 // This function is implemented to starts up two goroutines to delete readings and events in the background to achieve better performance
 func (c *Client) DeleteEventsByAgeAndDeviceNameAndSourceName(age int64, deviceName, sourceName string) errors.EdgeX {
-	return c.deleteEventsByAgeAndConditions(age, []string{deviceNameCol, sourceNameCol}, []any{deviceName, sourceName})
+	return c.deleteEventsByAgeAndConditions(age, nil, []string{deviceNameCol, sourceNameCol}, []any{deviceName, sourceName})
+}
+
+// RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName compacts the numeric readings of the events older than age into the reading
+// rollups of each interval, and then deletes the events and their corresponding readings in the same transaction
+func (c *Client) RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName(age int64, intervals []int64, deviceName, sourceName string) errors.EdgeX {
+	return c.deleteEventsByAgeAndConditions(age, intervals, []string{deviceNameCol, sourceNameCol}, []any{deviceName, sourceName})
 }
 
-// deleteEventsByAgeAndConditions deletes events and their corresponding readings that are older than age
+// deleteEventsByAgeAndConditions deletes events and their corresponding readings that are older than age, the numeric readings
+// are compacted into the reading rollups of each rollupInterval before being deleted
 // This function is implemented to starts up two goroutines to delete readings and events in the background to achieve better performance
-func (c *Client) deleteEventsByAgeAndConditions(age int64, cols []string, values []any) errors.EdgeX {
+func (c *Client) deleteEventsByAgeAndConditions(age int64, rollupIntervals []int64, cols []string, values []any) errors.EdgeX {
 	ctx := context.Background()
 	expireTimestamp := time.Now().UnixNano() - age
 	sqlStatement := sqlDeleteEventsByTimeRangeAndColumn(originCol, cols...)
@@ -283,6 +392,12 @@ func (c *Client) deleteEventsByAgeAndConditions(age int64, cols []string, values
 		_ = pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
 			// select the event ids within the origin time range from event table as the sub-query of deleting readings
 			subSqlStatement := sqlQueryEventIdFieldByTimeRangeAndConditions(originCol, cols...)
+			for _, interval := range rollupIntervals {
+				if err := upsertReadingRollupsBySubQuery(ctx, tx, subSqlStatement, interval, args...); err != nil {
+					c.loggingClient.Errorf("failed to rollup readings by age '%d' nanoseconds and interval '%d' nanoseconds: %v", age, interval, err)
+					return err
+				}
+			}
 			if err := deleteReadingsBySubQuery(ctx, tx, subSqlStatement, args...); err != nil {
 				c.loggingClient.Errorf("failed delete readings by age '%d' nanoseconds: %v", age, err)
 				return err
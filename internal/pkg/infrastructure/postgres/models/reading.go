@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -11,7 +11,8 @@ import "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 // which includes all the fields in BaseReading, BinaryReading, SimpleReading and ObjectReading
 type Reading struct {
 	models.BaseReading
-	EventId string `db:"event_id"` // the foreign key refers to the id column in core_data.event table
+	EventId      string `db:"event_id"`       // the foreign key refers to the id column in core_data.event table
+	DeviceInfoId int    `db:"device_info_id"` // the foreign key refers to the id column in core_data.device_info table
 	BinaryReading
 	SimpleReading
 	ObjectReading
@@ -17,6 +17,7 @@ import (
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
@@ -117,20 +118,20 @@ func (c *Client) AllProvisionWatchers(offset int, limit int, labels []string) (p
 	return pws, nil
 }
 
-// DeleteProvisionWatcherByName deletes a provision watcher by name
-func (c *Client) DeleteProvisionWatcherByName(name string) errors.EdgeX {
+// DeleteProvisionWatcherByName deletes a provision watcher by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteProvisionWatcherByName(name string, ifRevision uint64) errors.EdgeX {
 	ctx := context.Background()
 
 	queryObj := map[string]any{nameField: name}
-	_, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONField(provisionWatcherTableName), queryObj)
+	result, err := c.ConnPool.Exec(ctx, sqlDeleteByJSONFieldIfRevision(provisionWatcherTableName), queryObj, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to delete provision watcher by name %s", name), err)
 	}
-	return nil
+	return checkIfRevisionWritten(result, common.ProvisionWatcherSystemEventType, name, ifRevision)
 }
 
-// UpdateProvisionWatcher updates a provision watcher
-func (c *Client) UpdateProvisionWatcher(pw model.ProvisionWatcher) errors.EdgeX {
+// UpdateProvisionWatcher updates a provision watcher, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateProvisionWatcher(pw model.ProvisionWatcher, ifRevision uint64) errors.EdgeX {
 	ctx := context.Background()
 
 	exists, edgeXErr := provisionWatcherNameExists(ctx, c.ConnPool, pw.Name)
@@ -148,10 +149,13 @@ func (c *Client) UpdateProvisionWatcher(pw model.ProvisionWatcher) errors.EdgeX
 	}
 
 	queryObj := map[string]any{nameField: pw.Name}
-	_, err = c.ConnPool.Exec(ctx, sqlUpdateColsByJSONCondCol(provisionWatcherTableName, contentCol), updatedProvisionWatcherJSONBytes, queryObj)
+	result, err := c.ConnPool.Exec(ctx, sqlUpdateContentAndIncrRevisionByJSONFieldIfRevision(provisionWatcherTableName), updatedProvisionWatcherJSONBytes, queryObj, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to update provision watcher by name '%s' from %s table", pw.Name, provisionWatcherTableName), err)
 	}
+	if edgeXErr = checkIfRevisionWritten(result, common.ProvisionWatcherSystemEventType, pw.Name, ifRevision); edgeXErr != nil {
+		return edgeXErr
+	}
 
 	return nil
 }
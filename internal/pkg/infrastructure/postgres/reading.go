@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,8 +9,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
 	dbModels "github.com/edgexfoundry/edgex-go/internal/pkg/infrastructure/postgres/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -214,6 +217,90 @@ func (c *Client) ReadingCountByDeviceNameAndResourceNamesAndTimeRange(deviceName
 		start, end, deviceName, resourceNames)
 }
 
+// ReadingsByValueFilter query the numeric readings whose value satisfies the filter, origin within the time range of the filter,
+// and optionally by the device and resource of the filter, with offset and limit, and returns the total count of them along
+// with the readings. The total count is skipped with the negative offset.
+func (c *Client) ReadingsByValueFilter(filter dataModels.ReadingValueFilter, offset int, limit int) (uint32, []model.Reading, errors.EdgeX) {
+	var totalCount uint32
+	if offset >= 0 {
+		count, err := c.ReadingCountByValueFilter(filter)
+		if err != nil {
+			return 0, nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		if count == 0 || offset >= int(count) {
+			return count, []model.Reading{}, nil
+		}
+		totalCount = count
+	}
+
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	columns, queryArgs := readingValueFilterQueryArgs(filter)
+	sqlStatement := sqlQueryAllReadingWithValueFilterAndTimeRangeDescByCol(filter.Operator, originCol, originCol, columns...)
+
+	readings, err := queryReadings(context.Background(), c.ConnPool, sqlStatement, append(queryArgs, offset, validLimit)...)
+	if err != nil {
+		return 0, nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query readings by value filter '%s'", filter.Operator), err)
+	}
+	return totalCount, readings, nil
+}
+
+// ReadingCountByValueFilter returns the count of the numeric readings whose value satisfies the filter, origin within the time range
+// of the filter, and optionally by the device and resource of the filter from db
+func (c *Client) ReadingCountByValueFilter(filter dataModels.ReadingValueFilter) (uint32, errors.EdgeX) {
+	columns, queryArgs := readingValueFilterQueryArgs(filter)
+	sqlStatement := sqlQueryCountReadingWithValueFilterAndTimeRangeCol(filter.Operator, originCol, columns...)
+
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlStatement, queryArgs...)
+}
+
+// ReadingsByCursor queries the readings by the given cursor query in descending order of origin, event id and device info id,
+// and returns the cursor of the next page, which is empty if there are no more readings
+func (c *Client) ReadingsByCursor(query dataModels.CursorQuery) ([]model.Reading, string, errors.EdgeX) {
+	var columns []string
+	queryArgs := []any{query.Start, query.End}
+	if query.DeviceName != "" {
+		columns = append(columns, deviceNameCol)
+		queryArgs = append(queryArgs, query.DeviceName)
+	}
+	if query.ResourceName != "" {
+		columns = append(columns, resourceNameCol)
+		queryArgs = append(queryArgs, query.ResourceName)
+	}
+	if query.Cursor != "" {
+		keys, err := parseCursor(query.Cursor, 3)
+		if err != nil {
+			return nil, "", errors.NewCommonEdgeXWrapper(err)
+		}
+		origin, parseErr := strconv.ParseInt(keys[0], 10, 64)
+		_, uuidErr := uuid.Parse(keys[1])
+		deviceInfoId, atoiErr := strconv.Atoi(keys[2])
+		if parseErr != nil || uuidErr != nil || atoiErr != nil {
+			return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid reading cursor '%s'", query.Cursor), nil)
+		}
+		queryArgs = append(queryArgs, origin, keys[1], deviceInfoId)
+	}
+	// query one more reading than the limit to know whether there is a next page
+	queryArgs = append(queryArgs, query.Limit+1)
+
+	sqlStatement := sqlQueryAllReadingWithKeysetAndTimeRange(query.Cursor != "", columns...)
+	readingDBModels, err := queryReadingDBModels(context.Background(), c.ConnPool, sqlStatement, queryArgs...)
+	if err != nil {
+		return nil, "", errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to query readings by cursor", err)
+	}
+
+	var nextCursor string
+	if len(readingDBModels) > query.Limit {
+		readingDBModels = readingDBModels[:query.Limit]
+		last := readingDBModels[len(readingDBModels)-1]
+		nextCursor = formatCursor(last.Origin, last.EventId, last.DeviceInfoId)
+	}
+	readings := make([]model.Reading, len(readingDBModels))
+	for i, r := range readingDBModels {
+		readings[i] = toReadingModel(r)
+	}
+	return readings, nextCursor, nil
+}
+
 func (c *Client) LatestReadingByOffset(offset uint32) (model.Reading, errors.EdgeX) {
 	ctx := context.Background()
 
@@ -228,63 +315,120 @@ func (c *Client) LatestReadingByOffset(offset uint32) (model.Reading, errors.Edg
 	return readings[0], nil
 }
 
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange calculates the statistics of the numeric readings by the specified device and resource,
+// origin within the time range, the readings are grouped into the buckets of the interval
+func (c *Client) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	sqlStatement := sqlQueryReadingAggregatesByTimeRangeCol(originCol, deviceNameCol, resourceNameCol)
+
+	rows, err := c.ConnPool.Query(context.Background(), sqlStatement, start, end, deviceName, resourceName, pkgCommon.NumericValueTypes, interval)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query reading aggregates by device '%s' and resource '%s'", deviceName, resourceName), err)
+	}
+
+	aggregates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (dataModels.ReadingAggregate, error) {
+		aggregate := dataModels.ReadingAggregate{
+			DeviceName:   deviceName,
+			ResourceName: resourceName,
+			Interval:     interval,
+		}
+		var count int64
+		scanErr := row.Scan(&aggregate.Start, &count, &aggregate.Min, &aggregate.Max, &aggregate.Avg, &aggregate.First, &aggregate.Last)
+		aggregate.Count = uint32(count)
+		return aggregate, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to ReadingAggregate model", err)
+	}
+
+	return aggregates, nil
+}
+
+// readingValueFilterQueryArgs returns the condition columns of the filter and the query args in the order expected by
+// constructWhereCondWithValueFilter
+func readingValueFilterQueryArgs(filter dataModels.ReadingValueFilter) ([]string, []any) {
+	var columns []string
+	queryArgs := []any{filter.Start, filter.End}
+	if filter.DeviceName != "" {
+		columns = append(columns, deviceNameCol)
+		queryArgs = append(queryArgs, filter.DeviceName)
+	}
+	if filter.ResourceName != "" {
+		columns = append(columns, resourceNameCol)
+		queryArgs = append(queryArgs, filter.ResourceName)
+	}
+	queryArgs = append(queryArgs, pkgCommon.NumericValueTypes, filter.Value)
+	if filter.Operator == dataModels.ValueOperatorBetween {
+		queryArgs = append(queryArgs, filter.UpperValue)
+	}
+	return columns, queryArgs
+}
+
 // queryReadings queries the data rows with given sql statement and passed args, converts the rows to map and unmarshal the data rows to the Reading model slice
 func queryReadings(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]model.Reading, errors.EdgeX) {
+	readingDBModels, err := queryReadingDBModels(ctx, connPool, sql, args...)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	readings := make([]model.Reading, len(readingDBModels))
+	for i, r := range readingDBModels {
+		readings[i] = toReadingModel(r)
+	}
+	return readings, nil
+}
+
+// queryReadingDBModels queries the data rows with given sql statement and passed args, and converts the rows to the Reading DB model slice
+func queryReadingDBModels(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]dbModels.Reading, errors.EdgeX) {
 	rows, err := connPool.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, pgClient.WrapDBError("query failed", err)
 	}
 
-	readings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Reading, error) {
-		var reading model.Reading
-
+	readingDBModels, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (dbModels.Reading, error) {
 		readingDBModel, err := pgx.RowToStructByNameLax[dbModels.Reading](row)
 		if err != nil {
-			return nil, pgClient.WrapDBError("failed to convert row to map", err)
-		}
-
-		// convert the BaseReading fields to BaseReading struct defined in contract
-		baseReading := readingDBModel.GetBaseReading()
-
-		if readingDBModel.BinaryValue != nil {
-			// reading type is BinaryReading
-			binaryReading := model.BinaryReading{
-				BaseReading: baseReading,
-				MediaType:   *readingDBModel.MediaType,
-				BinaryValue: readingDBModel.BinaryValue,
-			}
-			reading = binaryReading
-		} else if readingDBModel.ObjectValue != nil {
-			// reading type is ObjectReading
-			objReading := model.ObjectReading{
-				BaseReading: baseReading,
-				ObjectValue: readingDBModel.ObjectValue,
-			}
-			reading = objReading
-		} else if readingDBModel.Value != nil {
-			// reading type is SimpleReading
-			simpleReading := model.SimpleReading{
-				BaseReading: baseReading,
-				Value:       *readingDBModel.Value,
-			}
-			reading = simpleReading
-		} else {
-			// reading type is NullReading
-			nullReading := model.NullReading{
-				BaseReading: baseReading,
-				Value:       nil,
-			}
-			reading = nullReading
+			return dbModels.Reading{}, pgClient.WrapDBError("failed to convert row to map", err)
 		}
-
-		return reading, nil
+		return readingDBModel, nil
 	})
-
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	return readings, nil
+	return readingDBModels, nil
+}
+
+// toReadingModel converts the Reading DB model to the BinaryReading/ObjectReading/SimpleReading/NullReading model based on the
+// stored value columns
+func toReadingModel(readingDBModel dbModels.Reading) model.Reading {
+	// convert the BaseReading fields to BaseReading struct defined in contract
+	baseReading := readingDBModel.GetBaseReading()
+
+	if readingDBModel.BinaryValue != nil {
+		// reading type is BinaryReading
+		return model.BinaryReading{
+			BaseReading: baseReading,
+			MediaType:   *readingDBModel.MediaType,
+			BinaryValue: readingDBModel.BinaryValue,
+		}
+	} else if readingDBModel.ObjectValue != nil {
+		// reading type is ObjectReading
+		return model.ObjectReading{
+			BaseReading: baseReading,
+			ObjectValue: readingDBModel.ObjectValue,
+		}
+	} else if readingDBModel.Value != nil {
+		// reading type is SimpleReading
+		return model.SimpleReading{
+			BaseReading: baseReading,
+			Value:       *readingDBModel.Value,
+		}
+	}
+	// reading type is NullReading
+	return model.NullReading{
+		BaseReading: baseReading,
+		Value:       nil,
+	}
 }
 
 // deleteReadingsByOriginAndEventId delete the data rows with given sql statement and passed args
@@ -327,52 +471,27 @@ func deleteReadingsBySubQuery(ctx context.Context, tx pgx.Tx, subQuerySql string
 // addReadingsInTx converts reading interface to BinaryReading/ObjectReading/SimpleReading structs first based on the reading value type
 // and then perform the CopyFromSlice transaction to insert readings in batch
 func (c *Client) addReadingsInTx(tx pgx.Tx, readings []model.Reading, eventId string) error {
+	return c.addEventsReadingsInTx(tx, []model.Event{{Id: eventId, Readings: readings}})
+}
+
+// addEventsReadingsInTx inserts the readings of all the events with a single CopyFromSlice transaction
+func (c *Client) addEventsReadingsInTx(tx pgx.Tx, events []model.Event) error {
 	var readingDBModels []dbModels.Reading
+	// eventIds holds the event id of each reading in readingDBModels
+	var eventIds []string
 
-	for _, r := range readings {
-		baseReading := r.GetBaseReading()
-		if baseReading.Id == "" {
-			baseReading.Id = uuid.New().String()
-		} else {
-			_, err := uuid.Parse(baseReading.Id)
+	for _, e := range events {
+		for _, r := range e.Readings {
+			readingDBModel, err := toReadingDBModel(r)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+				return err
 			}
+			readingDBModels = append(readingDBModels, readingDBModel)
+			eventIds = append(eventIds, e.Id)
 		}
-
-		var readingDBModel dbModels.Reading
-		switch contractReadingModel := r.(type) {
-		case model.BinaryReading:
-			// convert BinaryReading struct to Reading DB model
-			readingDBModel = dbModels.Reading{
-				BaseReading: baseReading,
-				BinaryReading: dbModels.BinaryReading{
-					BinaryValue: contractReadingModel.BinaryValue,
-					MediaType:   &contractReadingModel.MediaType,
-				},
-			}
-		case model.ObjectReading:
-			// convert ObjectReading struct to Reading DB model
-			readingDBModel = dbModels.Reading{
-				BaseReading: baseReading,
-				ObjectReading: dbModels.ObjectReading{
-					ObjectValue: contractReadingModel.ObjectValue,
-				},
-			}
-		case model.SimpleReading:
-			// convert SimpleReading struct to Reading DB model
-			readingDBModel = dbModels.Reading{
-				BaseReading:   baseReading,
-				SimpleReading: dbModels.SimpleReading{Value: &contractReadingModel.Value},
-			}
-		case model.NullReading:
-			readingDBModel = dbModels.Reading{
-				BaseReading: baseReading,
-			}
-		default:
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert reading to none of BinaryReading/ObjectReading/SimpleReading structs", nil)
-		}
-		readingDBModels = append(readingDBModels, readingDBModel)
+	}
+	if len(readingDBModels) == 0 {
+		return nil
 	}
 
 	// insert readingDBModels slice in batch
@@ -398,7 +517,7 @@ func (c *Client) addReadingsInTx(tx pgx.Tx, readings []model.Reading, eventId st
 			}
 
 			return []any{
-				eventId,
+				eventIds[i],
 				deviceInfoId,
 				r.Origin,
 				r.Value,
@@ -413,3 +532,48 @@ func (c *Client) addReadingsInTx(tx pgx.Tx, readings []model.Reading, eventId st
 
 	return nil
 }
+
+// toReadingDBModel converts the reading interface to BinaryReading/ObjectReading/SimpleReading structs based on the reading value type
+func toReadingDBModel(r model.Reading) (dbModels.Reading, error) {
+	baseReading := r.GetBaseReading()
+	if baseReading.Id == "" {
+		baseReading.Id = uuid.New().String()
+	} else {
+		_, err := uuid.Parse(baseReading.Id)
+		if err != nil {
+			return dbModels.Reading{}, errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+		}
+	}
+
+	switch contractReadingModel := r.(type) {
+	case model.BinaryReading:
+		// convert BinaryReading struct to Reading DB model
+		return dbModels.Reading{
+			BaseReading: baseReading,
+			BinaryReading: dbModels.BinaryReading{
+				BinaryValue: contractReadingModel.BinaryValue,
+				MediaType:   &contractReadingModel.MediaType,
+			},
+		}, nil
+	case model.ObjectReading:
+		// convert ObjectReading struct to Reading DB model
+		return dbModels.Reading{
+			BaseReading: baseReading,
+			ObjectReading: dbModels.ObjectReading{
+				ObjectValue: contractReadingModel.ObjectValue,
+			},
+		}, nil
+	case model.SimpleReading:
+		// convert SimpleReading struct to Reading DB model
+		return dbModels.Reading{
+			BaseReading:   baseReading,
+			SimpleReading: dbModels.SimpleReading{Value: &contractReadingModel.Value},
+		}, nil
+	case model.NullReading:
+		return dbModels.Reading{
+			BaseReading: baseReading,
+		}, nil
+	default:
+		return dbModels.Reading{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert reading to none of BinaryReading/ObjectReading/SimpleReading structs", nil)
+	}
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange query the reading rollups of the interval by the specified device and resource,
+// bucket within the time range, offset, and limit
+func (c *Client) ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, interval int64, start int64, end int64, offset int, limit int) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	sqlStatement := sqlQueryReadingRollupsWithPaginationAndTimeRange(deviceNameCol, resourceNameCol, bucketIntervalCol)
+
+	rows, err := c.ConnPool.Query(context.Background(), sqlStatement, start, end, deviceName, resourceName, interval, offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query reading rollups by device '%s' and resource '%s'", deviceName, resourceName), err)
+	}
+
+	rollups, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (dataModels.ReadingAggregate, error) {
+		rollup := dataModels.ReadingAggregate{
+			DeviceName:   deviceName,
+			ResourceName: resourceName,
+			Interval:     interval,
+		}
+		var count int64
+		scanErr := row.Scan(&rollup.Start, &count, &rollup.Min, &rollup.Max, &rollup.Avg, &rollup.First, &rollup.Last)
+		rollup.Count = uint32(count)
+		return rollup, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to ReadingAggregate model", err)
+	}
+
+	return rollups, nil
+}
+
+// DeleteReadingRollupsByAgeAndInterval deletes the reading rollups of the interval whose buckets are entirely older than age
+func (c *Client) DeleteReadingRollupsByAgeAndInterval(age int64, interval int64) errors.EdgeX {
+	expireTimestamp := time.Now().UnixNano() - age - interval
+	sqlStatement := sqlDeleteTimeRangeByColumn(readingRollupTableName, bucketCol, bucketIntervalCol)
+
+	_, err := c.ConnPool.Exec(context.Background(), sqlStatement, expireTimestamp, interval)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete reading rollups by age '%d' nanoseconds and interval '%d' nanoseconds", age, interval), err)
+	}
+	return nil
+}
+
+// upsertReadingRollupsBySubQuery summarizes the numeric readings with event_id in the range of the sub query into the buckets of
+// the interval, and merges the summaries into the existing reading rollups
+func upsertReadingRollupsBySubQuery(ctx context.Context, tx pgx.Tx, subQuerySql string, interval int64, args ...any) errors.EdgeX {
+	sqlStatement := sqlUpsertReadingRollupsByEventIdSubQuery(subQuerySql, len(args))
+	upsertArgs := append(slices.Clone(args), pkgCommon.NumericValueTypes, interval)
+
+	_, err := tx.Exec(ctx, sqlStatement, upsertArgs...)
+	if err != nil {
+		return pgClient.WrapDBError("reading rollup(s) upsert failed", err)
+	}
+	return nil
+}
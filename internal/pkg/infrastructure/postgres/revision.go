@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	stdErrs "errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// entityRevisionTables maps the types of the core-metadata entities having the revision to their tables
+var entityRevisionTables = map[string]string{
+	common.DeviceSystemEventType:           deviceTableName,
+	common.DeviceProfileSystemEventType:    deviceProfileTableName,
+	common.DeviceServiceSystemEventType:    deviceServiceTableName,
+	common.ProvisionWatcherSystemEventType: provisionWatcherTableName,
+}
+
+// EntityRevision returns the revision of the device, device profile, device service or provision watcher by name
+func (c *Client) EntityRevision(entityType string, name string) (uint64, errors.EdgeX) {
+	table, ok := entityRevisionTables[entityType]
+	if !ok {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("entity type %s has no revision", entityType), nil)
+	}
+	return queryRevisionByName(context.Background(), c.ConnPool, table, entityType, name)
+}
+
+// queryRevisionByName queries the revision column of the row in the table by the name field of the content
+func queryRevisionByName(ctx context.Context, connPool *pgxpool.Pool, table string, entityType string, name string) (uint64, errors.EdgeX) {
+	var revision int64
+	queryObj := map[string]any{nameField: name}
+	err := connPool.QueryRow(ctx, sqlQueryColByJSONField(table, revisionCol), queryObj).Scan(&revision)
+	if err != nil {
+		if stdErrs.Is(err, pgx.ErrNoRows) {
+			return 0, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no %s with name '%s' found", entityType, name), err)
+		}
+		return 0, pgClient.WrapDBError(fmt.Sprintf("failed to query the revision of %s '%s'", entityType, name), err)
+	}
+	return uint64(revision), nil
+}
+
+// checkIfRevisionWritten returns the precondition failed error if the conditional write at ifRevision affected no row,
+// which means the entity is no longer at the revision. Nothing is checked for the unconditional write of ifRevision 0.
+func checkIfRevisionWritten(result pgconn.CommandTag, entityType string, name string, ifRevision uint64) errors.EdgeX {
+	if ifRevision > 0 && result.RowsAffected() == 0 {
+		return utils.NewPreconditionFailedError(entityType, name, utils.ETag(ifRevision))
+	}
+	return nil
+}
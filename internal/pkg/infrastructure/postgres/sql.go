@@ -12,6 +12,10 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 const (
@@ -37,6 +41,35 @@ func sqlInsert(table string, columns ...string) string {
 	return fmt.Sprintf("INSERT INTO %s(%s) VALUES (%s)", table, columnNames, valueNames)
 }
 
+// sqlUpsertReadingRollupsByEventIdSubQuery returns the SQL statement for summarizing the numeric readings of the events selected by
+// the sub-query into the buckets of the interval, and merging the summaries into the reading rollup table
+func sqlUpsertReadingRollupsByEventIdSubQuery(subQuerySql string, subQueryParamCount int) string {
+	// note that this is a prepared statement with parameters beginning with the sub-query parameters,
+	// so the valuetype array and the interval are the first and second parameters after the sub-query parameters
+	valueTypeParam := subQueryParamCount + 1
+	intervalParam := subQueryParamCount + 2
+	numericValue := valueCol + "::double precision"
+
+	return fmt.Sprintf(
+		`INSERT INTO %s (%s, %s, %s, %s, count, min, max, sum, first, last)
+		SELECT %s, %s, $%d::bigint, %s / $%d * $%d AS %s, COUNT(*), MIN(%s), MAX(%s), SUM(%s),
+		(ARRAY_AGG(%s ORDER BY %s ASC))[1], (ARRAY_AGG(%s ORDER BY %s DESC))[1]
+		FROM %s JOIN %s on reading.device_info_id = device_info.id
+		WHERE %s = ANY ( %s ) AND %s = ANY ($%d) AND %s IS NOT NULL
+		GROUP BY %s, %s, %s
+		ON CONFLICT (%s, %s, %s, %s) DO UPDATE SET
+		count = reading_rollup.count + EXCLUDED.count, min = LEAST(reading_rollup.min, EXCLUDED.min),
+		max = GREATEST(reading_rollup.max, EXCLUDED.max), sum = reading_rollup.sum + EXCLUDED.sum, last = EXCLUDED.last`,
+		readingRollupTableName, deviceNameCol, resourceNameCol, bucketIntervalCol, bucketCol,
+		deviceNameCol, resourceNameCol, intervalParam, originCol, intervalParam, intervalParam, bucketCol,
+		numericValue, numericValue, numericValue,
+		numericValue, originCol, numericValue, originCol,
+		readingTableName, deviceInfoTableName,
+		eventIdFKCol, subQuerySql, valueTypeCol, valueTypeParam, valueCol,
+		deviceNameCol, resourceNameCol, bucketCol,
+		deviceNameCol, resourceNameCol, bucketIntervalCol, bucketCol)
+}
+
 // ----------------------------------------------------------------------------------
 // SQL statements for SELECT operations
 // ----------------------------------------------------------------------------------
@@ -188,6 +221,32 @@ func sqlQueryAllReadingWithPaginationAndTimeRangeDescByCol(timeRangeCol string,
 		columnCount+3, columnCount+4)
 }
 
+// sqlQueryAllEventWithKeysetAndTimeRange returns the SQL statement for selecting the events by the given columns composed of the
+// where condition with a time range by origin, descending by the keyset of origin and id. If hasCursor is true, only the events
+// after the cursor in the keyset order are selected.
+func sqlQueryAllEventWithKeysetAndTimeRange(hasCursor bool, columns ...string) string {
+	keyCols := []string{"event." + originCol, "event." + idCol}
+	whereCondition, paramCount := constructWhereCondWithKeyset(originCol, keyCols, hasCursor, columns...)
+
+	return fmt.Sprintf(
+		"SELECT %s FROM %s join %s on event.device_info_id = device_info.id WHERE %s ORDER BY %s DESC, %s DESC LIMIT $%d",
+		eventColumns, eventTableName, deviceInfoTableName,
+		whereCondition, keyCols[0], keyCols[1], paramCount+1)
+}
+
+// sqlQueryAllReadingWithKeysetAndTimeRange returns the SQL statement for selecting the readings with the device_info_id by the given
+// columns composed of the where condition with a time range by origin, descending by the keyset of origin, event_id and device_info_id.
+// If hasCursor is true, only the readings after the cursor in the keyset order are selected.
+func sqlQueryAllReadingWithKeysetAndTimeRange(hasCursor bool, columns ...string) string {
+	keyCols := []string{"reading." + originCol, "reading." + eventIdFKCol, "reading." + deviceInfoIdFKCol}
+	whereCondition, paramCount := constructWhereCondWithKeyset(originCol, keyCols, hasCursor, columns...)
+
+	return fmt.Sprintf(
+		"SELECT %s, reading.%s FROM %s join %s on reading.device_info_id = device_info.id WHERE %s ORDER BY %s DESC, %s DESC, %s DESC LIMIT $%d",
+		readingColumns, deviceInfoIdFKCol, readingTableName, deviceInfoTableName,
+		whereCondition, keyCols[0], keyCols[1], keyCols[2], paramCount+1)
+}
+
 // sqlQueryAllByStatusWithPaginationAndTimeRange returns the SQL statement for selecting all rows from the table by status with pagination and a time range.
 func sqlQueryAllByStatusWithPaginationAndTimeRange(table string) string {
 	return fmt.Sprintf("SELECT * FROM %s WHERE %s = $1 AND %s >= $2 AND %s <= $3 ORDER BY %s OFFSET $4 LIMIT $5", table, statusCol, createdCol, createdCol, createdCol)
@@ -242,16 +301,50 @@ func sqlQueryContentWithTimeRangeAndPagination(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) BETWEEN $1 AND $2 AND content @> $3::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $4 LIMIT $5", table, createdField, createdField)
 }
 
+// sqlQueryContentWithTimeRangeAndPaginationDesc returns the SQL statement for selecting content column from the table by the given time range and JSON query string with pagination in descending order of creation
+func sqlQueryContentWithTimeRangeAndPaginationDesc(table string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) BETWEEN $1 AND $2 AND content @> $3::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) DESC, id DESC OFFSET $4 LIMIT $5", table, createdField, createdField)
+}
+
 // sqlQueryContentByJSONField returns the SQL statement for selecting content column in the table by the given JSON query string
 func sqlQueryContentByJSONField(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb", table)
 }
 
+// sqlQueryColByJSONField returns the SQL statement for selecting the given column in the table by the given JSON query string
+func sqlQueryColByJSONField(table string, col string) string {
+	return fmt.Sprintf("SELECT %s FROM %s WHERE content @> $1::jsonb", col, table)
+}
+
+// sqlQueryContentAndColByJSONFieldForUpdate returns the SQL statement for selecting the content and the given column of a row in the table by the given JSON query string,
+// the row is locked until the end of the transaction.
+func sqlQueryContentAndColByJSONFieldForUpdate(table string, col string) string {
+	return fmt.Sprintf("SELECT content, %s FROM %s WHERE content @> $1::jsonb FOR UPDATE", col, table)
+}
+
 // sqlQueryContentByJSONFieldWithPagination returns the SQL statement for selecting content column in the table by the given JSON query string with pagination
 func sqlQueryContentByJSONFieldWithPagination(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $2 LIMIT $3", table, createdField)
 }
 
+// sqlQueryContentByJSONFieldWithPaginationDescByField returns the SQL statement for selecting content column in the table by the given JSON query string with pagination
+// in descending order of the numeric JSON field
+func sqlQueryContentByJSONFieldWithPaginationDescByField(table string, field string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) DESC OFFSET $2 LIMIT $3", table, field)
+}
+
+// sqlQueryContentByCondWithOrderAndPagination returns the SQL statement for selecting content column in the table by the
+// given where condition having paramCount parameters, the rows are sorted by the orderBy expression with pagination
+func sqlQueryContentByCondWithOrderAndPagination(table string, whereCondition string, orderBy string, paramCount int) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE %s ORDER BY %s OFFSET $%d LIMIT $%d", table, whereCondition, orderBy, paramCount+1, paramCount+2)
+}
+
+// sqlQueryContentByJSONFieldAndUpperLimitColWithPagination returns the SQL statement for selecting content column by the given JSON query string
+// and upperLimitCol less than or equal to the given value in pagination, the rows are ordered by upperLimitCol and the NULL upperLimitCol is excluded
+func sqlQueryContentByJSONFieldAndUpperLimitColWithPagination(table string, upperLimitCol string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb AND %s IS NOT NULL AND %s <= $2 ORDER BY %s OFFSET $3 LIMIT $4", table, upperLimitCol, upperLimitCol, upperLimitCol)
+}
+
 // sqlQueryContentByJSONFieldTimeRange returns the SQL statement for selecting content column by the given time range of the JSON field name
 //func sqlQueryContentByJSONFieldTimeRange(table string, field string) string {
 //	return fmt.Sprintf("SELECT content FROM %s WHERE (content->'%s')::bigint  >= $1 AND (content->'%s')::bigint <= $2 ORDER BY %s OFFSET $3 LIMIT $4", table, field, field, createdCol)
@@ -311,6 +404,67 @@ func sqlQueryCountReadingByTimeRangeCol(timeRangeCol string, arrayColNames []str
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s join %s on reading.device_info_id = device_info.id WHERE %s", readingTableName, deviceInfoTableName, whereCondition)
 }
 
+// sqlQueryReadingAggregatesByTimeRangeCol returns the SQL statement for calculating the count/min/max/avg/first/last of the
+// numeric reading values in the time range by timeRangeCol, the readings are grouped into the buckets of the interval
+// starting from the lower limit of the time range
+func sqlQueryReadingAggregatesByTimeRangeCol(timeRangeCol string, columns ...string) string {
+	whereCondition := constructWhereCondWithTimeRange(timeRangeCol, timeRangeCol, nil, columns...)
+	columnCount := len(columns)
+	// note that this is a prepared statement with parameters beginning with two timeRangeCol and then columns conditions,
+	// so the valuetype array and the interval are the third and forth parameters after the columns conditions
+	valueTypeParam := columnCount + 3
+	intervalParam := columnCount + 4
+	numericValue := valueCol + "::double precision"
+
+	return fmt.Sprintf(
+		`SELECT $1 + (%s - $1) / $%d * $%d AS bucket, COUNT(*) AS count,
+		MIN(%s) AS min, MAX(%s) AS max, AVG(%s) AS avg,
+		(ARRAY_AGG(%s ORDER BY %s ASC))[1] AS first, (ARRAY_AGG(%s ORDER BY %s DESC))[1] AS last
+		FROM %s JOIN %s on reading.device_info_id = device_info.id
+		WHERE %s AND %s = ANY ($%d) AND %s IS NOT NULL
+		GROUP BY bucket ORDER BY bucket`,
+		timeRangeCol, intervalParam, intervalParam,
+		numericValue, numericValue, numericValue,
+		numericValue, timeRangeCol, numericValue, timeRangeCol,
+		readingTableName, deviceInfoTableName,
+		whereCondition, valueTypeCol, valueTypeParam, valueCol)
+}
+
+// sqlQueryAllReadingWithValueFilterAndTimeRangeDescByCol returns the SQL statement for selecting the numeric readings whose value
+// satisfies the operator by the given columns composed of the where condition with a time range by timeRangeCol, desc by descCol and pagination
+func sqlQueryAllReadingWithValueFilterAndTimeRangeDescByCol(operator string, timeRangeCol string, descCol string, columns ...string) string {
+	whereCondition, paramCount := constructWhereCondWithValueFilter(operator, timeRangeCol, columns...)
+
+	return fmt.Sprintf(
+		"SELECT %s FROM %s join %s on reading.device_info_id = device_info.id WHERE %s ORDER BY %s DESC OFFSET $%d LIMIT $%d",
+		readingColumns, readingTableName, deviceInfoTableName,
+		whereCondition, descCol,
+		// note that this is a prepared statement with parameters beginning with the where conditions,
+		// so adding 1 and 2 for OFFSET, LIMIT parameters, respectively
+		paramCount+1, paramCount+2)
+}
+
+// sqlQueryCountReadingWithValueFilterAndTimeRangeCol returns the SQL statement for counting the numeric readings whose value
+// satisfies the operator by the given columns composed of the where condition with a time range by timeRangeCol
+func sqlQueryCountReadingWithValueFilterAndTimeRangeCol(operator string, timeRangeCol string, columns ...string) string {
+	whereCondition, _ := constructWhereCondWithValueFilter(operator, timeRangeCol, columns...)
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s join %s on reading.device_info_id = device_info.id WHERE %s", readingTableName, deviceInfoTableName, whereCondition)
+}
+
+// sqlQueryReadingRollupsWithPaginationAndTimeRange returns the SQL statement for selecting the reading rollups by the given columns
+// composed of the where condition with the bucket within the time range and pagination, the average is calculated by sum/count
+func sqlQueryReadingRollupsWithPaginationAndTimeRange(columns ...string) string {
+	columnCount := len(columns)
+	whereCondition := constructWhereCondWithTimeRange(bucketCol, bucketCol, nil, columns...)
+
+	return fmt.Sprintf(
+		"SELECT %s, count, min, max, sum / count, first, last FROM %s WHERE %s ORDER BY %s OFFSET $%d LIMIT $%d",
+		bucketCol, readingRollupTableName, whereCondition, bucketCol,
+		// note that this is a prepared statement with parameters beginning with two bucket time range
+		// and then columns conditions, so adding 3 and 4 for OFFSET, LIMIT parameters, respectively
+		columnCount+3, columnCount+4)
+}
+
 // sqlQueryCountByColAndLikePat returns the SQL statement for counting the number of rows by the given column name with LIKE pattern.
 func sqlQueryCountByColAndLikePat(table string, columns ...string) string {
 	whereCondition := constructWhereLikeCond(columns...)
@@ -322,6 +476,16 @@ func sqlQueryCountByJSONField(table string) string {
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE content @> $1::jsonb", table)
 }
 
+// sqlQueryCountByCond returns the SQL statement for counting the number of rows in the table by the given where condition
+func sqlQueryCountByCond(table string, whereCondition string) string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, whereCondition)
+}
+
+// sqlQueryCountByTimeRangeAndJSONField returns the SQL statement for counting the number of rows by the given time range and JSON query string
+func sqlQueryCountByTimeRangeAndJSONField(table string) string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) BETWEEN $1 AND $2 AND content @> $3::jsonb", table, createdField)
+}
+
 // sqlQueryCountByTimeRange returns the SQL statement for counting the number of rows by the given time range
 func sqlQueryCountByTimeRange(table string) string {
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) BETWEEN $1 AND $2", table, createdField)
@@ -375,6 +539,41 @@ func sqlUpdateContentById(table string) string {
 	return fmt.Sprintf("UPDATE %s SET %s = $1 WHERE %s = $2", table, contentCol, idCol)
 }
 
+// sqlUpdateContentAndIncrRevisionById returns the SQL statement for updating the content and increasing the revision of a row in the table by id.
+func sqlUpdateContentAndIncrRevisionById(table string) string {
+	return fmt.Sprintf("UPDATE %s SET %s = $1, %s = %s + 1 WHERE %s = $2", table, contentCol, revisionCol, revisionCol, idCol)
+}
+
+// sqlUpdateContentAndIncrRevisionByJSONField returns the SQL statement for updating the content and increasing the revision of a row in the table by the given JSON query string.
+func sqlUpdateContentAndIncrRevisionByJSONField(table string) string {
+	return fmt.Sprintf("UPDATE %s SET %s = $1, %s = %s + 1 WHERE content @> $2::jsonb", table, contentCol, revisionCol, revisionCol)
+}
+
+// sqlUpdateContentAndIncrRevisionByIdIfRevision returns the SQL statement for updating the content and increasing the revision of a row in the table by id,
+// the row is only updated if it is at the revision of the third parameter unless the parameter is 0.
+func sqlUpdateContentAndIncrRevisionByIdIfRevision(table string) string {
+	return fmt.Sprintf("%s AND %s", sqlUpdateContentAndIncrRevisionById(table), constructIfRevisionCond(3))
+}
+
+// sqlUpdateContentAndIncrRevisionByJSONFieldIfRevision returns the SQL statement for updating the content and increasing the revision of a row in the table by the given JSON query string,
+// the row is only updated if it is at the revision of the third parameter unless the parameter is 0.
+func sqlUpdateContentAndIncrRevisionByJSONFieldIfRevision(table string) string {
+	return fmt.Sprintf("%s AND %s", sqlUpdateContentAndIncrRevisionByJSONField(table), constructIfRevisionCond(3))
+}
+
+// sqlUpdateDeviceContentAndIncrRevisionByJSONField returns the SQL statement for updating the content and increasing the revision of a device by the given JSON query string,
+// the last connected time of the device is set to its modified time if the operating state changes to UP.
+func sqlUpdateDeviceContentAndIncrRevisionByJSONField() string {
+	return fmt.Sprintf("UPDATE %s SET %s = $1, %s = %s + 1, %s = CASE WHEN $1::jsonb->>'%s' = '%s' AND %s->>'%s' IS DISTINCT FROM '%s' THEN ($1::jsonb->>'%s')::bigint ELSE %s END WHERE content @> $2::jsonb",
+		deviceTableName, contentCol, revisionCol, revisionCol, lastConnectedCol, operatingStateField, model.Up, contentCol, operatingStateField, model.Up, modifiedField, lastConnectedCol)
+}
+
+// sqlUpdateDeviceContentAndIncrRevisionByJSONFieldIfRevision returns the SQL statement of sqlUpdateDeviceContentAndIncrRevisionByJSONField,
+// the device is only updated if it is at the revision of the third parameter unless the parameter is 0.
+func sqlUpdateDeviceContentAndIncrRevisionByJSONFieldIfRevision() string {
+	return fmt.Sprintf("%s AND %s", sqlUpdateDeviceContentAndIncrRevisionByJSONField(), constructIfRevisionCond(3))
+}
+
 // ----------------------------------------------------------------------------------
 // SQL statements for DELETE operations
 // ----------------------------------------------------------------------------------
@@ -384,6 +583,11 @@ func sqlDeleteById(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s = $1", table, idCol)
 }
 
+// sqlDeleteByIdReturningContent returns the SQL statement for deleting a row from the table by id and returning the content of the deleted row.
+func sqlDeleteByIdReturningContent(table string) string {
+	return fmt.Sprintf("%s RETURNING %s", sqlDeleteById(table), contentCol)
+}
+
 // sqlDeleteByAge returns the SQL statement for deleting rows from the table by created timestamp.
 func sqlDeleteByAge(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s < NOW() - INTERVAL '1 millisecond' * $1", table, createdCol)
@@ -404,11 +608,23 @@ func sqlDeleteByJSONField(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE content @> $1::jsonb", table)
 }
 
+// sqlDeleteByJSONFieldIfRevision returns the SQL statement for deleting rows from the table by the given JSON query string,
+// the rows are only deleted if they are at the revision of the second parameter unless the parameter is 0.
+func sqlDeleteByJSONFieldIfRevision(table string) string {
+	return fmt.Sprintf("%s AND %s", sqlDeleteByJSONField(table), constructIfRevisionCond(2))
+}
+
 // sqlDeleteByJSONFieldAndAge returns the SQL statement for deleting rows from the table by the given column and created timestamp.
 func sqlDeleteByJSONFieldAndAge(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE content @> $1::jsonb AND COALESCE((content->>'%s')::bigint, 0) < (EXTRACT(EPOCH FROM NOW()) * 1000)::bigint - $2", table, createdField)
 }
 
+// sqlDeleteByJSONFieldAndUpperLimitField returns the SQL statement for deleting rows from the table by the given JSON query string
+// and the numeric JSON field less than the given value
+func sqlDeleteByJSONFieldAndUpperLimitField(table string, field string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE content @> $1::jsonb AND COALESCE((content->>'%s')::bigint, 0) < $2", table, field)
+}
+
 // sqlDeleteTimeRangeByColumn returns the SQL statement for deleting rows from the table by time range with the specified column
 // the time range is calculated from the caller function since the interval unit might be different
 func sqlDeleteTimeRangeByColumn(table string, upperLimitTimeRangeCol string, cols ...string) string {
@@ -486,6 +702,52 @@ func constructWhereCondWithTimeRange(lowerLimitTimeRangeCol, upperLimitTimeRange
 	return strings.Join(conditions, " AND ")
 }
 
+// constructWhereCondWithValueFilter constructs the WHERE condition for the given columns with time range and the numeric
+// comparison of the reading value, and returns the condition with the number of parameters it uses. The parameters begin with
+// two timeRangeCol and then columns conditions, followed by the numeric valuetype array and the compared values.
+// The value is only cast within the CASE of the numeric valuetype, as PostgreSQL doesn't guarantee the evaluation order of
+// the AND conditions, and casting the value of a non-numeric reading would fail the whole query.
+func constructWhereCondWithValueFilter(operator string, timeRangeCol string, columns ...string) (string, int) {
+	whereCondition := constructWhereCondWithTimeRange(timeRangeCol, timeRangeCol, nil, columns...)
+	valueTypeParam := len(columns) + 3
+	valueParam := valueTypeParam + 1
+	numericValue := fmt.Sprintf("(CASE WHEN %s = ANY ($%d) THEN %s::double precision END)", valueTypeCol, valueTypeParam, valueCol)
+
+	var valueCondition string
+	paramCount := valueParam
+	switch operator {
+	case dataModels.ValueOperatorGreaterThan:
+		valueCondition = fmt.Sprintf("%s > $%d", numericValue, valueParam)
+	case dataModels.ValueOperatorLessThan:
+		valueCondition = fmt.Sprintf("%s < $%d", numericValue, valueParam)
+	case dataModels.ValueOperatorBetween:
+		valueCondition = fmt.Sprintf("%s BETWEEN $%d AND $%d", numericValue, valueParam, valueParam+1)
+		paramCount++
+	default:
+		valueCondition = fmt.Sprintf("%s = $%d", numericValue, valueParam)
+	}
+
+	return fmt.Sprintf("%s AND %s = ANY ($%d) AND %s IS NOT NULL AND %s", whereCondition, valueTypeCol, valueTypeParam, valueCol, valueCondition), paramCount
+}
+
+// constructWhereCondWithKeyset constructs the WHERE condition for the given columns with time range, and the row comparison
+// of the keyCols less than the cursor if hasCursor is true, and returns the condition with the number of parameters it uses.
+// The parameters begin with two timeRangeCol and then columns conditions, followed by the cursor values of the keyCols.
+func constructWhereCondWithKeyset(timeRangeCol string, keyCols []string, hasCursor bool, columns ...string) (string, int) {
+	whereCondition := constructWhereCondWithTimeRange(timeRangeCol, timeRangeCol, nil, columns...)
+	paramCount := len(columns) + 2
+	if !hasCursor {
+		return whereCondition, paramCount
+	}
+
+	cursorParams := make([]string, len(keyCols))
+	for i := range keyCols {
+		paramCount++
+		cursorParams[i] = fmt.Sprintf("$%d", paramCount)
+	}
+	return fmt.Sprintf("%s AND (%s) < (%s)", whereCondition, strings.Join(keyCols, ", "), strings.Join(cursorParams, ", ")), paramCount
+}
+
 // constructWhereLikeCond constructs the WHERE condition for the given columns with LIKE operator
 func constructWhereLikeCond(columns ...string) string {
 	columnCount := len(columns)
@@ -509,3 +771,8 @@ func constructUpdatedValues(columns ...string) string {
 
 	return strings.Join(conditions, ", ")
 }
+
+// constructIfRevisionCond constructs the condition of the revision column matching the given parameter, which is always true if the parameter is 0.
+func constructIfRevisionCond(param int) string {
+	return fmt.Sprintf("($%d::bigint = 0 OR %s = $%d::bigint)", param, revisionCol, param)
+}
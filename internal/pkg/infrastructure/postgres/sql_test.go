@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+func TestConstructWhereCondWithValueFilter(t *testing.T) {
+	guardedValue := "(CASE WHEN valuetype = ANY ($5) THEN value::double precision END)"
+
+	tests := []struct {
+		name               string
+		operator           string
+		expectedCondition  string
+		expectedParamCount int
+	}{
+		{"greater than", dataModels.ValueOperatorGreaterThan, guardedValue + " > $6", 6},
+		{"less than", dataModels.ValueOperatorLessThan, guardedValue + " < $6", 6},
+		{"equal", dataModels.ValueOperatorEqual, guardedValue + " = $6", 6},
+		{"between", dataModels.ValueOperatorBetween, guardedValue + " BETWEEN $6 AND $7", 7},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			condition, paramCount := constructWhereCondWithValueFilter(testCase.operator, originCol, deviceNameCol, resourceNameCol)
+
+			assert.Equal(t, testCase.expectedParamCount, paramCount)
+			assert.True(t, strings.HasSuffix(condition, testCase.expectedCondition), "unexpected value condition: %s", condition)
+			// the readings of the string and numeric value types are stored in the same table, so the value must never be cast
+			// outside the guard of the numeric value types, or the string readings in the time range would fail the query
+			assert.Equal(t, 1, strings.Count(condition, "::double precision"))
+			assert.Equal(t, strings.Count(condition, "::double precision"), strings.Count(condition, guardedValue))
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -84,6 +84,11 @@ func (c *Client) SubscriptionByName(name string) (models.Subscription, errors.Ed
 	return subscription, nil
 }
 
+// SubscriptionRevision returns the revision of the subscription by name
+func (c *Client) SubscriptionRevision(name string) (uint64, errors.EdgeX) {
+	return queryRevisionByName(context.Background(), c.ConnPool, subscriptionTableName, "subscription", name)
+}
+
 // SubscriptionsByCategory queries the subscription by category
 func (c *Client) SubscriptionsByCategory(offset, limit int, category string) ([]models.Subscription, errors.EdgeX) {
 	offset, validLimit := getValidOffsetAndLimit(offset, limit)
@@ -123,18 +128,18 @@ func (c *Client) SubscriptionsByReceiver(offset, limit int, receiver string) ([]
 	return subscriptions, nil
 }
 
-// DeleteSubscriptionByName deletes the subscription by name
-func (c *Client) DeleteSubscriptionByName(name string) errors.EdgeX {
+// DeleteSubscriptionByName deletes the subscription by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteSubscriptionByName(name string, ifRevision uint64) errors.EdgeX {
 	queryObj := map[string]any{nameField: name}
-	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByJSONField(subscriptionTableName), queryObj)
+	result, err := c.ConnPool.Exec(context.Background(), sqlDeleteByJSONFieldIfRevision(subscriptionTableName), queryObj, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to delete subscription by name %s", name), err)
 	}
-	return nil
+	return checkIfRevisionWritten(result, "subscription", name, ifRevision)
 }
 
-// UpdateSubscription updates the subscription
-func (c *Client) UpdateSubscription(s models.Subscription) errors.EdgeX {
+// UpdateSubscription updates the subscription, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateSubscription(s models.Subscription, ifRevision uint64) errors.EdgeX {
 	modified := time.Now().UTC().UnixMilli()
 	s.Modified = modified
 
@@ -143,10 +148,13 @@ func (c *Client) UpdateSubscription(s models.Subscription) errors.EdgeX {
 		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal Subscription model", err)
 	}
 
-	_, err = c.ConnPool.Exec(context.Background(), sqlUpdateContentById(subscriptionTableName), dataBytes, s.Id)
+	result, err := c.ConnPool.Exec(context.Background(), sqlUpdateContentAndIncrRevisionByIdIfRevision(subscriptionTableName), dataBytes, s.Id, ifRevision)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to update row by subscription id '%s' from subscription table", s.Id), err)
 	}
+	if edgeXErr := checkIfRevisionWritten(result, "subscription", s.Name, ifRevision); edgeXErr != nil {
+		return edgeXErr
+	}
 
 	return nil
 }
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -66,6 +66,21 @@ func (c *Client) UpdateTransmission(t models.Transmission) errors.EdgeX {
 	return nil
 }
 
+// UpdateTransmissionWithNextAttempt updates a transmission and the time in milliseconds of its next resend attempt in the database
+func (c *Client) UpdateTransmissionWithNextAttempt(t models.Transmission, nextAttempt int64) errors.EdgeX {
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal Transmission model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlUpdateColsByCondCol(transmissionTableName, idCol, contentCol, nextAttemptCol), dataBytes, nextAttempt, t.Id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row by transmission id '%s' from transmission table", t.Id), err)
+	}
+
+	return nil
+}
+
 // TransmissionById queries the transmission by id
 func (c *Client) TransmissionById(id string) (models.Transmission, errors.EdgeX) {
 	transmission, err := queryTransmission(context.Background(), c.ConnPool, sqlQueryContentById(transmissionTableName), id)
@@ -116,6 +131,26 @@ func (c *Client) TransmissionsByStatus(offset, limit int, status string) ([]mode
 	return transmissions, nil
 }
 
+// TransmissionsByStatusAndNextAttempt queries the transmissions by status whose next resend attempt time is earlier than or equal to
+// nextAttempt, the transmissions without the next attempt time are at 0 so they are also returned
+func (c *Client) TransmissionsByStatusAndNextAttempt(offset, limit int, status string, nextAttempt int64) ([]models.Transmission, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	queryObj := map[string]any{statusField: status}
+
+	transmissions, err := queryTransmissions(context.Background(), c.ConnPool, sqlQueryContentByJSONFieldAndUpperLimitColWithPagination(transmissionTableName, nextAttemptCol), queryObj, nextAttempt, offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query transmissions by status %s and next attempt %d", status, nextAttempt), err)
+	}
+
+	return transmissions, nil
+}
+
+// IndexTransmissionNextAttempts does nothing since the next attempt time of the transmissions stored before it was introduced
+// is set to 0 by the migration script, which means the transmissions are due
+func (c *Client) IndexTransmissionNextAttempts(status string) errors.EdgeX {
+	return nil
+}
+
 // DeleteProcessedTransmissionsByAge deletes the processed transmissions that are older than a specific age
 func (c *Client) DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX {
 	status := []string{models.Sent, models.Acknowledged, models.Escalated}
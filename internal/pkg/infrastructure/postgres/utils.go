@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,6 +7,8 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -98,3 +100,24 @@ func getUTCStartAndEndTime(start, end int64) (time.Time, time.Time) {
 func getUTCTime(timestamp int64) time.Time {
 	return time.UnixMilli(timestamp).UTC()
 }
+
+// cursorKeySeparator separates the key values of the last record of a page in the cursor
+const cursorKeySeparator = "_"
+
+// formatCursor returns the cursor composed of the key values of the last record of a page
+func formatCursor(keys ...any) string {
+	keyStrs := make([]string, len(keys))
+	for i, key := range keys {
+		keyStrs[i] = fmt.Sprint(key)
+	}
+	return strings.Join(keyStrs, cursorKeySeparator)
+}
+
+// parseCursor returns the key values of the cursor, and returns an error if the cursor doesn't contain keyCount key values
+func parseCursor(cursor string, keyCount int) ([]string, errors.EdgeX) {
+	keys := strings.Split(cursor, cursorKeySeparator)
+	if len(keys) != keyCount {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cursor '%s'", cursor), nil)
+	}
+	return keys, nil
+}
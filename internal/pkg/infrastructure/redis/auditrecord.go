@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+const (
+	AuditRecordCollection           = "md|audit"
+	AuditRecordCollectionActor      = AuditRecordCollection + DBKeySeparator + "actor"
+	AuditRecordCollectionAction     = AuditRecordCollection + DBKeySeparator + "action"
+	AuditRecordCollectionEntityType = AuditRecordCollection + DBKeySeparator + "entityType"
+	AuditRecordCollectionEntityName = AuditRecordCollection + DBKeySeparator + "entityName"
+)
+
+// auditRecordStoredKey return the audit record's stored key which combines the collection name and object id
+func auditRecordStoredKey(id string) string {
+	return CreateKey(AuditRecordCollection, id)
+}
+
+// auditRecordIndexKeys returns the sorted sets indexing the audit record by its creation time
+func auditRecordIndexKeys(r metadataModels.AuditRecord) []string {
+	return []string{
+		AuditRecordCollection,
+		CreateKey(AuditRecordCollectionActor, r.Actor),
+		CreateKey(AuditRecordCollectionAction, r.Action),
+		CreateKey(AuditRecordCollectionEntityType, r.EntityType),
+		CreateKey(AuditRecordCollectionEntityName, r.EntityName),
+	}
+}
+
+// addAuditRecord adds an audit record to DB, the Created time is kept if it is set
+func addAuditRecord(conn redis.Conn, r metadataModels.AuditRecord) (metadataModels.AuditRecord, errors.EdgeX) {
+	if r.Created == 0 {
+		r.Created = pkgCommon.MakeTimestamp()
+	}
+	m, err := json.Marshal(r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal audit record for Redis persistence", err)
+	}
+
+	storedKey := auditRecordStoredKey(r.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	for _, key := range auditRecordIndexKeys(r) {
+		_ = conn.Send(ZADD, key, r.Created, storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindDatabaseError, "audit record creation failed", err)
+	}
+	return r, nil
+}
+
+// auditRecordQueryKey returns the sorted set of the audit records matching the query. When the query narrows the records,
+// the set is a temporary intersection of the index sets which should be deleted by the returned cleanup function.
+func auditRecordQueryKey(conn redis.Conn, query metadataModels.AuditRecordQuery) (key string, cleanup func(), edgeXerr errors.EdgeX) {
+	keys := []string{AuditRecordCollection}
+	if query.Actor != "" {
+		keys = append(keys, CreateKey(AuditRecordCollectionActor, query.Actor))
+	}
+	if query.Action != "" {
+		keys = append(keys, CreateKey(AuditRecordCollectionAction, query.Action))
+	}
+	if query.EntityType != "" {
+		keys = append(keys, CreateKey(AuditRecordCollectionEntityType, query.EntityType))
+	}
+	if query.EntityName != "" {
+		keys = append(keys, CreateKey(AuditRecordCollectionEntityName, query.EntityName))
+	}
+	if len(keys) == 1 {
+		return AuditRecordCollection, func() {}, nil
+	}
+
+	// the scores of the intersection are the creation time of the records, which are weighted from the first set only
+	cacheSet := uuid.New().String()
+	args := redis.Args{}.Add(cacheSet, len(keys)).AddFlat(keys).Add(WEIGHTS, 1)
+	for range keys[1:] {
+		args = args.Add(0)
+	}
+	if _, err := conn.Do(ZINTERSTORE, args...); err != nil {
+		return "", nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to intersect the audit record sets", err)
+	}
+	return cacheSet, func() { _, _ = conn.Do(DEL, cacheSet) }, nil
+}
+
+// auditRecords queries the audit records matching the query in descending order of creation
+func auditRecords(conn redis.Conn, query metadataModels.AuditRecordQuery) ([]metadataModels.AuditRecord, errors.EdgeX) {
+	key, cleanup, edgeXerr := auditRecordQueryKey(conn, query)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	defer cleanup()
+
+	objects, edgeXerr := getObjectsByScoreRange(conn, key, query.Start, query.End, query.Offset, query.Limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToAuditRecords(objects)
+}
+
+// auditRecordCount returns the count of the audit records matching the query
+func auditRecordCount(conn redis.Conn, query metadataModels.AuditRecordQuery) (uint32, errors.EdgeX) {
+	key, cleanup, edgeXerr := auditRecordQueryKey(conn, query)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	defer cleanup()
+
+	return getMemberCountByScoreRange(conn, key, query.Start, query.End)
+}
+
+// deleteAuditRecordsByAge deletes the audit records created before the age in milliseconds
+func deleteAuditRecordsByAge(conn redis.Conn, age int64) errors.EdgeX {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, AuditRecordCollection, InfiniteMin, fmt.Sprintf("(%d", expireTimestamp)))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "retrieve audit records by age failed", err)
+	}
+	if len(storedKeys) == 0 {
+		return nil
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(storedKeys))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	records, edgeXerr := convertObjectsToAuditRecords(objects)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, r := range records {
+		storedKey := auditRecordStoredKey(r.Id)
+		_ = conn.Send(UNLINK, storedKey)
+		for _, key := range auditRecordIndexKeys(r) {
+			_ = conn.Send(ZREM, key, storedKey)
+		}
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "audit records deletion failed", err)
+	}
+	return nil
+}
+
+func convertObjectsToAuditRecords(objects [][]byte) ([]metadataModels.AuditRecord, errors.EdgeX) {
+	records := make([]metadataModels.AuditRecord, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &records[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "audit record format parsing failed from the database", err)
+		}
+	}
+	return records, nil
+}
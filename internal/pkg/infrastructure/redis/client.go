@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,15 +7,20 @@ package redis
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
 
+	"github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
 )
 
@@ -51,6 +56,27 @@ func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return addEvent(conn, e)
 }
 
+// AddEvents adds the events in batch within a single transaction, so either all the events are added or none of them
+func (c *Client) AddEvents(events []model.Event) ([]model.Event, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	// the events without the id are given the generated ones before checking the duplicated ids
+	events = slices.Clone(events)
+	for i, e := range events {
+		if e.Id == "" {
+			events[i].Id = uuid.NewString()
+		} else {
+			_, err := uuid.Parse(e.Id)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+			}
+		}
+	}
+
+	return addEvents(conn, events)
+}
+
 // EventById gets an event by id
 func (c *Client) EventById(id string) (event model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -77,8 +103,8 @@ func (c *Client) DeleteEventById(id string) (edgeXerr errors.EdgeX) {
 	return
 }
 
-// Add a new device profle
-func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile, errors.EdgeX) {
+// Add a new device profle, which is recorded as the first revision of the profile if maxRevisions is not 0
+func (c *Client) AddDeviceProfile(dp model.DeviceProfile, maxRevisions uint32) (model.DeviceProfile, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -91,14 +117,23 @@ func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile,
 		dp.Id = uuid.New().String()
 	}
 
-	return addDeviceProfile(conn, dp)
+	return addDeviceProfile(conn, dp, maxRevisions)
 }
 
-// UpdateDeviceProfile updates a new device profile
-func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile) errors.EdgeX {
+// UpdateDeviceProfile updates a new device profile, only if it is still at ifRevision unless ifRevision is 0. The updated
+// profile is recorded as a new revision in the same transaction if maxRevisions is not 0, and the revisions beyond
+// maxRevisions are removed.
+func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile, ifRevision uint64, maxRevisions uint32) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateDeviceProfile(conn, dp)
+	write := func(conn redis.Conn) errors.EdgeX {
+		return updateDeviceProfile(conn, dp, maxRevisions)
+	}
+	if maxRevisions == 0 {
+		return writeIfRevision(conn, DeviceProfileCollectionRevision, common.DeviceProfileSystemEventType, dp.Name, ifRevision, write)
+	}
+	// the profile revision is watched even for the unconditional update, as the revision history is written based on it
+	return writeWatchingRevisions(conn, DeviceProfileCollectionRevision, common.DeviceProfileSystemEventType, map[string]uint64{dp.Name: ifRevision}, write)
 }
 
 // DeviceProfileNameExists checks the device profile exists by name
@@ -159,12 +194,14 @@ func (c *Client) DeleteDeviceServiceById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceServiceByName deletes a device service by name
-func (c *Client) DeleteDeviceServiceByName(name string) errors.EdgeX {
+// DeleteDeviceServiceByName deletes a device service by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteDeviceServiceByName(name string, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteDeviceServiceByName(conn, name)
+	edgeXerr := writeIfRevision(conn, DeviceServiceCollectionRevision, common.DeviceServiceSystemEventType, name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return deleteDeviceServiceByName(conn, name)
+	})
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device service with name %s", name), edgeXerr)
 	}
@@ -179,11 +216,13 @@ func (c *Client) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return deviceServiceNameExist(conn, name)
 }
 
-// UpdateDeviceService updates a device service
-func (c *Client) UpdateDeviceService(ds model.DeviceService) errors.EdgeX {
+// UpdateDeviceService updates a device service, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateDeviceService(ds model.DeviceService, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateDeviceService(conn, ds)
+	return writeIfRevision(conn, DeviceServiceCollectionRevision, common.DeviceServiceSystemEventType, ds.Name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return updateDeviceService(conn, ds)
+	})
 }
 
 // DeviceProfileById gets a device profile by id
@@ -225,12 +264,16 @@ func (c *Client) DeleteDeviceProfileById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceProfileByName deletes a device profile by name
-func (c *Client) DeleteDeviceProfileByName(name string) errors.EdgeX {
+// DeleteDeviceProfileByName deletes a device profile by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteDeviceProfileByName(name string, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteDeviceProfileByName(conn, name)
+	// the profile revision is watched even for the unconditional deletion, so that the revision history deleted along
+	// with the profile is not changed in between
+	edgeXerr := writeWatchingRevisions(conn, DeviceProfileCollectionRevision, common.DeviceProfileSystemEventType, map[string]uint64{name: ifRevision}, func(conn redis.Conn) errors.EdgeX {
+		return deleteDeviceProfileByName(conn, name)
+	})
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device profile with name %s", name), edgeXerr)
 	}
@@ -352,6 +395,20 @@ func (c *Client) AddDevice(d model.Device) (model.Device, errors.EdgeX) {
 	return addDevice(conn, d)
 }
 
+// AddDevices adds the new devices in a single transaction, none of the devices is added if any of them fails
+func (c *Client) AddDevices(ds []model.Device) ([]model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	for i := range ds {
+		if len(ds[i].Id) == 0 {
+			ds[i].Id = uuid.New().String()
+		}
+	}
+
+	return addDevices(conn, ds)
+}
+
 // DeleteDeviceById deletes a device by id
 func (c *Client) DeleteDeviceById(id string) errors.EdgeX {
 	conn := c.Pool.Get()
@@ -365,12 +422,14 @@ func (c *Client) DeleteDeviceById(id string) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceByName deletes a device by name
-func (c *Client) DeleteDeviceByName(name string) errors.EdgeX {
+// DeleteDeviceByName deletes a device by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteDeviceByName(name string, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteDeviceByName(conn, name)
+	edgeXerr := writeIfRevision(conn, DeviceCollectionRevision, common.DeviceSystemEventType, name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return deleteDeviceByName(conn, name)
+	})
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device with name %s", name), edgeXerr)
 	}
@@ -378,6 +437,22 @@ func (c *Client) DeleteDeviceByName(name string) errors.EdgeX {
 	return nil
 }
 
+// DeleteDevicesByNames deletes the devices by names in a single transaction, none of the devices is deleted if any of
+// them fails. The device found in ifRevisions is only deleted if it is still at the revision.
+func (c *Client) DeleteDevicesByNames(names []string, ifRevisions map[string]uint64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := writeIfRevisions(conn, DeviceCollectionRevision, common.DeviceSystemEventType, ifRevisions, func(conn redis.Conn) errors.EdgeX {
+		return deleteDevicesByNames(conn, names)
+	})
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete %d devices", len(names)), edgeXerr)
+	}
+
+	return nil
+}
+
 // DevicesByServiceName query devices by offset, limit and name
 func (c *Client) DevicesByServiceName(offset int, limit int, name string) (devices []model.Device, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -452,12 +527,22 @@ func (c *Client) DevicesByProfileName(offset int, limit int, profileName string)
 	return devices, nil
 }
 
-// Update a device
-func (c *Client) UpdateDevice(d model.Device) errors.EdgeX {
+// Update a device, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateDevice(d model.Device, ifRevision uint64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return writeIfRevision(conn, DeviceCollectionRevision, common.DeviceSystemEventType, d.Name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return updateDevice(conn, d)
+	})
+}
+
+// UpdateDevices updates the devices in a single transaction, none of the devices is updated if any of them fails
+func (c *Client) UpdateDevices(ds []model.Device) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return updateDevice(conn, d)
+	return updateDevices(conn, ds)
 }
 
 // AllEvents query events by offset and limit
@@ -496,6 +581,31 @@ func (c *Client) DeviceTree(parent string, levels int, offset int, limit int, la
 	return totalCount, devices, nil
 }
 
+// DeviceAncestors returns the ancestors of the device from its parent up to the root of the device tree
+func (c *Client) DeviceAncestors(name string) ([]model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	ancestors, edgeXerr := deviceAncestors(conn, name)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the ancestors of device %s", name), edgeXerr)
+	}
+	return ancestors, nil
+}
+
+// DevicesByQuery returns the total count of the devices matching the filters of the query along with the page of them,
+// which are sorted and paged as the query specifies
+func (c *Client) DevicesByQuery(query metadataModels.DeviceQuery) (uint32, []model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, devices, edgeXerr := devicesByQuery(conn, query)
+	if edgeXerr != nil {
+		return 0, nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query devices by the search filters", edgeXerr)
+	}
+	return count, devices, nil
+}
+
 // EventsByDeviceName query events by offset, limit and device name
 func (c *Client) EventsByDeviceName(offset int, limit int, name string) (events []model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -757,6 +867,91 @@ func (c *Client) ReadingCountByDeviceNameAndTimeRange(deviceName string, start i
 	return count, nil
 }
 
+// ReadingsByValueFilter query the numeric readings whose value satisfies the filter, origin within the time range of the filter,
+// and optionally by the device and resource of the filter, and returns the total count of them along with the requested page.
+// Readings are sorted in descending order of origin time.
+func (c *Client) ReadingsByValueFilter(filter dataModels.ReadingValueFilter, offset int, limit int) (totalCount uint32, readings []model.Reading, err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	totalCount, readings, err = readingsByValueFilter(conn, filter, offset, limit)
+	if err != nil {
+		return 0, readings, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query readings by value filter %s, deviceName %s, resourceName %s and time range %v ~ %v", filter.Operator, filter.DeviceName, filter.ResourceName, filter.Start, filter.End), err)
+	}
+	return totalCount, readings, nil
+}
+
+// ReadingCountByValueFilter returns the count of the numeric readings whose value satisfies the filter, origin within the time range
+// of the filter, and optionally by the device and resource of the filter
+func (c *Client) ReadingCountByValueFilter(filter dataModels.ReadingValueFilter) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, _, err := readingsByValueFilter(conn, filter, 0, 0)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to count readings by value filter %s, deviceName %s, resourceName %s and time range %v ~ %v", filter.Operator, filter.DeviceName, filter.ResourceName, filter.Start, filter.End), err)
+	}
+
+	return count, nil
+}
+
+// EventsByCursor query events by the cursor query in descending order of origin, and returns the cursor of the next page
+func (c *Client) EventsByCursor(query dataModels.CursorQuery) (events []model.Event, nextCursor string, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, nextCursor, edgeXerr = eventsByCursor(conn, query)
+	if edgeXerr != nil {
+		return events, "", errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events by cursor, deviceName %s and time range %v ~ %v", query.DeviceName, query.Start, query.End), edgeXerr)
+	}
+	return events, nextCursor, nil
+}
+
+// ReadingsByCursor query readings by the cursor query in descending order of origin, and returns the cursor of the next page
+func (c *Client) ReadingsByCursor(query dataModels.CursorQuery) (readings []model.Reading, nextCursor string, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, nextCursor, edgeXerr = readingsByCursor(conn, query)
+	if edgeXerr != nil {
+		return readings, "", errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings by cursor, deviceName %s, resourceName %s and time range %v ~ %v", query.DeviceName, query.ResourceName, query.Start, query.End), edgeXerr)
+	}
+	return readings, nextCursor, nil
+}
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange calculates the statistics of the numeric readings by the specified device and resource,
+// origin within the time range, the readings are grouped into the buckets of the interval
+func (c *Client) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int64, end int64, interval int64) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	aggregates, err := readingAggregatesByDeviceNameAndResourceNameAndTimeRange(conn, deviceName, resourceName, start, end, interval)
+	if err != nil {
+		return aggregates, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query reading aggregates by deviceName %s, resourceName %s and time range %v ~ %v", deviceName, resourceName, start, end), err)
+	}
+
+	return aggregates, nil
+}
+
+// ReadingRollupsByDeviceNameAndResourceNameAndTimeRange query the reading rollups of the interval by the specified device and resource,
+// bucket within the time range, offset, and limit. The reading rollups are not supported by Redis, so an empty result is returned
+func (c *Client) ReadingRollupsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, interval int64, start int64, end int64, offset int, limit int) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	c.loggingClient.Warn("ReadingRollupsByDeviceNameAndResourceNameAndTimeRange function didn't implement")
+	return []dataModels.ReadingAggregate{}, nil
+}
+
+// DeleteReadingRollupsByAgeAndInterval deletes the reading rollups of the interval whose buckets are entirely older than age.
+// The reading rollups are not supported by Redis, so nothing is deleted
+func (c *Client) DeleteReadingRollupsByAgeAndInterval(age int64, interval int64) errors.EdgeX {
+	c.loggingClient.Warn("DeleteReadingRollupsByAgeAndInterval function didn't implement")
+	return nil
+}
+
 // AddProvisionWatcher adds a new provision watcher
 func (c *Client) AddProvisionWatcher(pw model.ProvisionWatcher) (model.ProvisionWatcher, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -836,12 +1031,14 @@ func (c *Client) AllProvisionWatchers(offset int, limit int, labels []string) (p
 	return
 }
 
-// DeleteProvisionWatcherByName deletes a provision watcher by name
-func (c *Client) DeleteProvisionWatcherByName(name string) errors.EdgeX {
+// DeleteProvisionWatcherByName deletes a provision watcher by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteProvisionWatcherByName(name string, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteProvisionWatcherByName(conn, name)
+	edgeXerr := writeIfRevision(conn, ProvisionWatcherCollectionRevision, common.ProvisionWatcherSystemEventType, name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return deleteProvisionWatcherByName(conn, name)
+	})
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("failed to delete the provision watcher with name %s", name), edgeXerr)
 	}
@@ -849,12 +1046,14 @@ func (c *Client) DeleteProvisionWatcherByName(name string) errors.EdgeX {
 	return nil
 }
 
-// Update a provision watcher
-func (c *Client) UpdateProvisionWatcher(pw model.ProvisionWatcher) errors.EdgeX {
+// Update a provision watcher, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateProvisionWatcher(pw model.ProvisionWatcher, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return updateProvisionWatcher(conn, pw)
+	return writeIfRevision(conn, ProvisionWatcherCollectionRevision, common.ProvisionWatcherSystemEventType, pw.Name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return updateProvisionWatcher(conn, pw)
+	})
 }
 
 // DeviceProfileCountByLabels returns the total count of Device Profiles with labels specified.  If no label is specified, the total count of all device profiles will be returned.
@@ -909,6 +1108,94 @@ func (c *Client) DeviceProfileCountByManufacturerAndModel(manufacturer, model st
 	return uint32(len(profiles)), nil
 }
 
+// DeviceProfileRevisionsByProfileName queries the revisions of the device profile with offset and limit, the latest revision comes first
+func (c *Client) DeviceProfileRevisionsByProfileName(offset int, limit int, profileName string) ([]metadataModels.DeviceProfileRevision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	revisions, edgeXerr := deviceProfileRevisionsByProfileName(conn, offset, limit, profileName)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query revisions of device profile %s", profileName), edgeXerr)
+	}
+	return revisions, nil
+}
+
+// DeviceProfileRevisionCountByProfileName returns the count of the revisions of the device profile
+func (c *Client) DeviceProfileRevisionCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceProfileRevisionCollectionProfileName, profileName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeviceProfileRevisionByProfileNameAndRevision gets the revision of the device profile
+func (c *Client) DeviceProfileRevisionByProfileNameAndRevision(profileName string, revision uint64) (metadataModels.DeviceProfileRevision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return deviceProfileRevisionByProfileNameAndRevision(conn, profileName, revision)
+}
+
+// AddAuditRecord adds a new audit record, the Created time is kept if it is set
+func (c *Client) AddAuditRecord(r metadataModels.AuditRecord) (metadataModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+	return addAuditRecord(conn, r)
+}
+
+// AuditRecords queries the audit records matching the query in descending order of creation
+func (c *Client) AuditRecords(query metadataModels.AuditRecordQuery) ([]metadataModels.AuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := auditRecords(conn, query)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query audit records", edgeXerr)
+	}
+	return records, nil
+}
+
+// AuditRecordCount returns the count of the audit records matching the query, the offset and limit are ignored
+func (c *Client) AuditRecordCount(query metadataModels.AuditRecordQuery) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := auditRecordCount(conn, query)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteAuditRecordsByAge deletes the audit records created before the age in milliseconds
+func (c *Client) DeleteAuditRecordsByAge(age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return deleteAuditRecordsByAge(conn, age)
+}
+
+// EntityRevision returns the revision of the device, device profile, device service or provision watcher by name
+func (c *Client) EntityRevision(entityType string, name string) (uint64, errors.EdgeX) {
+	hashes, ok := entityRevisionHashes[entityType]
+	if !ok {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("entity type %s has no revision", entityType), nil)
+	}
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return entityRevision(conn, hashes[0], hashes[1], entityType, name)
+}
+
 func (c *Client) InUseResourceCount() (uint32, errors.EdgeX) {
 	c.loggingClient.Warn("InUseResourceCount function didn't implement")
 	return 0, nil
@@ -1096,19 +1383,31 @@ func (c *Client) SubscriptionByName(name string) (subscription model.Subscriptio
 	return subscription, nil
 }
 
-// UpdateSubscription updates a new subscription
-func (c *Client) UpdateSubscription(subscription model.Subscription) errors.EdgeX {
+// SubscriptionRevision returns the revision of the subscription by name
+func (c *Client) SubscriptionRevision(name string) (uint64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return entityRevision(conn, SubscriptionCollectionName, SubscriptionCollectionRevision, "subscription", name)
+}
+
+// UpdateSubscription updates a new subscription, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) UpdateSubscription(subscription model.Subscription, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateSubscription(conn, subscription)
+	return writeIfRevision(conn, SubscriptionCollectionRevision, "subscription", subscription.Name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return updateSubscription(conn, subscription)
+	})
 }
 
-// DeleteSubscriptionByName deletes a subscription by name
-func (c *Client) DeleteSubscriptionByName(name string) errors.EdgeX {
+// DeleteSubscriptionByName deletes a subscription by name, only if it is still at ifRevision unless ifRevision is 0
+func (c *Client) DeleteSubscriptionByName(name string, ifRevision uint64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteSubscriptionByName(conn, name)
+	edgeXerr := writeIfRevision(conn, SubscriptionCollectionRevision, "subscription", name, ifRevision, func(conn redis.Conn) errors.EdgeX {
+		return deleteSubscriptionByName(conn, name)
+	})
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the subscription with name %s", name), edgeXerr)
 	}
@@ -1495,6 +1794,13 @@ func (c *Client) UpdateTransmission(trans model.Transmission) errors.EdgeX {
 	return updateTransmission(conn, trans)
 }
 
+// UpdateTransmissionWithNextAttempt updates a transmission and the time of its next resend attempt
+func (c *Client) UpdateTransmissionWithNextAttempt(trans model.Transmission, nextAttempt int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateTransmissionWithNextAttempt(conn, trans, nextAttempt)
+}
+
 // TransmissionById gets a transmission by id
 func (c *Client) TransmissionById(id string) (trans model.Transmission, edgexErr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1547,6 +1853,31 @@ func (c *Client) TransmissionsByStatus(offset int, limit int, status string) (tr
 	return transmissions, nil
 }
 
+// TransmissionsByStatusAndNextAttempt queries transmissions by offset, limit and status whose next resend attempt time is due
+func (c *Client) TransmissionsByStatusAndNextAttempt(offset int, limit int, status string, nextAttempt int64) (transmissions []model.Transmission, err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transmissions, err = transmissionsByStatusAndNextAttempt(conn, offset, limit, status, nextAttempt)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query transmissions by offset %d, limit %d, status %s and next attempt %d", offset, limit, status, nextAttempt), err)
+	}
+	return transmissions, nil
+}
+
+// IndexTransmissionNextAttempts indexes the transmissions of the status without the next resend attempt time as due
+func (c *Client) IndexTransmissionNextAttempts(status string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := indexTransmissionNextAttempts(conn, status)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to index the next attempt of transmissions by status %s", status), err)
+	}
+	return nil
+}
+
 // TransmissionsBySubscriptionName queries transmissions by offset, limit and subscription name
 func (c *Client) TransmissionsBySubscriptionName(offset int, limit int, subscriptionName string) (transmissions []model.Transmission, err errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1729,3 +2060,89 @@ func (c *Client) UpdateRegistration(r model.Registration) errors.EdgeX {
 
 	return nil
 }
+
+// DevicesByTemplateName returns all the devices linked to the device template
+func (c *Client) DevicesByTemplateName(templateName string) ([]model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr := devicesByTemplateName(conn, templateName)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query devices by template name %s", templateName), edgeXerr)
+	}
+	return devices, nil
+}
+
+// DeviceCountByTemplateName returns the count of the devices linked to the device template
+func (c *Client) DeviceCountByTemplateName(templateName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionTemplate, templateName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// AddDeviceTemplate adds a new device template
+func (c *Client) AddDeviceTemplate(t metadataModels.DeviceTemplate) (metadataModels.DeviceTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+	return addDeviceTemplate(conn, t)
+}
+
+// UpdateDeviceTemplate updates a device template along with the linked devices in a single transaction, neither the
+// template nor the devices are updated if any of them fails
+func (c *Client) UpdateDeviceTemplate(t metadataModels.DeviceTemplate, linkedDevices []model.Device) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateDeviceTemplate(conn, t, linkedDevices)
+}
+
+// DeviceTemplateByName gets a device template by name
+func (c *Client) DeviceTemplateByName(name string) (metadataModels.DeviceTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return deviceTemplateByName(conn, name)
+}
+
+// AllDeviceTemplates returns the device templates with the given offset and limit
+func (c *Client) AllDeviceTemplates(offset int, limit int) ([]metadataModels.DeviceTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	templates, edgeXerr := allDeviceTemplates(conn, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query all device templates", edgeXerr)
+	}
+	return templates, nil
+}
+
+// DeviceTemplateCount returns the total count of the device templates
+func (c *Client) DeviceTemplateCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeviceTemplateCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteDeviceTemplateByName deletes a device template by name
+func (c *Client) DeleteDeviceTemplateByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device template with name %s", name), edgeXerr)
+	}
+	return nil
+}
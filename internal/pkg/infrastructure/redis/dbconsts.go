@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,6 +9,7 @@ package redis
 // Reference: https://redis.io/commands
 const (
 	MULTI            = "MULTI"
+	DISCARD          = "DISCARD"
 	SET              = "SET"
 	GET              = "GET"
 	EXISTS           = "EXISTS"
@@ -18,28 +19,39 @@ const (
 	HSET             = "HSET"
 	HSETNX           = "HSETNX"
 	HGET             = "HGET"
+	HMGET            = "HMGET"
+	HKEYS            = "HKEYS"
 	HEXISTS          = "HEXISTS"
 	HDEL             = "HDEL"
+	HINCRBY          = "HINCRBY"
+	INCR             = "INCR"
 	SADD             = "SADD"
 	SREM             = "SREM"
 	ZADD             = "ZADD"
 	ZREM             = "ZREM"
 	EXEC             = "EXEC"
+	WATCH            = "WATCH"
+	UNWATCH          = "UNWATCH"
 	ZRANGE           = "ZRANGE"
 	ZREVRANGE        = "ZREVRANGE"
 	MGET             = "MGET"
 	ZCARD            = "ZCARD"
 	ZCOUNT           = "ZCOUNT"
+	ZSCORE           = "ZSCORE"
 	UNLINK           = "UNLINK"
 	ZRANGEBYSCORE    = "ZRANGEBYSCORE"
+	ZRANGEBYLEX      = "ZRANGEBYLEX"
 	ZREVRANGEBYSCORE = "ZREVRANGEBYSCORE"
+	ZREMRANGEBYSCORE = "ZREMRANGEBYSCORE"
 	LIMIT            = "LIMIT"
+	WITHSCORES       = "WITHSCORES"
 	ZUNIONSTORE      = "ZUNIONSTORE"
 	ZINTERSTORE      = "ZINTERSTORE"
 	TYPE             = "TYPE"
 	INFO             = "INFO"
 	MEMORY           = "MEMORY"
 	WEIGHTS          = "WEIGHTS"
+	NX               = "NX"
 )
 
 const (
@@ -47,6 +59,8 @@ const (
 	InfiniteMax     = "+inf"
 	GreaterThanZero = "(0"
 	DBKeySeparator  = ":"
+	// cursorSeparator separates the score and the member of the last retrieved member of a page in the cursor
+	cursorSeparator = "_"
 )
 
 // Redis data types
@@ -1,15 +1,20 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package redis
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
+	"strings"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -17,15 +22,24 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
 )
 
 const (
-	DeviceCollection            = "md|dv"
-	DeviceCollectionName        = DeviceCollection + DBKeySeparator + common.Name
+	DeviceCollection     = "md|dv"
+	DeviceCollectionName = DeviceCollection + DBKeySeparator + common.Name
+	// DeviceCollectionNameIndex is the sorted set of the device names of the same score, so that the names are ordered
+	// lexicographically and the names of a prefix can be ranged by ZRANGEBYLEX
+	DeviceCollectionNameIndex   = DeviceCollectionName + DBKeySeparator + "index"
+	DeviceCollectionRevision    = DeviceCollection + DBKeySeparator + "revision"
 	DeviceCollectionLabel       = DeviceCollection + DBKeySeparator + common.Label
 	DeviceCollectionParent      = DeviceCollection + DBKeySeparator + "parent"
 	DeviceCollectionServiceName = DeviceCollection + DBKeySeparator + common.Service + DBKeySeparator + common.Name
 	DeviceCollectionProfileName = DeviceCollection + DBKeySeparator + common.Profile + DBKeySeparator + common.Name
+	DeviceCollectionTemplate    = DeviceCollection + DBKeySeparator + "template" + DBKeySeparator + common.Name
+	// DeviceCollectionLastConnected is the sorted set of the devices scored by the time their operating state last changed
+	// to UP, the devices never connected are absent
+	DeviceCollectionLastConnected = DeviceCollection + DBKeySeparator + "lastconnected"
 )
 
 // deviceStoredKey return the device's stored key which combines the collection name and object id
@@ -60,6 +74,7 @@ func sendAddDeviceCmd(conn redis.Conn, storedKey string, d models.Device) errors
 	_ = conn.Send(SET, storedKey, dsJSONBytes)
 	_ = conn.Send(ZADD, DeviceCollection, 0, storedKey)
 	_ = conn.Send(HSET, DeviceCollectionName, d.Name, storedKey)
+	_ = conn.Send(ZADD, DeviceCollectionNameIndex, 0, d.Name)
 	_ = conn.Send(ZADD, CreateKey(DeviceCollectionServiceName, d.ServiceName), d.Modified, storedKey)
 	_ = conn.Send(ZADD, CreateKey(DeviceCollectionProfileName, d.ProfileName), d.Modified, storedKey)
 	for _, label := range d.Labels {
@@ -68,9 +83,26 @@ func sendAddDeviceCmd(conn redis.Conn, storedKey string, d models.Device) errors
 	if d.Parent != "" {
 		_ = conn.Send(ZADD, CreateKey(DeviceCollectionParent, d.Parent), d.Modified, storedKey)
 	}
+	if templateName := deviceTemplateNameOf(d); templateName != "" {
+		_ = conn.Send(ZADD, CreateKey(DeviceCollectionTemplate, templateName), d.Modified, storedKey)
+	}
 	return nil
 }
 
+// sendConnectDeviceCmd sends the command to set the last connected time of the device to its modified time, if the
+// operating state of the device changes to UP from the old state
+func sendConnectDeviceCmd(conn redis.Conn, storedKey string, oldState models.OperatingState, d models.Device) {
+	if d.OperatingState == models.Up && oldState != models.Up {
+		_ = conn.Send(ZADD, DeviceCollectionLastConnected, d.Modified, storedKey)
+	}
+}
+
+// deviceTemplateNameOf returns the name of the device template linked by the device properties, if any
+func deviceTemplateNameOf(d models.Device) string {
+	templateName, _ := d.Properties[constants.DeviceTemplateNameProperty].(string)
+	return templateName
+}
+
 // addDevice adds a new device into DB
 func addDevice(conn redis.Conn, d models.Device) (models.Device, errors.EdgeX) {
 	var exists bool
@@ -111,6 +143,8 @@ func addDevice(conn redis.Conn, d models.Device) (models.Device, errors.EdgeX) {
 	if edgeXerr != nil {
 		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendConnectDeviceCmd(conn, storedKey, "", d)
+	sendAddRevisionCmd(conn, DeviceCollectionRevision, d.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device creation failed", err)
@@ -168,6 +202,7 @@ func sendDeleteDeviceCmd(conn redis.Conn, storedKey string, device models.Device
 	_ = conn.Send(DEL, storedKey)
 	_ = conn.Send(ZREM, DeviceCollection, storedKey)
 	_ = conn.Send(HDEL, DeviceCollectionName, device.Name)
+	_ = conn.Send(ZREM, DeviceCollectionNameIndex, device.Name)
 	_ = conn.Send(ZREM, CreateKey(DeviceCollectionServiceName, device.ServiceName), storedKey)
 	_ = conn.Send(ZREM, CreateKey(DeviceCollectionProfileName, device.ProfileName), storedKey)
 	for _, label := range device.Labels {
@@ -176,6 +211,9 @@ func sendDeleteDeviceCmd(conn redis.Conn, storedKey string, device models.Device
 	if device.Parent != "" {
 		_ = conn.Send(ZREM, CreateKey(DeviceCollectionParent, device.Parent), storedKey)
 	}
+	if templateName := deviceTemplateNameOf(device); templateName != "" {
+		_ = conn.Send(ZREM, CreateKey(DeviceCollectionTemplate, templateName), storedKey)
+	}
 }
 
 // deleteDevice deletes a device
@@ -190,6 +228,8 @@ func deleteDevice(conn redis.Conn, device models.Device) errors.EdgeX {
 	storedKey := deviceStoredKey(device.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceCmd(conn, storedKey, device)
+	_ = conn.Send(ZREM, DeviceCollectionLastConnected, storedKey)
+	sendDeleteRevisionCmd(conn, DeviceCollectionRevision, device.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device deletion failed", err)
@@ -254,6 +294,287 @@ func devicesByProfileName(conn redis.Conn, offset int, limit int, profileName st
 	return devices, nil
 }
 
+// devicesByTemplateName query all the devices linked to the device template
+func devicesByTemplateName(conn redis.Conn, templateName string) ([]models.Device, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceCollectionTemplate, templateName), 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices := make([]models.Device, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &devices[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+	}
+	return devices, nil
+}
+
+// deviceQueryKey returns the sorted set of the devices matching the indexed filters of the query, which are the name
+// prefix, the profile names, the service names, the labels to have and the last connected range. When the query narrows the devices, the set is a temporary combination
+// of the index sets which should be deleted by the returned cleanup function.
+func deviceQueryKey(conn redis.Conn, query metadataModels.DeviceQuery) (key string, cleanup func(), edgeXerr errors.EdgeX) {
+	var cacheSets []string
+	cleanup = func() {
+		if len(cacheSets) > 0 {
+			_, _ = conn.Do(DEL, redis.Args{}.AddFlat(cacheSets)...)
+		}
+	}
+	// union returns the set of the devices in any index set of the values
+	union := func(collection string, values []string) (string, error) {
+		if len(values) == 1 {
+			return CreateKey(collection, values[0]), nil
+		}
+		cacheSet := uuid.New().String()
+		cacheSets = append(cacheSets, cacheSet)
+		args := redis.Args{}.Add(cacheSet, len(values))
+		for _, value := range values {
+			args = args.Add(CreateKey(collection, value))
+		}
+		_, err := conn.Do(ZUNIONSTORE, args...)
+		return cacheSet, err
+	}
+
+	keys := []string{DeviceCollection}
+	for _, filter := range []struct {
+		collection string
+		values     []string
+	}{
+		{DeviceCollectionProfileName, query.ProfileNames},
+		{DeviceCollectionServiceName, query.ServiceNames},
+		{DeviceCollectionLabel, query.AnyLabels},
+	} {
+		if len(filter.values) == 0 {
+			continue
+		}
+		unionKey, err := union(filter.collection, filter.values)
+		if err != nil {
+			cleanup()
+			return "", nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to union the device sets", err)
+		}
+		keys = append(keys, unionKey)
+	}
+	for _, label := range query.AllLabels {
+		keys = append(keys, CreateKey(DeviceCollectionLabel, label))
+	}
+	if query.NamePrefix != "" {
+		storedKeys, edgeXerr := deviceStoredKeysByNamePrefix(conn, query.NamePrefix)
+		if edgeXerr != nil {
+			cleanup()
+			return "", nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		// the set of no device is absent, which makes the intersection empty
+		cacheSet := uuid.New().String()
+		cacheSets = append(cacheSets, cacheSet)
+		if len(storedKeys) > 0 {
+			args := redis.Args{}.Add(cacheSet)
+			for _, storedKey := range storedKeys {
+				args = args.Add(0, storedKey)
+			}
+			if _, err := conn.Do(ZADD, args...); err != nil {
+				cleanup()
+				return "", nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to store the devices of the name prefix", err)
+			}
+		}
+		keys = append(keys, cacheSet)
+	}
+	if query.HasLastConnectedRange() {
+		keys = append(keys, DeviceCollectionLastConnected)
+	}
+	if len(keys) == 1 {
+		return DeviceCollection, cleanup, nil
+	}
+
+	cacheSet := uuid.New().String()
+	cacheSets = append(cacheSets, cacheSet)
+	args := redis.Args{}.Add(cacheSet, len(keys)).AddFlat(keys)
+	if query.HasLastConnectedRange() {
+		// the intersection is scored by the last connected time alone, so that it can be trimmed to the range
+		weights := make([]int, len(keys))
+		weights[len(keys)-1] = 1
+		args = args.Add(WEIGHTS).AddFlat(weights)
+	}
+	if _, err := conn.Do(ZINTERSTORE, args...); err != nil {
+		cleanup()
+		return "", nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to intersect the device sets", err)
+	}
+	if query.HasLastConnectedRange() {
+		if edgeXerr := trimDevicesByLastConnected(conn, cacheSet, query); edgeXerr != nil {
+			cleanup()
+			return "", nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return cacheSet, cleanup, nil
+}
+
+// lastConnectedTrimRanges returns the score ranges out of the last connected range of the query for ZREMRANGEBYSCORE
+func lastConnectedTrimRanges(query metadataModels.DeviceQuery) [][2]string {
+	var ranges [][2]string
+	if query.LastConnectedFrom > 0 {
+		ranges = append(ranges, [2]string{InfiniteMin, fmt.Sprintf("(%d", query.LastConnectedFrom)})
+	}
+	if query.LastConnectedTo > 0 {
+		ranges = append(ranges, [2]string{fmt.Sprintf("(%d", query.LastConnectedTo), InfiniteMax})
+	}
+	return ranges
+}
+
+// trimDevicesByLastConnected removes the devices last connected out of the range of the query from the set, which is
+// scored by the last connected time
+func trimDevicesByLastConnected(conn redis.Conn, set string, query metadataModels.DeviceQuery) errors.EdgeX {
+	for _, scoreRange := range lastConnectedTrimRanges(query) {
+		if _, err := conn.Do(ZREMRANGEBYSCORE, set, scoreRange[0], scoreRange[1]); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to trim the devices by the last connected range", err)
+		}
+	}
+	return nil
+}
+
+// namePrefixRange returns the lexicographical range of the names starting with the prefix for ZRANGEBYLEX, the range ends
+// before the prefix followed by the greatest byte, which no UTF-8 encoded name contains
+func namePrefixRange(prefix string) (string, string) {
+	return "[" + prefix, "(" + prefix + "\xff"
+}
+
+// deviceStoredKeysByNamePrefix returns the stored keys of the devices whose name starts with the prefix, which are ranged
+// by the name index
+func deviceStoredKeysByNamePrefix(conn redis.Conn, prefix string) ([]string, errors.EdgeX) {
+	if edgeXerr := ensureDeviceNameIndex(conn); edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	start, end := namePrefixRange(prefix)
+	names, err := redis.Strings(conn.Do(ZRANGEBYLEX, DeviceCollectionNameIndex, start, end))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to query the device names by prefix", err)
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	storedKeys, err := redis.Strings(conn.Do(HMGET, redis.Args{}.Add(DeviceCollectionName).AddFlat(names)...))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to query the devices by names", err)
+	}
+	// the name deleted in the meantime has no stored key
+	return slices.DeleteFunc(storedKeys, func(storedKey string) bool { return storedKey == "" }), nil
+}
+
+// ensureDeviceNameIndex rebuilds the name index from the device names if they don't match in number, which is the case of
+// the devices stored before the name index is introduced
+func ensureDeviceNameIndex(conn redis.Conn) errors.EdgeX {
+	nameCount, err := redis.Int(conn.Do(HLEN, DeviceCollectionName))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to count the device names", err)
+	}
+	indexCount, err := redis.Int(conn.Do(ZCARD, DeviceCollectionNameIndex))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to count the device name index", err)
+	}
+	if nameCount == indexCount {
+		return nil
+	}
+
+	names, err := redis.Strings(conn.Do(HKEYS, DeviceCollectionName))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to query the device names", err)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, DeviceCollectionNameIndex)
+	if len(names) > 0 {
+		args := redis.Args{}.Add(DeviceCollectionNameIndex)
+		for _, name := range names {
+			args = args.Add(0, name)
+		}
+		_ = conn.Send(ZADD, args...)
+	}
+	if _, err = conn.Do(EXEC); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to rebuild the device name index", err)
+	}
+	return nil
+}
+
+// deviceMatchesQuery checks the device against the filters of the query which are not indexed
+func deviceMatchesQuery(d models.Device, query metadataModels.DeviceQuery) bool {
+	if len(query.AdminStates) > 0 && !slices.Contains(query.AdminStates, string(d.AdminState)) {
+		return false
+	}
+	if len(query.OperatingStates) > 0 && !slices.Contains(query.OperatingStates, string(d.OperatingState)) {
+		return false
+	}
+	if slices.ContainsFunc(query.NoLabels, func(label string) bool { return slices.Contains(d.Labels, label) }) {
+		return false
+	}
+	for protocol, properties := range query.ProtocolProperties {
+		for property, value := range properties {
+			// the property values are compared as strings, as the JSON values which are not strings are stored as is
+			actual, ok := d.Protocols[protocol][property]
+			if !ok || fmt.Sprint(actual) != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// sortDevicesByQuery sorts the devices by the field of the query, the devices of the same value are sorted by name
+func sortDevicesByQuery(devices []models.Device, query metadataModels.DeviceQuery) {
+	sortValue := func(d models.Device) int64 {
+		switch query.SortBy {
+		case constants.DeviceSortByCreated:
+			return d.Created
+		case constants.DeviceSortByModified:
+			return d.Modified
+		default:
+			return 0
+		}
+	}
+	slices.SortFunc(devices, func(a, b models.Device) int {
+		result := cmp.Or(cmp.Compare(sortValue(a), sortValue(b)), strings.Compare(a.Name, b.Name))
+		if query.Descending {
+			return -result
+		}
+		return result
+	})
+}
+
+// devicesByQuery returns the total count of the devices matching the filters of the query along with the page of them in
+// the sort order of the query, the devices are narrowed by the index sets first and then matched against the other filters
+func devicesByQuery(conn redis.Conn, query metadataModels.DeviceQuery) (uint32, []models.Device, errors.EdgeX) {
+	key, cleanup, edgeXerr := deviceQueryKey(conn, query)
+	if edgeXerr != nil {
+		return 0, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	defer cleanup()
+
+	objects, edgeXerr := getObjectsByRange(conn, key, 0, -1)
+	if edgeXerr != nil {
+		return 0, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	devices := make([]models.Device, 0, len(objects))
+	for _, in := range objects {
+		var d models.Device
+		err := json.Unmarshal(in, &d)
+		if err != nil {
+			return 0, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		if deviceMatchesQuery(d, query) {
+			devices = append(devices, d)
+		}
+	}
+	sortDevicesByQuery(devices, query)
+
+	count := uint32(len(devices))
+	offset := max(query.Offset, 0)
+	if offset >= len(devices) {
+		return count, []models.Device{}, nil
+	}
+	devices = devices[offset:]
+	if query.Limit >= 0 && query.Limit < len(devices) {
+		devices = devices[:query.Limit]
+	}
+	return count, devices, nil
+}
+
 func updateDevice(conn redis.Conn, d models.Device) errors.EdgeX {
 	if d.ProfileName != "" {
 		exists, edgeXerr := deviceProfileNameExists(conn, d.ProfileName)
@@ -279,6 +600,8 @@ func updateDevice(conn redis.Conn, d models.Device) errors.EdgeX {
 	if edgexErr != nil {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
+	sendConnectDeviceCmd(conn, storedKey, oldDevice.OperatingState, d)
+	sendIncrRevisionCmd(conn, DeviceCollectionRevision, d.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device update failed", err)
@@ -287,6 +610,162 @@ func updateDevice(conn redis.Conn, d models.Device) errors.EdgeX {
 	return nil
 }
 
+// devicesProfileCheck checks the existence of the device profiles used by the devices, each profile is only checked once
+func devicesProfileCheck(conn redis.Conn, ds []models.Device) errors.EdgeX {
+	checked := make(map[string]bool)
+	for _, d := range ds {
+		if d.ProfileName == "" || checked[d.ProfileName] {
+			continue
+		}
+		exists, edgeXerr := deviceProfileNameExists(conn, d.ProfileName)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("device profile '%s' existence check failed", d.ProfileName), edgeXerr)
+		} else if !exists {
+			return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exists", d.ProfileName), nil)
+		}
+		checked[d.ProfileName] = true
+	}
+	return nil
+}
+
+// addDevices adds the new devices into DB in a single transaction, none of the devices is added if any of them fails
+func addDevices(conn redis.Conn, ds []models.Device) ([]models.Device, errors.EdgeX) {
+	edgeXerr := devicesProfileCheck(conn, ds)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	for _, d := range ds {
+		exists, edgeXerr := deviceIdExists(conn, d.Id)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if exists {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device id %s already exists", d.Id), nil)
+		}
+		exists, edgeXerr = deviceNameExists(conn, d.Name)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if exists {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s already exists", d.Name), nil)
+		}
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	addedDevices := make([]models.Device, len(ds))
+	_ = conn.Send(MULTI)
+	for i, d := range ds {
+		if d.Created == 0 {
+			d.Created = ts
+		}
+		d.Modified = ts
+		storedKey := deviceStoredKey(d.Id)
+		edgeXerr = sendAddDeviceCmd(conn, storedKey, d)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		sendConnectDeviceCmd(conn, storedKey, "", d)
+		sendAddRevisionCmd(conn, DeviceCollectionRevision, d.Name)
+		addedDevices[i] = d
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "devices creation failed", err)
+	}
+
+	return addedDevices, nil
+}
+
+// updateDevices updates the devices in a single transaction, none of the devices is updated if any of them fails
+func updateDevices(conn redis.Conn, ds []models.Device) errors.EdgeX {
+	oldDevices, edgeXerr := devicesToUpdate(conn, ds)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	edgeXerr = sendUpdateDevicesCmd(conn, oldDevices, ds)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "devices update failed", err)
+	}
+
+	return nil
+}
+
+// devicesToUpdate checks the devices to update and returns the stored devices of them
+func devicesToUpdate(conn redis.Conn, ds []models.Device) ([]models.Device, errors.EdgeX) {
+	edgeXerr := devicesProfileCheck(conn, ds)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	oldDevices := make([]models.Device, len(ds))
+	for i, d := range ds {
+		oldDevices[i], edgeXerr = deviceByName(conn, d.Name)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return oldDevices, nil
+}
+
+// sendUpdateDevicesCmd sends the commands to replace the old devices with the devices into the transaction
+func sendUpdateDevicesCmd(conn redis.Conn, oldDevices []models.Device, ds []models.Device) errors.EdgeX {
+	ts := pkgCommon.MakeTimestamp()
+	for i, d := range ds {
+		d.Modified = ts
+		storedKey := deviceStoredKey(d.Id)
+		sendDeleteDeviceCmd(conn, storedKey, oldDevices[i])
+		edgeXerr := sendAddDeviceCmd(conn, storedKey, d)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		sendConnectDeviceCmd(conn, storedKey, oldDevices[i].OperatingState, d)
+		sendIncrRevisionCmd(conn, DeviceCollectionRevision, d.Name)
+	}
+	return nil
+}
+
+// deleteDevicesByNames deletes the devices by names in a single transaction, none of the devices is deleted if any of
+// them fails. A device having child devices can only be deleted together with all its children.
+func deleteDevicesByNames(conn redis.Conn, names []string) errors.EdgeX {
+	devices := make([]models.Device, len(names))
+	childrenToDelete := make(map[string]uint32)
+	for i, name := range names {
+		device, edgeXerr := deviceByName(conn, name)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		devices[i] = device
+		if device.Parent != "" {
+			childrenToDelete[device.Parent]++
+		}
+	}
+	for _, device := range devices {
+		numChildren, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionParent, device.Name))
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "Could not determine if device had any children", edgeXerr)
+		}
+		if numChildren > childrenToDelete[device.Name] {
+			return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("Cannot delete device %s, it has child devices", device.Name), nil)
+		}
+	}
+
+	_ = conn.Send(MULTI)
+	for _, device := range devices {
+		storedKey := deviceStoredKey(device.Id)
+		sendDeleteDeviceCmd(conn, storedKey, device)
+		_ = conn.Send(ZREM, DeviceCollectionLastConnected, storedKey)
+		sendDeleteRevisionCmd(conn, DeviceCollectionRevision, device.Name)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "devices deletion failed", err)
+	}
+	return nil
+}
+
 // Return all devices with the given parent and labels (one level of the tree).
 func deviceTreeLevel(conn redis.Conn, parent string, labels []string) ([]models.Device, errors.EdgeX) {
 	queryList := []string{CreateKey(DeviceCollectionParent, parent)}
@@ -361,3 +840,29 @@ func deviceTree(conn redis.Conn, parent string, levels int, offset int, limit in
 	}
 	return uint32(len(all_devices)), all_devices[offset : offset+numToReturn], nil
 }
+
+// deviceAncestors returns the ancestors of the device from its parent up to the root of the device tree. The ancestors
+// stop at the parent that doesn't exist, and an error is returned if the parents form a cycle.
+func deviceAncestors(conn redis.Conn, name string) ([]models.Device, errors.EdgeX) {
+	device, edgeXerr := deviceByName(conn, name)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	ancestors := []models.Device{}
+	visited := map[string]bool{device.Name: true}
+	for device.Parent != "" {
+		if visited[device.Parent] {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("the parents of device %s form a cycle at device %s, stopping ancestors query", name, device.Parent), nil)
+		}
+		visited[device.Parent] = true
+		device, edgeXerr = deviceByName(conn, device.Parent)
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			break
+		} else if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		ancestors = append(ancestors, device)
+	}
+	return ancestors, nil
+}
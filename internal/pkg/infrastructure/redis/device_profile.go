@@ -21,6 +21,7 @@ import (
 const (
 	DeviceProfileCollection             = "md|dp"
 	DeviceProfileCollectionName         = DeviceProfileCollection + DBKeySeparator + common.Name
+	DeviceProfileCollectionRevision     = DeviceProfileCollection + DBKeySeparator + "revision"
 	DeviceProfileCollectionLabel        = DeviceProfileCollection + DBKeySeparator + common.Label
 	DeviceProfileCollectionModel        = DeviceProfileCollection + DBKeySeparator + common.Model
 	DeviceProfileCollectionManufacturer = DeviceProfileCollection + DBKeySeparator + common.Manufacturer
@@ -66,8 +67,8 @@ func sendAddDeviceProfileCmd(conn redis.Conn, storedKey string, dp models.Device
 	return nil
 }
 
-// addDeviceProfile adds a device profile to DB
-func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
+// addDeviceProfile adds a device profile to DB, and records it as the first revision if maxRevisions is not 0
+func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile, maxRevisions uint32) (models.DeviceProfile, errors.EdgeX) {
 	// query device profile name and id to avoid the conflict
 	exists, edgeXerr := deviceProfileIdExists(conn, dp.Id)
 	if edgeXerr != nil {
@@ -97,6 +98,13 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DevicePr
 	if edgeXerr != nil {
 		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendAddRevisionCmd(conn, DeviceProfileCollectionRevision, dp.Name)
+	if maxRevisions > 0 {
+		edgeXerr = sendAddDeviceProfileRevisionCmd(conn, dp, 1)
+		if edgeXerr != nil {
+			return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile creation failed", err)
@@ -135,10 +143,18 @@ func sendDeleteDeviceProfileCmd(conn redis.Conn, storedKey string, dp models.Dev
 	}
 }
 
+// deleteDeviceProfile deletes the device profile along with its revision history
 func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX {
+	revisionKeys, edgeXerr := deviceProfileRevisionStoredKeys(conn, dp.Name, InfiniteMin, InfiniteMax)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceProfileCmd(conn, storedKey, dp)
+	sendDeleteRevisionCmd(conn, DeviceProfileCollectionRevision, dp.Name)
+	sendDeleteDeviceProfileRevisionsCmd(conn, dp.Name, revisionKeys)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile deletion failed", err)
@@ -146,8 +162,9 @@ func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX
 	return nil
 }
 
-// updateDeviceProfile updates a device profile to DB
-func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr errors.EdgeX) {
+// updateDeviceProfile updates a device profile to DB, and records it as a new revision at the increased entity revision
+// if maxRevisions is not 0, in which case the profile revision should be watched by the conn
+func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile, maxRevisions uint32) (edgeXerr errors.EdgeX) {
 	var oldDeviceProfile models.DeviceProfile
 	oldDeviceProfile, edgeXerr = deviceProfileById(conn, dp.Id)
 	if edgeXerr == nil {
@@ -165,6 +182,18 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 	dp.Created = oldDeviceProfile.Created
 	dp.Modified = pkgCommon.MakeTimestamp()
 
+	var history deviceProfileHistoryWrite
+	if maxRevisions > 0 {
+		revision, err := storedRevision(conn, DeviceProfileCollectionRevision, dp.Name)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the revision of device profile '%s' failed", dp.Name), err)
+		}
+		history, edgeXerr = prepareDeviceProfileHistoryWrite(conn, dp.Name, revision, maxRevisions)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceProfileCmd(conn, storedKey, oldDeviceProfile)
@@ -172,6 +201,13 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendIncrRevisionCmd(conn, DeviceProfileCollectionRevision, dp.Name)
+	if maxRevisions > 0 {
+		edgeXerr = history.sendCmd(conn, oldDeviceProfile, dp)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile update failed", err)
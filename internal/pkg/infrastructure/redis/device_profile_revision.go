@@ -0,0 +1,159 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+const (
+	DeviceProfileRevisionCollection            = "md|dprev"
+	DeviceProfileRevisionCollectionProfileName = DeviceProfileRevisionCollection + DBKeySeparator + common.ProfileName
+)
+
+// deviceProfileRevisionStoredKey return the device profile revision's stored key which combines the collection name and object id
+func deviceProfileRevisionStoredKey(id string) string {
+	return CreateKey(DeviceProfileRevisionCollection, id)
+}
+
+// deviceProfileHistoryWrite is the change of the revision history made along with a device profile change, which is
+// prepared before the transaction of the profile change and sent within it
+type deviceProfileHistoryWrite struct {
+	// revision is the entity revision of the changed profile
+	revision uint64
+	// seed indicates whether the profile before the change should be recorded, as the profile changed for the first
+	// time since the revision history was enabled has no revision recorded yet
+	seed bool
+	// expiredKeys are the stored keys of the revisions beyond the max revisions after the change
+	expiredKeys []string
+}
+
+// prepareDeviceProfileHistoryWrite prepares the revision history write of the device profile changed from the current
+// entity revision, which is 0 for a new profile. The current revision and history should be read under the watch of the
+// profile revision, so that they are not changed until the transaction of the profile change is committed.
+func prepareDeviceProfileHistoryWrite(conn redis.Conn, profileName string, currentRevision uint64, maxRevisions uint32) (deviceProfileHistoryWrite, errors.EdgeX) {
+	history := deviceProfileHistoryWrite{revision: currentRevision + 1}
+	if currentRevision == 0 {
+		return history, nil
+	}
+	collectionKey := CreateKey(DeviceProfileRevisionCollectionProfileName, profileName)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, collectionKey)
+	if edgeXerr != nil {
+		return history, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	oldest := uint64(1)
+	if history.revision > uint64(maxRevisions) {
+		oldest = history.revision - uint64(maxRevisions) + 1
+	}
+	history.seed = count == 0 && currentRevision >= oldest
+
+	history.expiredKeys, edgeXerr = deviceProfileRevisionStoredKeys(conn, profileName, InfiniteMin, fmt.Sprintf("(%d", oldest))
+	if edgeXerr != nil {
+		return history, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return history, nil
+}
+
+// sendCmd sends the commands of the revision history write, which records the changed profile at the entity revision
+func (h deviceProfileHistoryWrite) sendCmd(conn redis.Conn, current models.DeviceProfile, changed models.DeviceProfile) errors.EdgeX {
+	if h.seed {
+		if edgeXerr := sendAddDeviceProfileRevisionCmd(conn, current, h.revision-1); edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	if edgeXerr := sendAddDeviceProfileRevisionCmd(conn, changed, h.revision); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	sendDeleteDeviceProfileRevisionsCmd(conn, changed.Name, h.expiredKeys)
+	return nil
+}
+
+// sendAddDeviceProfileRevisionCmd sends the command adding the revision of the device profile, the revisions of a
+// profile are sorted by the revision number
+func sendAddDeviceProfileRevisionCmd(conn redis.Conn, profile models.DeviceProfile, revision uint64) errors.EdgeX {
+	r := metadataModels.DeviceProfileRevision{
+		Id:          uuid.New().String(),
+		ProfileName: profile.Name,
+		Revision:    revision,
+		Profile:     profile,
+		Created:     pkgCommon.MakeTimestamp(),
+	}
+	m, err := json.Marshal(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile revision for Redis persistence", err)
+	}
+
+	storedKey := deviceProfileRevisionStoredKey(r.Id)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CreateKey(DeviceProfileRevisionCollectionProfileName, r.ProfileName), r.Revision, storedKey)
+	return nil
+}
+
+// deviceProfileRevisionsByProfileName query the revisions of the device profile with offset and limit, the latest revision comes first
+func deviceProfileRevisionsByProfileName(conn redis.Conn, offset int, limit int, profileName string) ([]metadataModels.DeviceProfileRevision, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceProfileRevisionCollectionProfileName, profileName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToDeviceProfileRevisions(objects)
+}
+
+// deviceProfileRevisionByProfileNameAndRevision query the revision of the device profile
+func deviceProfileRevisionByProfileNameAndRevision(conn redis.Conn, profileName string, revision uint64) (metadataModels.DeviceProfileRevision, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, CreateKey(DeviceProfileRevisionCollectionProfileName, profileName), int64(revision), int64(revision), 0, 1)
+	if edgeXerr != nil {
+		return metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	revisions, edgeXerr := convertObjectsToDeviceProfileRevisions(objects)
+	if edgeXerr != nil {
+		return metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(revisions) == 0 {
+		return metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("revision %d of device profile '%s' does not exist", revision, profileName), nil)
+	}
+	return revisions[0], nil
+}
+
+// deviceProfileRevisionStoredKeys returns the stored keys of the revisions of the device profile within the revision range
+func deviceProfileRevisionStoredKeys(conn redis.Conn, profileName string, minRevision any, maxRevision any) ([]string, errors.EdgeX) {
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, CreateKey(DeviceProfileRevisionCollectionProfileName, profileName), minRevision, maxRevision))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve revisions of device profile '%s' failed", profileName), err)
+	}
+	return storedKeys, nil
+}
+
+// sendDeleteDeviceProfileRevisionsCmd sends the command deleting the revisions of the device profile by the stored keys
+func sendDeleteDeviceProfileRevisionsCmd(conn redis.Conn, profileName string, storedKeys []string) {
+	collectionKey := CreateKey(DeviceProfileRevisionCollectionProfileName, profileName)
+	for _, storedKey := range storedKeys {
+		_ = conn.Send(UNLINK, storedKey)
+		_ = conn.Send(ZREM, collectionKey, storedKey)
+	}
+}
+
+func convertObjectsToDeviceProfileRevisions(objects [][]byte) ([]metadataModels.DeviceProfileRevision, errors.EdgeX) {
+	revisions := make([]metadataModels.DeviceProfileRevision, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &revisions[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile revision format parsing failed from the database", err)
+		}
+	}
+	return revisions, nil
+}
@@ -19,9 +19,10 @@ import (
 )
 
 const (
-	DeviceServiceCollection      = "md|ds"
-	DeviceServiceCollectionName  = DeviceServiceCollection + DBKeySeparator + common.Name
-	DeviceServiceCollectionLabel = DeviceServiceCollection + DBKeySeparator + common.Label
+	DeviceServiceCollection         = "md|ds"
+	DeviceServiceCollectionName     = DeviceServiceCollection + DBKeySeparator + common.Name
+	DeviceServiceCollectionRevision = DeviceServiceCollection + DBKeySeparator + "revision"
+	DeviceServiceCollectionLabel    = DeviceServiceCollection + DBKeySeparator + common.Label
 )
 
 // deviceServiceStoredKey return the device service's stored key which combines the collection name and object id
@@ -91,6 +92,7 @@ func addDeviceService(conn redis.Conn, ds models.DeviceService) (addedDeviceServ
 	if edgeXerr != nil {
 		return ds, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendAddRevisionCmd(conn, DeviceServiceCollectionRevision, ds.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device service creation failed", err)
@@ -131,6 +133,7 @@ func deleteDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX
 	storedKey := deviceServiceStoredKey(ds.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceServiceCmd(conn, storedKey, ds)
+	sendDeleteRevisionCmd(conn, DeviceServiceCollectionRevision, ds.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service deletion failed", err)
@@ -214,6 +217,7 @@ func updateDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendIncrRevisionCmd(conn, DeviceServiceCollectionRevision, ds.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service update failed", err)
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+func TestDeviceMatchesQuery(t *testing.T) {
+	device := models.Device{
+		Name:           "sensor-01",
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         []string{"floor1", "hvac"},
+		Protocols:      map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1", "Port": float64(502)}},
+	}
+
+	tests := []struct {
+		name     string
+		query    metadataModels.DeviceQuery
+		expected bool
+	}{
+		{"empty query", metadataModels.DeviceQuery{}, true},
+		{"admin state matched", metadataModels.DeviceQuery{AdminStates: []string{models.Locked, models.Unlocked}}, true},
+		{"operating state not matched", metadataModels.DeviceQuery{OperatingStates: []string{models.Down}}, false},
+		{"no labels matched", metadataModels.DeviceQuery{NoLabels: []string{"floor2"}}, true},
+		{"no labels not matched", metadataModels.DeviceQuery{NoLabels: []string{"floor2", "hvac"}}, false},
+		{"protocol properties matched", metadataModels.DeviceQuery{ProtocolProperties: map[string]map[string]string{"modbus-tcp": {"Address": "10.0.0.1", "Port": "502"}}}, true},
+		{"protocol property value not matched", metadataModels.DeviceQuery{ProtocolProperties: map[string]map[string]string{"modbus-tcp": {"Address": "10.0.0.2"}}}, false},
+		{"protocol not found", metadataModels.DeviceQuery{ProtocolProperties: map[string]map[string]string{"mqtt": {"Topic": "t"}}}, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, deviceMatchesQuery(device, testCase.query))
+		})
+	}
+}
+
+func TestSortDevicesByQuery(t *testing.T) {
+	devices := []models.Device{
+		{Name: "c", DBTimestamp: models.DBTimestamp{Created: 1}},
+		{Name: "a", DBTimestamp: models.DBTimestamp{Created: 2}},
+		{Name: "b", DBTimestamp: models.DBTimestamp{Created: 1}},
+	}
+	names := func() []string {
+		result := make([]string, len(devices))
+		for i, d := range devices {
+			result[i] = d.Name
+		}
+		return result
+	}
+
+	sortDevicesByQuery(devices, metadataModels.DeviceQuery{SortBy: constants.DeviceSortByName})
+	assert.Equal(t, []string{"a", "b", "c"}, names())
+	sortDevicesByQuery(devices, metadataModels.DeviceQuery{SortBy: constants.DeviceSortByCreated})
+	assert.Equal(t, []string{"b", "c", "a"}, names())
+	sortDevicesByQuery(devices, metadataModels.DeviceQuery{SortBy: constants.DeviceSortByCreated, Descending: true})
+	assert.Equal(t, []string{"a", "c", "b"}, names())
+}
+
+func TestNamePrefixRange(t *testing.T) {
+	start, end := namePrefixRange("sensor-")
+	// the inclusive start and the exclusive end of the range
+	require.True(t, strings.HasPrefix(start, "["))
+	require.True(t, strings.HasPrefix(end, "("))
+	inRange := func(name string) bool { return name >= start[1:] && name < end[1:] }
+	for _, name := range []string{"sensor-", "sensor-01", "sensor-温度"} {
+		assert.True(t, inRange(name), name)
+	}
+	for _, name := range []string{"sensor", "sensor.01", "sensos"} {
+		assert.False(t, inRange(name), name)
+	}
+}
+
+func TestLastConnectedTrimRanges(t *testing.T) {
+	assert.Empty(t, lastConnectedTrimRanges(metadataModels.DeviceQuery{}))
+	// the bounds of the query are inclusive, so the ranges to trim exclude them
+	assert.Equal(t, [][2]string{{InfiniteMin, "(100"}}, lastConnectedTrimRanges(metadataModels.DeviceQuery{LastConnectedFrom: 100}))
+	assert.Equal(t, [][2]string{{"(200", InfiniteMax}}, lastConnectedTrimRanges(metadataModels.DeviceQuery{LastConnectedTo: 200}))
+	assert.Equal(t, [][2]string{{InfiniteMin, "(100"}, {"(200", InfiniteMax}},
+		lastConnectedTrimRanges(metadataModels.DeviceQuery{LastConnectedFrom: 100, LastConnectedTo: 200}))
+}
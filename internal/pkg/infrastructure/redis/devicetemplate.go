@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	DeviceTemplateCollection     = "md|dt"
+	DeviceTemplateCollectionName = DeviceTemplateCollection + DBKeySeparator + common.Name
+)
+
+// deviceTemplateStoredKey return the device template's stored key which combines the collection name and object id
+func deviceTemplateStoredKey(id string) string {
+	return CreateKey(DeviceTemplateCollection, id)
+}
+
+// sendAddDeviceTemplateCmd send redis command for adding device template
+func sendAddDeviceTemplateCmd(conn redis.Conn, storedKey string, t metadataModels.DeviceTemplate) errors.EdgeX {
+	m, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device template for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceTemplateCollection, t.Modified, storedKey)
+	_ = conn.Send(HSET, DeviceTemplateCollectionName, t.Name, storedKey)
+	return nil
+}
+
+// addDeviceTemplate adds a new device template into DB
+func addDeviceTemplate(conn redis.Conn, t metadataModels.DeviceTemplate) (metadataModels.DeviceTemplate, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceTemplateStoredKey(t.Id))
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device template id %s already exists", t.Id), nil)
+	}
+	exists, edgeXerr = objectNameExists(conn, DeviceTemplateCollectionName, t.Name)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device template name %s already exists", t.Name), nil)
+	}
+
+	t.Created = pkgCommon.MakeTimestamp()
+	t.Modified = t.Created
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceTemplateCmd(conn, deviceTemplateStoredKey(t.Id), t)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindDatabaseError, "device template creation failed", err)
+	}
+	return t, nil
+}
+
+// deviceTemplateByName query device template by name from DB
+func deviceTemplateByName(conn redis.Conn, name string) (template metadataModels.DeviceTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceTemplateCollectionName, name, &template)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device template by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allDeviceTemplates query the device templates with offset and limit, the latest modified template comes first
+func allDeviceTemplates(conn redis.Conn, offset int, limit int) ([]metadataModels.DeviceTemplate, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceTemplateCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	templates := make([]metadataModels.DeviceTemplate, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &templates[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device template format parsing failed from the database", err)
+		}
+	}
+	return templates, nil
+}
+
+// sendDeleteDeviceTemplateCmd send redis command for deleting device template
+func sendDeleteDeviceTemplateCmd(conn redis.Conn, storedKey string, t metadataModels.DeviceTemplate) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceTemplateCollection, storedKey)
+	_ = conn.Send(HDEL, DeviceTemplateCollectionName, t.Name)
+}
+
+// updateDeviceTemplate replaces the device template of the same name
+func updateDeviceTemplate(conn redis.Conn, t metadataModels.DeviceTemplate, linkedDevices []models.Device) errors.EdgeX {
+	oldTemplate, edgeXerr := deviceTemplateByName(conn, t.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	oldDevices, edgeXerr := devicesToUpdate(conn, linkedDevices)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	t.Id = oldTemplate.Id
+	t.Created = oldTemplate.Created
+	t.Modified = pkgCommon.MakeTimestamp()
+	storedKey := deviceTemplateStoredKey(t.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTemplateCmd(conn, storedKey, oldTemplate)
+	edgeXerr = sendAddDeviceTemplateCmd(conn, storedKey, t)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = sendUpdateDevicesCmd(conn, oldDevices, linkedDevices)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device template update failed", err)
+	}
+	return nil
+}
+
+// deleteDeviceTemplateByName deletes the device template by name
+func deleteDeviceTemplateByName(conn redis.Conn, name string) errors.EdgeX {
+	template, edgeXerr := deviceTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTemplateCmd(conn, deviceTemplateStoredKey(template.Id), template)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device template deletion failed", err)
+	}
+	return nil
+}
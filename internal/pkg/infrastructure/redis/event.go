@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2021 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"time"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -125,13 +126,70 @@ func eventStoredKey(id string) string {
 }
 
 func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXerr errors.EdgeX) {
-	// query Event by Id first to avoid the Id conflict
-	_, edgeXerr = eventById(conn, e.Id)
+	edgeXerr = checkEventIdNotExists(conn, e.Id)
+	if edgeXerr != nil {
+		return addedEvent, edgeXerr
+	}
+
+	_ = conn.Send(MULTI)
+	addedEvent, edgeXerr = sendAddEventCmd(conn, e)
+	if edgeXerr != nil {
+		return models.Event{}, edgeXerr
+	}
+
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
+	}
+
+	return addedEvent, edgeXerr
+}
+
+// addEvents adds the events along with their readings in a single transaction, so either all the events are added or
+// none of them
+func addEvents(conn redis.Conn, events []models.Event) ([]models.Event, errors.EdgeX) {
+	ids := make(map[string]bool, len(events))
+	for _, e := range events {
+		if ids[e.Id] {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s is duplicated in the batch", e.Id), nil)
+		}
+		ids[e.Id] = true
+		edgeXerr := checkEventIdNotExists(conn, e.Id)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add event %s", e.Id), edgeXerr)
+		}
+	}
+
+	addedEvents := make([]models.Event, len(events))
+	_ = conn.Send(MULTI)
+	for i, e := range events {
+		addedEvent, edgeXerr := sendAddEventCmd(conn, e)
+		if edgeXerr != nil {
+			_, _ = conn.Do(DISCARD)
+			return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add event %s", e.Id), edgeXerr)
+		}
+		addedEvents[i] = addedEvent
+	}
+
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "events creation failed", err)
+	}
+	return addedEvents, nil
+}
+
+// checkEventIdNotExists queries Event by Id to avoid the Id conflict
+func checkEventIdNotExists(conn redis.Conn, id string) errors.EdgeX {
+	_, edgeXerr := eventById(conn, id)
 	if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
-		return addedEvent, errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil)
+		return errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil)
 	}
-	edgeXerr = nil
+	return nil
+}
 
+// sendAddEventCmd sends the commands to add the event along with its readings into the transaction, and returns the
+// event with the added readings
+func sendAddEventCmd(conn redis.Conn, e models.Event) (models.Event, errors.EdgeX) {
 	event := models.Event{
 		Id:          e.Id,
 		DeviceName:  e.DeviceName,
@@ -143,11 +201,10 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 
 	m, err := json.Marshal(event)
 	if err != nil {
-		return addedEvent, errors.NewCommonEdgeX(errors.KindContractInvalid, "event parsing failed", err)
+		return models.Event{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "event parsing failed", err)
 	}
 
 	storedKey := eventStoredKey(e.Id)
-	_ = conn.Send(MULTI)
 	// use the SET command to save event as blob
 	_ = conn.Send(SET, storedKey, m)
 	_ = conn.Send(ZADD, EventsCollection, e.Origin, storedKey)
@@ -174,13 +231,7 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 	if len(rids) > 1 {
 		_ = conn.Send(ZADD, rids...)
 	}
-
-	_, err = conn.Do(EXEC)
-	if err != nil {
-		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
-	}
-
-	return e, edgeXerr
+	return e, nil
 }
 
 func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
@@ -274,6 +325,20 @@ func eventsByTimeRange(conn redis.Conn, startTime int64, endTime int64, offset i
 	return convertObjectsToEvents(conn, objects)
 }
 
+// eventsByCursor query events by the cursor query in descending order of origin, and returns the cursor of the next page
+func eventsByCursor(conn redis.Conn, query dataModels.CursorQuery) (events []models.Event, nextCursor string, edgeXerr errors.EdgeX) {
+	key := EventsCollectionOrigin
+	if query.DeviceName != "" {
+		key = CreateKey(EventsCollectionDeviceName, query.DeviceName)
+	}
+	objects, nextCursor, edgeXerr := getObjectsByScoreRangeAndCursor(conn, key, query.Start, query.End, query.Cursor, query.Limit)
+	if edgeXerr != nil {
+		return events, "", edgeXerr
+	}
+	events, edgeXerr = convertObjectsToEvents(conn, objects)
+	return events, nextCursor, edgeXerr
+}
+
 func convertObjectsToEvents(conn redis.Conn, objects [][]byte) (events []models.Event, edgeXerr errors.EdgeX) {
 	events = make([]models.Event, len(objects))
 	for i, in := range objects {
@@ -306,6 +371,11 @@ func (c *Client) DeleteEventsByAgeAndDeviceNameAndSourceName(age int64, deviceNa
 	return nil
 }
 
+func (c *Client) RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName(age int64, intervals []int64, deviceName, sourceName string) errors.EdgeX {
+	c.loggingClient.Warn("RollupAndDeleteEventsByAgeAndDeviceNameAndSourceName function didn't implement")
+	return nil
+}
+
 func (c *Client) LatestEventByDeviceNameAndSourceNameAndOffset(deviceName string, sourceName string, offset uint32) (models.Event, errors.EdgeX) {
 	c.loggingClient.Warn("LatestEventByDeviceNameAndSourceNameAndOffset function didn't implement")
 	return models.Event{}, nil
@@ -20,6 +20,7 @@ import (
 const (
 	ProvisionWatcherCollection            = "md|pw"
 	ProvisionWatcherCollectionName        = ProvisionWatcherCollection + DBKeySeparator + common.Name
+	ProvisionWatcherCollectionRevision    = ProvisionWatcherCollection + DBKeySeparator + "revision"
 	ProvisionWatcherCollectionLabel       = ProvisionWatcherCollection + DBKeySeparator + common.Label
 	ProvisionWatcherCollectionServiceName = ProvisionWatcherCollectionName + DBKeySeparator + common.Service + DBKeySeparator + common.Name
 	ProvisionWatcherCollectionProfileName = ProvisionWatcherCollectionName + DBKeySeparator + common.Profile + DBKeySeparator + common.Name
@@ -84,6 +85,7 @@ func addProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) (addedProv
 	storedKey := provisionWatcherStoredKey(pw.Id)
 	_ = conn.Send(MULTI)
 	edgexErr = sendAddProvisionWatcherCmd(conn, storedKey, pw)
+	sendAddRevisionCmd(conn, ProvisionWatcherCollectionRevision, pw.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgexErr = errors.NewCommonEdgeX(errors.KindDatabaseError, "provision watcher creation failed", err)
@@ -203,6 +205,7 @@ func deleteProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) errors.
 	storedKey := provisionWatcherStoredKey(pw.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteProvisionWatcherCmd(conn, storedKey, pw)
+	sendDeleteRevisionCmd(conn, ProvisionWatcherCollectionRevision, pw.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "provision watcher deletion failed", err)
@@ -234,6 +237,7 @@ func updateProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) errors.
 	if edgexErr != nil {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
+	sendIncrRevisionCmd(conn, ProvisionWatcherCollectionRevision, pw.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "provision watcher update failed", err)
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -110,6 +110,64 @@ func getObjectsByScoreRange(conn redis.Conn, key string, start int64, end int64,
 	return getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(objIds))
 }
 
+// getObjectsByScoreRangeAndCursor retrieves at most count objects of the sorted set within the score range in the descending order
+// of score and member. If the cursor is not empty, the objects are retrieved after the cursor member in that order. The members
+// with the same score are sorted in descending lexicographical order, so the members retrieved in the previous pages with the cursor
+// score are skipped. The returned next cursor refers to the last retrieved member, and is empty if there are no more objects.
+func getObjectsByScoreRangeAndCursor(conn redis.Conn, key string, start int64, end int64, cursor string, count int) (objects [][]byte, nextCursor string, edgeXerr errors.EdgeX) {
+	var max any = end
+	var cursorScore float64
+	var cursorMember string
+	var ties int
+	if cursor != "" {
+		var scoreStr string
+		var found bool
+		var err error
+		scoreStr, cursorMember, found = strings.Cut(cursor, cursorSeparator)
+		if cursorScore, err = strconv.ParseFloat(scoreStr, 64); !found || err != nil || cursorMember == "" {
+			return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cursor '%s'", cursor), nil)
+		}
+		if cursorScore < float64(end) {
+			max = scoreStr
+		}
+		ties, err = redis.Int(conn.Do(ZCOUNT, key, scoreStr, scoreStr))
+		if err != nil {
+			return nil, "", errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to count the members with the cursor score", err)
+		}
+	}
+
+	// retrieve one more member than the count to know whether there is a next page
+	values, err := redis.Strings(conn.Do(ZREVRANGEBYSCORE, key, max, start, WITHSCORES, LIMIT, 0, count+1+ties))
+	if err != nil {
+		return nil, "", errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to query the members by score range", err)
+	}
+	var members, scores []string
+	for i := 0; i+1 < len(values) && len(members) <= count; i += 2 {
+		member, scoreStr := values[i], values[i+1]
+		if cursor != "" {
+			score, err := strconv.ParseFloat(scoreStr, 64)
+			if err != nil {
+				return nil, "", errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to parse the score of member %s", member), err)
+			}
+			if score == cursorScore && member >= cursorMember {
+				continue
+			}
+		}
+		members = append(members, member)
+		scores = append(scores, scoreStr)
+	}
+	if len(members) > count {
+		members = members[:count]
+		nextCursor = scores[count-1] + cursorSeparator + members[count-1]
+	}
+
+	objects, edgeXerr = getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(members))
+	if edgeXerr != nil {
+		return nil, "", edgeXerr
+	}
+	return objects, nextCursor, nil
+}
+
 // getObjectsByLabelsAndSomeRange retrieves the entries for keys enumerated in a sorted set using the specified Redis range
 // command (i.e. RANGE, REVRANGE). The entries are retrieved in the order specified by the supplied Redis command.
 func getObjectsByLabelsAndSomeRange(conn redis.Conn, command string, key string, labels []string, offset int, limit int) ([][]byte, errors.EdgeX) {
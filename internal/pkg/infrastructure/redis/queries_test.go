@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sortedSetMember struct {
+	member string
+	score  float64
+}
+
+// fakeSortedSetConn serves the ZCOUNT, ZREVRANGEBYSCORE and MGET commands of a single sorted set, the object of each member
+// is the member itself
+type fakeSortedSetConn struct {
+	redis.Conn
+	members []sortedSetMember
+}
+
+func (f *fakeSortedSetConn) Do(command string, args ...interface{}) (interface{}, error) {
+	parseScore := func(arg interface{}) float64 {
+		score, _ := strconv.ParseFloat(fmt.Sprint(arg), 64)
+		return score
+	}
+	switch command {
+	case ZCOUNT:
+		minScore, maxScore := parseScore(args[1]), parseScore(args[2])
+		var count int64
+		for _, m := range f.members {
+			if m.score >= minScore && m.score <= maxScore {
+				count++
+			}
+		}
+		return count, nil
+	case ZREVRANGEBYSCORE:
+		maxScore, minScore, limit := parseScore(args[1]), parseScore(args[2]), args[6].(int)
+		members := make([]sortedSetMember, len(f.members))
+		copy(members, f.members)
+		sort.Slice(members, func(i, j int) bool {
+			if members[i].score != members[j].score {
+				return members[i].score > members[j].score
+			}
+			return members[i].member > members[j].member
+		})
+		var values []interface{}
+		for _, m := range members {
+			if m.score >= minScore && m.score <= maxScore && len(values) < limit*2 {
+				values = append(values, []byte(m.member), []byte(strconv.FormatFloat(m.score, 'g', 17, 64)))
+			}
+		}
+		return values, nil
+	case MGET:
+		var values []interface{}
+		for _, arg := range args {
+			values = append(values, []byte(fmt.Sprint(arg)))
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("unsupported command %s", command)
+}
+
+func TestGetObjectsByScoreRangeAndCursor(t *testing.T) {
+	conn := &fakeSortedSetConn{members: []sortedSetMember{
+		{"a", 30}, {"b", 20}, {"c", 20}, {"d", 20}, {"e", 10}, {"f", 5},
+	}}
+
+	// page through the sorted set two members at a time, the members with the same score are split across the pages
+	var pages [][]string
+	var cursor string
+	for {
+		objects, nextCursor, err := getObjectsByScoreRangeAndCursor(conn, "key", 10, 30, cursor, 2)
+		require.NoError(t, err)
+		var page []string
+		for _, object := range objects {
+			page = append(page, string(object))
+		}
+		pages = append(pages, page)
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+	assert.Equal(t, [][]string{{"a", "d"}, {"c", "b"}, {"e"}}, pages, "Pages not as expected")
+
+	_, _, err := getObjectsByScoreRangeAndCursor(conn, "key", 10, 30, "invalid", 2)
+	assert.Error(t, err, "an invalid cursor should be rejected")
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,11 +8,13 @@ package redis
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	"github.com/gomodule/redigo/redis"
@@ -27,6 +29,9 @@ const (
 	ReadingsCollectionDeviceNameResourceName = ReadingsCollection + DBKeySeparator + common.DeviceName + DBKeySeparator + common.ResourceName
 )
 
+// readingScanBatchSize is the number of readings retrieved at a time when the readings are scanned in memory
+const readingScanBatchSize = 1000
+
 var emptyBinaryValue = make([]byte, 0)
 
 // asyncDeleteReadingsByIds deletes all readings with given reading Ids.  This function is implemented to be run as a
@@ -281,6 +286,164 @@ func readingsByDeviceNameAndTimeRange(conn redis.Conn, deviceName string, startT
 	return convertObjectsToReadings(objects)
 }
 
+// readingAggregatesByDeviceNameAndResourceNameAndTimeRange calculates the statistics of the numeric readings by device name,
+// resource name and time range. Redis has no aggregate functions for the stored reading values, so the readings within the
+// time range are scanned in batches through the sorted set of device name and resource name and grouped by the interval, which
+// only holds one batch of the readings and the aggregates in memory. The scan time still grows with the readings in the range.
+func readingAggregatesByDeviceNameAndResourceNameAndTimeRange(conn redis.Conn, deviceName string, resourceName string, startTime int64, endTime int64, interval int64) (aggregates []dataModels.ReadingAggregate, edgeXerr errors.EdgeX) {
+	aggregator := newReadingAggregator(startTime, interval)
+	edgeXerr = scanReadingsByScoreRange(conn, CreateKey(ReadingsCollectionDeviceNameResourceName, deviceName, resourceName), startTime, endTime, aggregator.add)
+	if edgeXerr != nil {
+		return aggregates, edgeXerr
+	}
+	return aggregator.aggregates(), nil
+}
+
+// readingAggregator groups the numeric simple readings, which are added in descending order of origin, into the buckets of the
+// interval starting from the start time, and calculates the count/min/max/avg/first/last of each bucket
+type readingAggregator struct {
+	startTime int64
+	interval  int64
+	// buckets are in descending order of the bucket start as the readings are added
+	buckets []dataModels.ReadingAggregate
+	sums    []float64
+}
+
+func newReadingAggregator(startTime int64, interval int64) *readingAggregator {
+	return &readingAggregator{startTime: startTime, interval: interval}
+}
+
+// add groups the readings, which should be in descending order of origin and follow the readings added before
+func (a *readingAggregator) add(readings []models.Reading) {
+	for _, r := range readings {
+		simpleReading, ok := r.(models.SimpleReading)
+		if !ok || !pkgCommon.IsNumericValueType(simpleReading.ValueType) {
+			continue
+		}
+		value, err := strconv.ParseFloat(simpleReading.Value, 64)
+		if err != nil {
+			continue
+		}
+		bucket := a.startTime + (simpleReading.Origin-a.startTime)/a.interval*a.interval
+		last := len(a.buckets) - 1
+		if last < 0 || a.buckets[last].Start != bucket {
+			a.buckets = append(a.buckets, dataModels.ReadingAggregate{
+				DeviceName:   simpleReading.DeviceName,
+				ResourceName: simpleReading.ResourceName,
+				Start:        bucket,
+				Interval:     a.interval,
+				Min:          value,
+				Max:          value,
+				Last:         value,
+			})
+			a.sums = append(a.sums, 0)
+			last++
+		}
+		aggregate := &a.buckets[last]
+		aggregate.Count++
+		aggregate.Min = min(aggregate.Min, value)
+		aggregate.Max = max(aggregate.Max, value)
+		// the readings are added from the latest one, so the first value of the bucket is the one added last
+		aggregate.First = value
+		a.sums[last] += value
+	}
+}
+
+// aggregates returns the aggregates of the added readings sorted by the bucket start
+func (a *readingAggregator) aggregates() []dataModels.ReadingAggregate {
+	aggregates := make([]dataModels.ReadingAggregate, len(a.buckets))
+	for i, aggregate := range a.buckets {
+		aggregate.Avg = a.sums[i] / float64(aggregate.Count)
+		aggregates[len(a.buckets)-1-i] = aggregate
+	}
+	return aggregates
+}
+
+// readingsByCursor query readings by the cursor query in descending order of origin, and returns the cursor of the next page
+func readingsByCursor(conn redis.Conn, query dataModels.CursorQuery) (readings []models.Reading, nextCursor string, edgeXerr errors.EdgeX) {
+	objects, nextCursor, edgeXerr := getObjectsByScoreRangeAndCursor(conn, readingsSortedSetKey(query.DeviceName, query.ResourceName), query.Start, query.End, query.Cursor, query.Limit)
+	if edgeXerr != nil {
+		return readings, "", edgeXerr
+	}
+	readings, edgeXerr = convertObjectsToReadings(objects)
+	return readings, nextCursor, edgeXerr
+}
+
+// readingsSortedSetKey returns the key of the sorted set of the readings narrowed by the device and resource names, an empty
+// name matches any device or resource
+func readingsSortedSetKey(deviceName string, resourceName string) string {
+	switch {
+	case deviceName != "" && resourceName != "":
+		return CreateKey(ReadingsCollectionDeviceNameResourceName, deviceName, resourceName)
+	case deviceName != "":
+		return CreateKey(ReadingsCollectionDeviceName, deviceName)
+	case resourceName != "":
+		return CreateKey(ReadingsCollectionResourceName, resourceName)
+	}
+	return ReadingsCollectionOrigin
+}
+
+// scanReadingsByScoreRange retrieves the readings of the sorted set within the time range in batches of readingScanBatchSize in
+// descending order of origin, and passes each batch to scan, so that only one batch of the readings is held in memory at a time
+func scanReadingsByScoreRange(conn redis.Conn, key string, start int64, end int64, scan func(readings []models.Reading)) errors.EdgeX {
+	cursor := ""
+	for {
+		objects, nextCursor, edgeXerr := getObjectsByScoreRangeAndCursor(conn, key, start, end, cursor, readingScanBatchSize)
+		if edgeXerr != nil {
+			return edgeXerr
+		}
+		readings, edgeXerr := convertObjectsToReadings(objects)
+		if edgeXerr != nil {
+			return edgeXerr
+		}
+		scan(readings)
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// readingsByValueFilter query the numeric readings whose value satisfies the filter with offset and limit, and returns the total
+// count of them as well. Redis has no index of the stored reading values, so the readings within the time range are scanned in
+// batches through the sorted set narrowed by the device and resource names of the filter and compared in memory, only the
+// readings of the requested page are kept. The returned readings are sorted in descending order of origin.
+func readingsByValueFilter(conn redis.Conn, filter dataModels.ReadingValueFilter, offset int, limit int) (totalCount uint32, readings []models.Reading, edgeXerr errors.EdgeX) {
+	if offset < 0 {
+		offset = 0
+	}
+	readings = make([]models.Reading, 0)
+	edgeXerr = scanReadingsByScoreRange(conn, readingsSortedSetKey(filter.DeviceName, filter.ResourceName), filter.Start, filter.End, func(batch []models.Reading) {
+		for _, r := range filterReadingsByValue(batch, filter) {
+			if int(totalCount) >= offset && (limit < 0 || len(readings) < limit) {
+				readings = append(readings, r)
+			}
+			totalCount++
+		}
+	})
+	if edgeXerr != nil {
+		return 0, []models.Reading{}, edgeXerr
+	}
+	return totalCount, readings, nil
+}
+
+// filterReadingsByValue returns the numeric simple readings whose value satisfies the operator of the filter
+func filterReadingsByValue(readings []models.Reading, filter dataModels.ReadingValueFilter) []models.Reading {
+	filtered := make([]models.Reading, 0)
+	for _, r := range readings {
+		simpleReading, ok := r.(models.SimpleReading)
+		if !ok || !pkgCommon.IsNumericValueType(simpleReading.ValueType) {
+			continue
+		}
+		value, err := strconv.ParseFloat(simpleReading.Value, 64)
+		if err != nil || !filter.Matches(value) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
 func convertObjectsToReadings(objects [][]byte) (readings []models.Reading, edgeXerr errors.EdgeX) {
 	readings = make([]models.Reading, len(objects))
 	var alias struct {
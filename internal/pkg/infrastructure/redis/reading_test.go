@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -13,6 +13,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 )
 
 const (
@@ -106,3 +108,90 @@ func TestConvertObjectsToReadings(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedReadings, events)
 }
+
+func TestReadingAggregator(t *testing.T) {
+	interval := int64(10)
+	newReading := func(origin int64, valueType string, value string) models.Reading {
+		reading := simpleReadingData()
+		reading.Origin = origin
+		reading.ValueType = valueType
+		reading.Value = value
+		return reading
+	}
+	// readings are scanned in batches in descending order of origin from the sorted set, and a bucket may span the batches
+	batches := [][]models.Reading{
+		{
+			newReading(25, common.ValueTypeFloat64, "4.5"),
+			newReading(21, common.ValueTypeInt32, "-1"),
+			newReading(15, common.ValueTypeString, "abc"),
+			newReading(9, common.ValueTypeInt32, "6"),
+		},
+		{
+			newReading(3, common.ValueTypeInt32, "2"),
+			newReading(0, common.ValueTypeInt32, "1"),
+			binaryReadingData(),
+		},
+	}
+
+	aggregator := newReadingAggregator(0, interval)
+	for _, batch := range batches {
+		aggregator.add(batch)
+	}
+	aggregates := aggregator.aggregates()
+
+	require.Len(t, aggregates, 2)
+	assert.Equal(t, int64(0), aggregates[0].Start)
+	assert.Equal(t, uint32(3), aggregates[0].Count)
+	assert.Equal(t, float64(1), aggregates[0].Min)
+	assert.Equal(t, float64(6), aggregates[0].Max)
+	assert.Equal(t, float64(3), aggregates[0].Avg)
+	assert.Equal(t, float64(1), aggregates[0].First)
+	assert.Equal(t, float64(6), aggregates[0].Last)
+	assert.Equal(t, int64(20), aggregates[1].Start)
+	assert.Equal(t, uint32(2), aggregates[1].Count)
+	assert.Equal(t, float64(-1), aggregates[1].Min)
+	assert.Equal(t, 4.5, aggregates[1].Max)
+	assert.Equal(t, 1.75, aggregates[1].Avg)
+	assert.Equal(t, float64(-1), aggregates[1].First)
+	assert.Equal(t, 4.5, aggregates[1].Last)
+	assert.Empty(t, newReadingAggregator(0, interval).aggregates())
+}
+
+func TestFilterReadingsByValue(t *testing.T) {
+	newReading := func(valueType string, value string) models.Reading {
+		reading := simpleReadingData()
+		reading.ValueType = valueType
+		reading.Value = value
+		return reading
+	}
+	readings := []models.Reading{
+		newReading(common.ValueTypeFloat64, "80.5"),
+		newReading(common.ValueTypeInt32, "80"),
+		newReading(common.ValueTypeString, "90"),
+		newReading(common.ValueTypeString, "on"),
+		newReading(common.ValueTypeInt32, "70"),
+		binaryReadingData(),
+	}
+
+	tests := []struct {
+		name           string
+		filter         dataModels.ReadingValueFilter
+		expectedValues []string
+	}{
+		{"greater than", dataModels.ReadingValueFilter{Operator: dataModels.ValueOperatorGreaterThan, Value: 80}, []string{"80.5"}},
+		{"less than", dataModels.ReadingValueFilter{Operator: dataModels.ValueOperatorLessThan, Value: 80}, []string{"70"}},
+		{"equal", dataModels.ReadingValueFilter{Operator: dataModels.ValueOperatorEqual, Value: 80}, []string{"80"}},
+		{"between is inclusive", dataModels.ReadingValueFilter{Operator: dataModels.ValueOperatorBetween, Value: 70, UpperValue: 80}, []string{"80", "70"}},
+		{"no match", dataModels.ReadingValueFilter{Operator: dataModels.ValueOperatorGreaterThan, Value: 100}, []string{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			filtered := filterReadingsByValue(readings, testCase.filter)
+			values := make([]string, len(filtered))
+			for i, r := range filtered {
+				values[i] = r.(models.SimpleReading).Value
+			}
+			assert.Equal(t, testCase.expectedValues, values)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	stdErrs "errors"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// maxIfRevisionAttempts is the max attempts of a conditional write, which is aborted and attempted again when the
+// entity is changed after its revision is checked and before the write is committed
+const maxIfRevisionAttempts = 5
+
+// errTransactionAborted is returned by the EXEC of a watchedConn when a watched key is changed before the EXEC
+var errTransactionAborted = stdErrs.New("transaction aborted by a change of the watched keys")
+
+// entityRevisionHashes maps the types of the core-metadata entities having the revision to their name and revision hashes
+var entityRevisionHashes = map[string][2]string{
+	common.DeviceSystemEventType:           {DeviceCollectionName, DeviceCollectionRevision},
+	common.DeviceProfileSystemEventType:    {DeviceProfileCollectionName, DeviceProfileCollectionRevision},
+	common.DeviceServiceSystemEventType:    {DeviceServiceCollectionName, DeviceServiceCollectionRevision},
+	common.ProvisionWatcherSystemEventType: {ProvisionWatcherCollectionName, ProvisionWatcherCollectionRevision},
+}
+
+// entityRevision returns the revision of the entity by name from the revision hash, the entity stored before the
+// revision was introduced has no revision field and is at revision 1 as the other entities are when they are added
+func entityRevision(conn redis.Conn, nameHash string, revisionHash string, entityType string, name string) (uint64, errors.EdgeX) {
+	exists, edgeXerr := objectNameExists(conn, nameHash, name)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("%s existence check by name failed", entityType), edgeXerr)
+	} else if !exists {
+		return 0, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s '%s' does not exist", entityType, name), nil)
+	}
+
+	revision, err := storedRevision(conn, revisionHash, name)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the revision of %s '%s' failed", entityType, name), err)
+	}
+	return revision, nil
+}
+
+// storedRevision returns the revision of the entity by name from the revision hash, a missing field is revision 1
+func storedRevision(conn redis.Conn, revisionHash string, name string) (uint64, error) {
+	revision, err := redis.Uint64(conn.Do(HGET, revisionHash, name))
+	if err == redis.ErrNil {
+		return 1, nil
+	}
+	return revision, err
+}
+
+// revisionWatchKey returns the key watched by the conditional writes of the entity, which is changed along with every
+// change of the entity revision, so that the conditional write is only aborted by the changes of the same entity
+func revisionWatchKey(revisionHash string, name string) string {
+	return CreateKey(revisionHash, name)
+}
+
+// sendAddRevisionCmd sends the command setting the revision of the added entity to 1 in the revision hash
+func sendAddRevisionCmd(conn redis.Conn, revisionHash string, name string) {
+	_ = conn.Send(HSET, revisionHash, name, 1)
+	_ = conn.Send(INCR, revisionWatchKey(revisionHash, name))
+}
+
+// sendIncrRevisionCmd sends the command increasing the revision of the entity in the revision hash, the missing field
+// of the entity stored before the revision was introduced is set to revision 1 first
+func sendIncrRevisionCmd(conn redis.Conn, revisionHash string, name string) {
+	_ = conn.Send(HSETNX, revisionHash, name, 1)
+	_ = conn.Send(HINCRBY, revisionHash, name, 1)
+	_ = conn.Send(INCR, revisionWatchKey(revisionHash, name))
+}
+
+// sendDeleteRevisionCmd sends the command removing the revision of the deleted entity from the revision hash, the watch
+// key is changed before it's deleted as deleting a missing key doesn't abort the transactions watching it
+func sendDeleteRevisionCmd(conn redis.Conn, revisionHash string, name string) {
+	_ = conn.Send(HDEL, revisionHash, name)
+	_ = conn.Send(INCR, revisionWatchKey(revisionHash, name))
+	_ = conn.Send(DEL, revisionWatchKey(revisionHash, name))
+}
+
+// watchedConn is the connection watching the revision watch keys of a conditional write, whose EXEC returns
+// errTransactionAborted instead of the nil reply when the transaction is aborted
+type watchedConn struct {
+	redis.Conn
+}
+
+func (c watchedConn) Do(commandName string, args ...any) (any, error) {
+	reply, err := c.Conn.Do(commandName, args...)
+	if err == nil && reply == nil && commandName == EXEC {
+		return nil, errTransactionAborted
+	}
+	return reply, err
+}
+
+// writeIfRevisions runs the write only if the entities are still at the revisions of ifRevisions, which are keyed by
+// the entity names, and the entity at revision 0 is written unconditionally
+func writeIfRevisions(conn redis.Conn, revisionHash string, entityType string, ifRevisions map[string]uint64, write func(conn redis.Conn) errors.EdgeX) errors.EdgeX {
+	conditional := make(map[string]uint64, len(ifRevisions))
+	for name, ifRevision := range ifRevisions {
+		if ifRevision != 0 {
+			conditional[name] = ifRevision
+		}
+	}
+	if len(conditional) == 0 {
+		return write(conn)
+	}
+	return writeWatchingRevisions(conn, revisionHash, entityType, conditional, write)
+}
+
+// writeWatchingRevisions runs the write with the watch keys of the entities of ifRevisions watched from the revision
+// check until the write is committed, and the entity at revision 0 is watched without the revision check. The write is
+// aborted if any of the entities is changed in between, and it's attempted again to check the revisions against the
+// change, so the write may also read the current state of the entities consistently. A conflict error is returned when
+// all the attempts are aborted, which may be retried by the caller.
+func writeWatchingRevisions(conn redis.Conn, revisionHash string, entityType string, ifRevisions map[string]uint64, write func(conn redis.Conn) errors.EdgeX) errors.EdgeX {
+	watchKeys := make([]any, 0, len(ifRevisions))
+	for name := range ifRevisions {
+		watchKeys = append(watchKeys, revisionWatchKey(revisionHash, name))
+	}
+
+	for attempt := 0; attempt < maxIfRevisionAttempts; attempt++ {
+		if _, err := conn.Do(WATCH, watchKeys...); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("watch the revisions of %s failed", entityType), err)
+		}
+		for name, ifRevision := range ifRevisions {
+			if ifRevision == 0 {
+				continue
+			}
+			revision, err := storedRevision(conn, revisionHash, name)
+			if err != nil {
+				_, _ = conn.Do(UNWATCH)
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the revision of %s '%s' failed", entityType, name), err)
+			} else if revision != ifRevision {
+				_, _ = conn.Do(UNWATCH)
+				return utils.NewPreconditionFailedError(entityType, name, utils.ETag(ifRevision))
+			}
+		}
+
+		edgeXerr := write(watchedConn{conn})
+		if edgeXerr == nil {
+			return nil
+		} else if !stdErrs.Is(edgeXerr, errTransactionAborted) {
+			_, _ = conn.Do(UNWATCH)
+			return edgeXerr
+		}
+	}
+	return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("the write of %s was aborted by the concurrent changes for %d times, please retry", entityType, maxIfRevisionAttempts), nil)
+}
+
+// writeIfRevision runs the write only if the entity is still at ifRevision, and the write is unconditional if ifRevision is 0
+func writeIfRevision(conn redis.Conn, revisionHash string, entityType string, name string, ifRevision uint64, write func(conn redis.Conn) errors.EdgeX) errors.EdgeX {
+	if ifRevision == 0 {
+		return write(conn)
+	}
+	return writeIfRevisions(conn, revisionHash, entityType, map[string]uint64{name: ifRevision}, write)
+}
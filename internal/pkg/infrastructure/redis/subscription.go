@@ -21,6 +21,7 @@ import (
 const (
 	SubscriptionCollection         = "sn|sub"
 	SubscriptionCollectionName     = SubscriptionCollection + DBKeySeparator + common.Name
+	SubscriptionCollectionRevision = SubscriptionCollection + DBKeySeparator + "revision"
 	SubscriptionCollectionCategory = SubscriptionCollection + DBKeySeparator + common.Category
 	SubscriptionCollectionLabel    = SubscriptionCollection + DBKeySeparator + common.Label
 	SubscriptionCollectionReceiver = SubscriptionCollection + DBKeySeparator + common.Receiver
@@ -78,6 +79,7 @@ func addSubscription(conn redis.Conn, subscription models.Subscription) (models.
 	if edgeXerr != nil {
 		return subscription, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendAddRevisionCmd(conn, SubscriptionCollectionRevision, subscription.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription creation failed", err)
@@ -199,6 +201,7 @@ func deleteSubscription(conn redis.Conn, subscription models.Subscription) error
 	storedKey := subscriptionStoredKey(subscription.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteSubscriptionCmd(conn, storedKey, subscription)
+	sendDeleteRevisionCmd(conn, SubscriptionCollectionRevision, subscription.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription deletion failed", err)
@@ -221,6 +224,7 @@ func updateSubscription(conn redis.Conn, subscription models.Subscription) error
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	sendIncrRevisionCmd(conn, SubscriptionCollectionRevision, subscription.Name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription update failed", err)
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -16,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
 )
 
 const (
@@ -24,6 +25,7 @@ const (
 	TransmissionCollectionSubscriptionName = TransmissionCollection + DBKeySeparator + common.Subscription + DBKeySeparator + common.Name
 	TransmissionCollectionNotificationId   = TransmissionCollection + DBKeySeparator + common.Notification + DBKeySeparator + common.Id
 	TransmissionCollectionCreated          = TransmissionCollection + DBKeySeparator + common.Created
+	TransmissionCollectionNextAttempt      = TransmissionCollection + DBKeySeparator + "nextattempt"
 )
 
 // notificationStoredKey return the transmission's stored key which combines the collection name and object id
@@ -40,8 +42,9 @@ func transmissionById(conn redis.Conn, id string) (trans models.Transmission, ed
 	return
 }
 
-// sendAddTransmissionCmd sends redis command for adding transmission
-func sendAddTransmissionCmd(conn redis.Conn, storedKey string, trans models.Transmission) errors.EdgeX {
+// sendAddTransmissionCmd sends redis command for adding transmission, the transmission is indexed by the time of its next resend
+// attempt, which is 0 for the transmissions without the next attempt time so that they are treated as due
+func sendAddTransmissionCmd(conn redis.Conn, storedKey string, trans models.Transmission, nextAttempt int64) errors.EdgeX {
 	m, err := json.Marshal(trans)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal transmission for Redis persistence", err)
@@ -52,6 +55,7 @@ func sendAddTransmissionCmd(conn redis.Conn, storedKey string, trans models.Tran
 	_ = conn.Send(ZADD, CreateKey(TransmissionCollectionStatus, string(trans.Status)), trans.Created, storedKey)
 	_ = conn.Send(ZADD, CreateKey(TransmissionCollectionSubscriptionName, trans.SubscriptionName), trans.Created, storedKey)
 	_ = conn.Send(ZADD, CreateKey(TransmissionCollectionNotificationId, trans.NotificationId), trans.Created, storedKey)
+	_ = conn.Send(ZADD, TransmissionCollectionNextAttempt, nextAttempt, storedKey)
 	return nil
 }
 
@@ -71,7 +75,7 @@ func addTransmission(conn redis.Conn, trans models.Transmission) (models.Transmi
 
 	storedKey := transmissionStoredKey(trans.Id)
 	_ = conn.Send(MULTI)
-	edgeXerr = sendAddTransmissionCmd(conn, storedKey, trans)
+	edgeXerr = sendAddTransmissionCmd(conn, storedKey, trans, 0)
 	if edgeXerr != nil {
 		return trans, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -91,6 +95,7 @@ func sendDeleteTransmissionCmd(conn redis.Conn, storedKey string, trans models.T
 	_ = conn.Send(ZREM, CreateKey(TransmissionCollectionStatus, string(trans.Status)), storedKey)
 	_ = conn.Send(ZREM, CreateKey(TransmissionCollectionSubscriptionName, trans.SubscriptionName), storedKey)
 	_ = conn.Send(ZREM, CreateKey(TransmissionCollectionNotificationId, trans.NotificationId), storedKey)
+	_ = conn.Send(ZREM, TransmissionCollectionNextAttempt, storedKey)
 }
 
 // updateTransmission updates a transmission
@@ -103,7 +108,28 @@ func updateTransmission(conn redis.Conn, trans models.Transmission) errors.EdgeX
 
 	_ = conn.Send(MULTI)
 	sendDeleteTransmissionCmd(conn, storedKey, oldTransmission)
-	edgeXerr = sendAddTransmissionCmd(conn, storedKey, trans)
+	edgeXerr = sendAddTransmissionCmd(conn, storedKey, trans, 0)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission update failed", err)
+	}
+	return nil
+}
+
+// updateTransmissionWithNextAttempt updates a transmission and the time of its next resend attempt
+func updateTransmissionWithNextAttempt(conn redis.Conn, trans models.Transmission, nextAttempt int64) errors.EdgeX {
+	oldTransmission, edgeXerr := transmissionById(conn, trans.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := transmissionStoredKey(trans.Id)
+
+	_ = conn.Send(MULTI)
+	sendDeleteTransmissionCmd(conn, storedKey, oldTransmission)
+	edgeXerr = sendAddTransmissionCmd(conn, storedKey, trans, nextAttempt)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -160,6 +186,64 @@ func transmissionsByStatus(conn redis.Conn, offset int, limit int, status string
 	return objectsToTransmissions(objects)
 }
 
+// indexTransmissionNextAttempts adds the transmissions of the status which are not indexed by the next attempt time, e.g. the
+// ones stored before the next attempt time was introduced, to the next attempt index with score 0 so that they are treated as due
+func indexTransmissionNextAttempts(conn redis.Conn, status string) errors.EdgeX {
+	storedKeys, err := redis.Strings(conn.Do(ZRANGE, CreateKey(TransmissionCollectionStatus, status), 0, -1))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query transmissions by status %s from database failed", status), err)
+	}
+	if len(storedKeys) == 0 {
+		return nil
+	}
+
+	_ = conn.Send(MULTI)
+	for _, storedKey := range storedKeys {
+		// NX only adds the transmission which is not indexed yet, the next attempt time of the others is kept
+		_ = conn.Send(ZADD, TransmissionCollectionNextAttempt, NX, 0, storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission next attempt indexing failed", err)
+	}
+	return nil
+}
+
+// transmissionsByStatusAndNextAttempt queries transmissions by offset, limit, and status whose next resend attempt time is earlier than
+// or equal to nextAttempt in the ascending order of the next attempt time
+func transmissionsByStatusAndNextAttempt(conn redis.Conn, offset int, limit int, status string, nextAttempt int64) (transmissions []models.Transmission, edgeXerr errors.EdgeX) {
+	if limit == 0 {
+		return
+	}
+	// the scores of the intersection are the next attempt time, which are weighted from the next attempt index only
+	cacheSet := uuid.New().String()
+	defer func() {
+		// delete cache set
+		_, _ = conn.Do(DEL, cacheSet)
+	}()
+	args := redis.Args{}.Add(cacheSet, 2, TransmissionCollectionNextAttempt, CreateKey(TransmissionCollectionStatus, status), WEIGHTS, 1, 0)
+	_, err := conn.Do(ZINTERSTORE, args...)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.KindDatabaseError,
+			fmt.Sprintf("failed to execute %s command with args %v", ZINTERSTORE, args), err)
+	}
+
+	// ZRANGEBYSCORE key -inf nextAttempt LIMIT offset count
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, cacheSet, InfiniteMin, nextAttempt, LIMIT, offset, limit))
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.KindDatabaseError, "query transmissions by next attempt from database failed", err)
+	}
+	if len(storedKeys) == 0 {
+		return []models.Transmission{}, nil
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(storedKeys))
+	if edgeXerr != nil {
+		return transmissions, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return objectsToTransmissions(objects)
+}
+
 // transmissionsBySubscriptionName queries transmissions by offset, limit, and subscription name
 func transmissionsBySubscriptionName(conn redis.Conn, offset int, limit int, subscriptionName string) (transmissions []models.Transmission, err errors.EdgeX) {
 	objects, err := getObjectsByRevRange(conn, CreateKey(TransmissionCollectionSubscriptionName, subscriptionName), offset, limit)
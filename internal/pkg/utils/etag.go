@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	stdErrs "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+type ifMatchKey struct{}
+
+// ErrPreconditionFailed is wrapped by the errors of the conditional writes whose entity is not at the revision matching
+// the If-Match header value, so that the errors are responded with 412 Precondition Failed
+var ErrPreconditionFailed = stdErrs.New("precondition failed")
+
+// NewPreconditionFailedError returns the error of the conditional write of the entity, whose current revision doesn't
+// match the If-Match header value
+func NewPreconditionFailedError(entityType string, name string, ifMatch string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindStatusConflict,
+		fmt.Sprintf("%s '%s' has been changed, the If-Match %s doesn't match the current entity tag", entityType, name, ifMatch), ErrPreconditionFailed)
+}
+
+// ErrorCode returns the HTTP status code of the error, which is 412 Precondition Failed for the errors wrapping
+// ErrPreconditionFailed and the status code of the error kind otherwise
+func ErrorCode(err errors.EdgeX) int {
+	if stdErrs.Is(err, ErrPreconditionFailed) {
+		return http.StatusPreconditionFailed
+	}
+	return err.Code()
+}
+
+// ETag returns the strong entity tag of the entity revision
+func ETag(revision uint64) string {
+	return strconv.Quote(strconv.FormatUint(revision, 10))
+}
+
+// ETagMatches reports whether the If-Match header value matches the entity revision, which is true if the value is
+// "*" or any of its comma-separated entity tags is the entity tag of the revision
+func ETagMatches(ifMatch string, revision uint64) bool {
+	current := ETag(revision)
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == current {
+			return true
+		}
+	}
+	return false
+}
+
+// WithIfMatch returns the context carrying the If-Match header value of the request
+func WithIfMatch(ctx context.Context, ifMatch string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, ifMatch)
+}
+
+// IfMatchFromContext returns the If-Match header value carried by the context, an empty string is returned if the
+// request has no If-Match header
+func IfMatchFromContext(ctx context.Context) string {
+	ifMatch, _ := ctx.Value(ifMatchKey{}).(string)
+	return ifMatch
+}
+
+// IfMatch is the middleware putting the If-Match header of the PATCH, PUT and DELETE requests into the request context,
+// so that the writes made by the request are only applied if the stored entity is still at the expected revision
+func IfMatch(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		r := c.Request()
+		ifMatch := strings.TrimSpace(r.Header.Get(HeaderIfMatch))
+		switch r.Method {
+		case http.MethodPatch, http.MethodPut, http.MethodDelete:
+			if ifMatch != "" {
+				c.SetRequest(r.WithContext(WithIfMatch(r.Context(), ifMatch)))
+			}
+		}
+		return next(c)
+	}
+}
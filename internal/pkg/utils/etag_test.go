@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		ifMatch  string
+		revision uint64
+		expected bool
+	}{
+		{"Valid - same revision", `"3"`, 3, true},
+		{"Valid - any revision", "*", 3, true},
+		{"Valid - one of the revisions", `"1", "3"`, 3, true},
+		{"Invalid - stale revision", `"2"`, 3, false},
+		{"Invalid - weak entity tag", `W/"3"`, 3, false},
+		{"Invalid - unquoted entity tag", "3", 3, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, ETagMatches(testCase.ifMatch, testCase.revision))
+		})
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		ifMatch         string
+		expectedIfMatch string
+	}{
+		{"PATCH with If-Match", http.MethodPatch, `"3"`, `"3"`},
+		{"PUT with If-Match", http.MethodPut, `"3"`, `"3"`},
+		{"DELETE with If-Match", http.MethodDelete, "*", "*"},
+		{"PATCH without If-Match", http.MethodPatch, "", ""},
+		{"POST with If-Match", http.MethodPost, `"3"`, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(testCase.method, "/", http.NoBody)
+			if testCase.ifMatch != "" {
+				req.Header.Set(HeaderIfMatch, testCase.ifMatch)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+			var ifMatch string
+			err := IfMatch(func(c echo.Context) error {
+				ifMatch = IfMatchFromContext(c.Request().Context())
+				return nil
+			})(c)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedIfMatch, ifMatch)
+		})
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	preconditionFailed := NewPreconditionFailedError("device", "test-device", `"3"`)
+	assert.Equal(t, http.StatusPreconditionFailed, ErrorCode(preconditionFailed))
+	assert.Equal(t, http.StatusPreconditionFailed, ErrorCode(errors.NewCommonEdgeXWrapper(preconditionFailed)))
+	assert.Equal(t, http.StatusConflict, ErrorCode(errors.NewCommonEdgeX(errors.KindStatusConflict, "conflict", nil)))
+	assert.Equal(t, http.StatusNotFound, ErrorCode(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)))
+}
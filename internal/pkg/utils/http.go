@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -49,8 +49,9 @@ func WriteErrorResponse(w *echo.Response, ctx context.Context, lc logger.Logging
 		lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 	}
 	lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
-	errResponses := commonDTO.NewBaseResponse(requestId, err.Message(), err.Code())
-	WriteHttpHeader(w, ctx, err.Code())
+	code := ErrorCode(err)
+	errResponses := commonDTO.NewBaseResponse(requestId, err.Message(), code)
+	WriteHttpHeader(w, ctx, code)
 	return pkg.EncodeAndWriteResponse(errResponses, w, lc)
 }
 
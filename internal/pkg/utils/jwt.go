@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// CallerFromAuthorization returns the caller identity of the JWT in the Authorization header, which is the name claim of
+// the secret store identity token or the subject of the other tokens. The JWT is not verified here, and the name claim is
+// set by whoever issues the token, so the caller is informational only and must not be used for access control. An empty
+// caller is returned if there is no JWT.
+func CallerFromAuthorization(authorization string) string {
+	token, found := strings.CutPrefix(authorization, "Bearer ")
+	if !found {
+		return ""
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimSpace(token), claims); err != nil {
+		return ""
+	}
+	if name, ok := claims["name"].(string); ok && name != "" {
+		return name
+	}
+	subject, _ := claims.GetSubject()
+	return subject
+}
@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallerFromAuthorization(t *testing.T) {
+	sign := func(claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		return "Bearer " + token
+	}
+
+	tests := []struct {
+		name           string
+		authorization  string
+		expectedCaller string
+	}{
+		{"Valid - name claim", sign(jwt.MapClaims{"name": "app-rules-engine", "sub": "b9d4c8a4"}), "app-rules-engine"},
+		{"Valid - subject claim", sign(jwt.MapClaims{"sub": "admin"}), "admin"},
+		{"Valid - no authorization", "", ""},
+		{"Valid - not a bearer token", "Basic YWRtaW46cGFzc3dvcmQ=", ""},
+		{"Valid - malformed JWT", "Bearer not-a-jwt", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedCaller, CallerFromAuthorization(testCase.authorization))
+		})
+	}
+}
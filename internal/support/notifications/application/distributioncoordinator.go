@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -67,28 +67,13 @@ func transmit(dic *di.Container, n models.Notification, sub models.Subscription,
 		return trans, nil
 	}
 
-	// Resend the critical notification if the transmission is failed.
+	// Schedule to resend the critical notification if the transmission is failed, the transmission is escalated if the resend limit is reached.
 	if n.Severity == models.Critical && trans.Status == models.Failed {
-		// Change the transmission status to RESENDING which means this transmission process is resending the notification and should not be removed.
-		trans.Status = models.RESENDING
-		err = dbClient.UpdateTransmission(trans)
-		if err != nil {
-			lc.Error(err.Message())
-			return trans, errors.NewCommonEdgeXWrapper(err)
-		}
-		trans, err = reSend(dic, n, sub, trans)
+		trans, err = scheduleResend(dic, n, sub, trans)
 		if err != nil {
 			lc.Errorf("fail to handle the critical notification sending for the subscription %s with address %v, err: %v", sub.Name, address.GetBaseAddress(), err)
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
-	// Trigger a escalated notification if the transmission is Escalated
-	if trans.Status == models.Escalated {
-		err = escalatedSend(dic, n, trans)
-		if err != nil {
-			lc.Errorf("fail to handle the escalated notification sending, err: %v", err)
-			return trans, errors.NewCommonEdgeXWrapper(err)
-		}
-	}
 	return trans, nil
 }
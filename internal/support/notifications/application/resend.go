@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+const (
+	resendStrategyFixed       = "fixed"
+	resendStrategyExponential = "exponential"
+
+	defaultResendMultiplier = 2
+	defaultResendWorkers    = 10
+	// maxResendDelay avoids overflowing the duration when the exponential delay grows without an upper bound
+	maxResendDelay = float64(math.MaxInt32 * time.Second)
+)
+
+// AsyncResendTransmissions starts the resend scheduler which periodically resends the RESENDING transmissions whose next attempt
+// time is due. Since the next attempt time is persisted with the transmission, the RESENDING transmissions left by the previous
+// run of the service are picked up at the first polling after startup.
+func AsyncResendTransmissions(ctx context.Context, wg *sync.WaitGroup, interval time.Duration, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// the RESENDING transmissions stored before the next attempt time was introduced are indexed as due, otherwise they
+		// would never be picked up by the polling
+		if err := container.DBClientFrom(dic.Get).IndexTransmissionNextAttempts(models.RESENDING); err != nil {
+			lc.Errorf("Failed to index the next attempt time of the RESENDING transmissions, %v", err)
+		}
+		// poll at once to pick up the pending RESENDING transmissions at startup
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting notification resend scheduler")
+				return
+			case <-timer.C:
+				if err := resendDueTransmissions(dic); err != nil {
+					lc.Errorf("Failed to resend the transmissions, %v", err)
+				}
+				timer.Reset(interval)
+			}
+		}
+	}()
+}
+
+// resendDueTransmissions resends the RESENDING transmissions whose next attempt time is due with at most Resend.Workers
+// transmissions at the same time, and waits for the resending to be done so that the same transmission won't be resent by
+// the next polling at the same time
+func resendDueTransmissions(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	batchSize := config.Resend.BatchSize
+	if batchSize <= 0 {
+		batchSize = -1
+	}
+	transmissions, err := dbClient.TransmissionsByStatusAndNextAttempt(0, batchSize, models.RESENDING, pkgCommon.MakeTimestamp())
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	workers := config.Resend.Workers
+	if workers <= 0 {
+		workers = defaultResendWorkers
+	}
+	workers = min(workers, len(transmissions))
+
+	queue := make(chan models.Transmission)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for trans := range queue {
+				if err := resendTransmission(dic, trans); err != nil {
+					lc.Errorf("fail to resend the transmission %s, err: %v", trans.Id, err)
+				}
+			}
+		}()
+	}
+	for _, trans := range transmissions {
+		queue <- trans
+	}
+	close(queue)
+	wg.Wait()
+	return nil
+}
+
+// resendTransmission resends the notification of the RESENDING transmission
+func resendTransmission(dic *di.Container, trans models.Transmission) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	n, err := dbClient.NotificationById(trans.NotificationId)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		// the notification has been removed, so stop resending to avoid picking up the transmission again
+		trans.Status = models.Failed
+		if updateErr := dbClient.UpdateTransmission(trans); updateErr != nil {
+			return errors.NewCommonEdgeXWrapper(updateErr)
+		}
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("notification %s of the transmission does not exist, stop resending", trans.NotificationId), err)
+	} else if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	sub, err := dbClient.SubscriptionByName(trans.SubscriptionName)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		lc.Warnf("subscription %s of the transmission %s does not exist, resend with the default resend policy", trans.SubscriptionName, trans.Id)
+		sub = models.Subscription{Name: trans.SubscriptionName}
+	} else if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	_, err = reSend(dic, n, sub, trans)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// resendBackoff returns the resend backoff policy of the subscription, or the default one if the subscription doesn't override it
+func resendBackoff(config *config.ConfigurationStruct, sub models.Subscription) config.ResendBackoffInfo {
+	if backoff, ok := config.Writable.SubscriptionResendBackoffs[sub.Name]; ok {
+		return backoff
+	}
+	return config.Writable.ResendBackoff
+}
+
+// resendDelay calculates the delay before the specified resend attempt, which starts from 1, according to the backoff policy
+func resendDelay(backoff config.ResendBackoffInfo, resendInterval time.Duration, attempt int) (time.Duration, errors.EdgeX) {
+	delay := float64(resendInterval)
+	switch backoff.Strategy {
+	case "", resendStrategyFixed:
+	case resendStrategyExponential:
+		multiplier := backoff.Multiplier
+		if multiplier <= 1 {
+			multiplier = defaultResendMultiplier
+		}
+		delay = delay * math.Pow(multiplier, float64(attempt-1))
+		if backoff.MaxInterval != "" {
+			maxInterval, err := time.ParseDuration(backoff.MaxInterval)
+			if err != nil {
+				return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the MaxInterval of resend backoff", err)
+			}
+			delay = math.Min(delay, float64(maxInterval))
+		}
+	default:
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported resend backoff strategy %s", backoff.Strategy), nil)
+	}
+
+	if backoff.Jitter > 0 {
+		jitter := math.Min(backoff.Jitter, 1)
+		// randomly add or subtract up to the jitter fraction of the delay
+		delay = delay * (1 + jitter*(2*rand.Float64()-1))
+	}
+	delay = math.Min(delay, maxResendDelay)
+	return time.Duration(delay), nil
+}
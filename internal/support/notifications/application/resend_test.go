@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResendDelay(t *testing.T) {
+	tests := []struct {
+		name          string
+		backoff       config.ResendBackoffInfo
+		attempt       int
+		expectedDelay time.Duration
+		errorExpected bool
+	}{
+		{"fixed by default", config.ResendBackoffInfo{}, 3, time.Second, false},
+		{"fixed", config.ResendBackoffInfo{Strategy: resendStrategyFixed}, 3, time.Second, false},
+		{"exponential first attempt", config.ResendBackoffInfo{Strategy: resendStrategyExponential}, 1, time.Second, false},
+		{"exponential third attempt with default multiplier", config.ResendBackoffInfo{Strategy: resendStrategyExponential}, 3, 4 * time.Second, false},
+		{"exponential third attempt with multiplier", config.ResendBackoffInfo{Strategy: resendStrategyExponential, Multiplier: 3}, 3, 9 * time.Second, false},
+		{"exponential with max interval", config.ResendBackoffInfo{Strategy: resendStrategyExponential, MaxInterval: "3s"}, 5, 3 * time.Second, false},
+		{"exponential without upper bound", config.ResendBackoffInfo{Strategy: resendStrategyExponential}, 1000, time.Duration(maxResendDelay), false},
+		{"invalid max interval", config.ResendBackoffInfo{Strategy: resendStrategyExponential, MaxInterval: "invalid"}, 1, 0, true},
+		{"unsupported strategy", config.ResendBackoffInfo{Strategy: "linear"}, 1, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			delay, err := resendDelay(testCase.backoff, time.Second, testCase.attempt)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedDelay, delay)
+		})
+	}
+}
+
+func TestResendDelayWithJitter(t *testing.T) {
+	backoff := config.ResendBackoffInfo{Strategy: resendStrategyExponential, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		delay, err := resendDelay(backoff, time.Second, 2)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, delay, time.Second)
+		assert.LessOrEqual(t, delay, 3*time.Second)
+	}
+}
+
+func TestResendBackoff(t *testing.T) {
+	dic := mockDic()
+	configuration := container.ConfigurationFrom(dic.Get)
+	configuration.Writable.ResendBackoff = config.ResendBackoffInfo{Strategy: resendStrategyFixed}
+	configuration.Writable.SubscriptionResendBackoffs = map[string]config.ResendBackoffInfo{
+		sub.Name: {Strategy: resendStrategyExponential},
+	}
+
+	assert.Equal(t, resendStrategyExponential, resendBackoff(configuration, sub).Strategy)
+	assert.Equal(t, resendStrategyFixed, resendBackoff(configuration, models.Subscription{Name: "other"}).Strategy)
+}
+
+func TestResendDueTransmissions(t *testing.T) {
+	dic := mockDic()
+	missingNotificationId := "missing"
+	dueTrans := models.NewTransmission(sub.Name, testRestAddress, notification.Id)
+	dueTrans.Id = "due"
+	dueTrans.Status = models.RESENDING
+	orphanedTrans := models.NewTransmission(sub.Name, testRestAddress, missingNotificationId)
+	orphanedTrans.Id = "orphaned"
+	orphanedTrans.Status = models.RESENDING
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TransmissionsByStatusAndNextAttempt", 0, -1, models.RESENDING, mock.Anything).Return([]models.Transmission{dueTrans, orphanedTrans}, nil)
+	dbClientMock.On("NotificationById", notification.Id).Return(notification, nil)
+	dbClientMock.On("NotificationById", missingNotificationId).Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("SubscriptionByName", sub.Name).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, testRestAddress).Return("", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+
+	err := resendDueTransmissions(dic)
+	require.NoError(t, err)
+
+	dbClientMock.AssertCalled(t, "UpdateTransmission", mock.MatchedBy(func(trans models.Transmission) bool {
+		return trans.Id == dueTrans.Id && trans.Status == models.Sent && trans.ResendCount == 1
+	}))
+	dbClientMock.AssertCalled(t, "UpdateTransmission", mock.MatchedBy(func(trans models.Transmission) bool {
+		return trans.Id == orphanedTrans.Id && trans.Status == models.Failed
+	}))
+	restSender.AssertNumberOfCalls(t, "Send", 1)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -32,40 +32,56 @@ func firstSend(dic *di.Container, n models.Notification, trans models.Transmissi
 	return trans
 }
 
-// reSend sends the Critical notification and return the transmission
+// reSend resends the Critical notification which is failed to send via the transmission and return the transmission.
+// If the resending is failed, the transmission keeps RESENDING with the next attempt time or is escalated when the resend
+// count reaches the limit.
 func reSend(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission) (models.Transmission, errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	record := sendNotificationViaChannel(dic, n, trans.Channel)
+	trans.ResendCount = trans.ResendCount + 1
+	trans.Records = append(trans.Records, record)
+	if record.Status == models.Failed {
+		// fail to transmit the notification, schedule the next resend attempt or escalate the transmission
+		lc.Warnf("fail to resend the critical notification to %s with address %v, transmission Id: %s, resend count: %d", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Id, trans.ResendCount)
+		return scheduleResend(dic, n, sub, trans)
+	}
+
+	trans.Status = record.Status
+	err := dbClient.UpdateTransmission(trans)
+	if err != nil {
+		return trans, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("success to send the critical notification to %s with address %v, transmission Id: %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Id)
+	return trans, nil
+}
+
+// scheduleResend updates the failed transmission to RESENDING with the next attempt time calculated by the resend backoff policy
+// of the subscription, so the resend scheduler can resend the notification even if the service restarts. When the resend count
+// reaches the limit, the transmission is escalated instead.
+func scheduleResend(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission) (models.Transmission, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
 
 	resendLimit, resendInterval, err := resendLimitAndInterval(config, sub)
 	if err != nil {
 		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
-	for i := 1; i <= resendLimit; i++ {
-		// Since this sending process is triggered for the critical notification which is failed to send to the subscription at the first time,
-		// so we wait seconds and retry to send the notification again.
-		time.Sleep(resendInterval)
-		lc.Warn("fail to send the critical notification. Retry to send again...")
-
-		record := sendNotificationViaChannel(dic, n, trans.Channel)
-		if record.Status == models.Failed {
-			// fail to transmit the notification, keep resending
-			trans.Status = models.RESENDING
-		} else {
-			trans.Status = record.Status
-		}
-		trans.ResendCount = trans.ResendCount + 1
-		trans.Records = append(trans.Records, record)
-		err = dbClient.UpdateTransmission(trans)
+	if trans.ResendCount < resendLimit {
+		delay, err := resendDelay(resendBackoff(config, sub), resendInterval, trans.ResendCount+1)
 		if err != nil {
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
-
-		if trans.Status == models.RESENDING {
-			continue
+		// Change the transmission status to RESENDING which means this transmission is waiting for resending the notification and should not be removed.
+		trans.Status = models.RESENDING
+		nextAttempt := pkgCommon.MakeTimestamp() + delay.Milliseconds()
+		err = dbClient.UpdateTransmissionWithNextAttempt(trans, nextAttempt)
+		if err != nil {
+			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
-		lc.Debugf("success to send the critical notification to %s with address %v, transmission Id: %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Id)
+		lc.Debugf("schedule to resend the critical notification to %s in %s, transmission Id: %s", trans.SubscriptionName, delay, trans.Id)
 		return trans, nil
 	}
 
@@ -75,6 +91,10 @@ func reSend(dic *di.Container, n models.Notification, sub models.Subscription, t
 	if err != nil {
 		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
+	err = escalatedSend(dic, n, trans)
+	if err != nil {
+		return trans, errors.NewCommonEdgeX(errors.Kind(err), "fail to handle the escalated notification sending", err)
+	}
 	return trans, nil
 }
 
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -12,7 +12,6 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
-	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -113,9 +112,10 @@ func TestFirstSend(t *testing.T) {
 
 func TestReSend(t *testing.T) {
 	dic := mockDic()
-	config := notificationContainer.ConfigurationFrom(dic.Get)
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateTransmissionWithNextAttempt", mock.Anything, mock.Anything).Return(nil)
+	dbClientMock.On("SubscriptionByName", models.EscalationSubscriptionName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -138,31 +138,35 @@ func TestReSend(t *testing.T) {
 	})
 
 	tests := []struct {
-		name          string
-		address       models.Address
-		expectedError bool
+		name                string
+		address             models.Address
+		resendCount         int
+		expectedStatus      models.TransmissionStatus
+		expectedResendCount int
 	}{
-		{"sent rest address successful", testRestAddress, false},
-		{"sent email address successful", testEmailAddress, false},
-		{"sent rest failed", testRestAddress2, true},
-		{"sent email failed", testEmailAddress2, true},
+		{"sent rest address successful", testRestAddress, 0, models.Sent, 1},
+		{"sent email address successful", testEmailAddress, 0, models.Sent, 1},
+		{"sent rest failed, schedule next attempt", testRestAddress2, 0, models.RESENDING, 1},
+		{"sent email failed, schedule next attempt", testEmailAddress2, 0, models.RESENDING, 1},
+		{"sent rest failed, resend limit reached", testRestAddress2, 1, models.Escalated, 2},
+		{"sent email failed, resend limit reached", testEmailAddress2, 1, models.Escalated, 2},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
 			sub.Channels = []models.Address{testCase.address}
 			trans := models.NewTransmission(sub.Name, testCase.address, notification.Id)
+			trans.ResendCount = testCase.resendCount
 
 			trans, err := reSend(dic, notification, sub, trans)
 			require.NoError(t, err)
 
-			if testCase.expectedError {
-				assert.EqualValues(t, models.Escalated, trans.Status)
-				assert.Equal(t, config.Writable.ResendLimit, trans.ResendCount)
-				assert.Equal(t, config.Writable.ResendLimit, len(trans.Records))
+			assert.EqualValues(t, testCase.expectedStatus, trans.Status)
+			assert.Equal(t, testCase.expectedResendCount, trans.ResendCount)
+			assert.Equal(t, 1, len(trans.Records))
+			if testCase.expectedStatus == models.RESENDING {
+				dbClientMock.AssertCalled(t, "UpdateTransmissionWithNextAttempt", trans, mock.Anything)
 			} else {
-				assert.EqualValues(t, models.Sent, trans.Status)
-				assert.Equal(t, 1, trans.ResendCount)
-				assert.Equal(t, 1, len(trans.Records))
+				dbClientMock.AssertCalled(t, "UpdateTransmission", trans)
 			}
 		})
 	}
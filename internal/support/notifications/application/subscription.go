@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -172,11 +172,15 @@ func DeleteSubscriptionByName(name string, ctx context.Context, dic *di.Containe
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, subscription.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	err = channel.RemoveClientFromCache(dic, subscription.Channels)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	err = dbClient.DeleteSubscriptionByName(name)
+	err = dbClient.DeleteSubscriptionByName(name, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -192,6 +196,10 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	ifRevision, err := ifMatchRevision(ctx, subscription.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	requests.ReplaceSubscriptionModelFieldsWithDTO(&subscription, dto)
 
@@ -199,7 +207,7 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription categories and labels can not be both empty", nil)
 	}
 
-	err = dbClient.UpdateSubscription(subscription)
+	err = dbClient.UpdateSubscription(subscription, ifRevision)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -231,3 +239,36 @@ func subscriptionByDTO(dbClient interfaces.DBClient, dto dtos.UpdateSubscription
 	}
 	return subscription, nil
 }
+
+// SubscriptionRevision returns the revision of the subscription by name, which is increased whenever the subscription
+// is updated
+func SubscriptionRevision(name string, dic *di.Container) (uint64, errors.EdgeX) {
+	if name == "" {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	revision, err := dbClient.SubscriptionRevision(name)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return revision, nil
+}
+
+// ifMatchRevision returns the revision of the subscription matching the If-Match header value carried by the context,
+// which should be passed to the write of the subscription so that the write is only applied if the subscription is
+// still at the revision. A precondition failed error is returned if the subscription has been changed since the client
+// read it, and revision 0 of the unconditional write is returned if the context carries no If-Match header value.
+func ifMatchRevision(ctx context.Context, name string, dic *di.Container) (uint64, errors.EdgeX) {
+	ifMatch := utils.IfMatchFromContext(ctx)
+	if ifMatch == "" {
+		return 0, nil
+	}
+	revision, err := SubscriptionRevision(name, dic)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	if !utils.ETagMatches(ifMatch, revision) {
+		return 0, utils.NewPreconditionFailedError("subscription", name, ifMatch)
+	}
+	return revision, nil
+}
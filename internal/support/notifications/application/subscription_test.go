@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
@@ -97,7 +98,9 @@ func TestPatchSubscription(t *testing.T) {
 
 	valid := updateSubscriptionData()
 	dbClientMock.On("SubscriptionById", *valid.Id).Return(model, nil)
-	dbClientMock.On("UpdateSubscription", model).Return(nil)
+	dbClientMock.On("UpdateSubscription", model, uint64(0)).Return(nil)
+	dbClientMock.On("UpdateSubscription", model, uint64(3)).Return(nil)
+	dbClientMock.On("SubscriptionRevision", *valid.Name).Return(uint64(3), nil)
 
 	emptyCategoriesAndLabels := updateSubscriptionData()
 	emptyCategoriesAndLabels.Categories = []string{}
@@ -118,15 +121,19 @@ func TestPatchSubscription(t *testing.T) {
 	tests := []struct {
 		name              string
 		subscription      dtos.UpdateSubscription
+		ifMatch           string
 		errorExpected     bool
 		expectedErrorKind errors.ErrKind
 	}{
-		{"valid", valid, false, ""},
-		{"invalid, empty categories and labels", emptyCategoriesAndLabels, true, errors.KindContractInvalid},
+		{"valid", valid, "", false, ""},
+		{"valid, If-Match matches the revision", valid, `"3"`, false, ""},
+		{"invalid, empty categories and labels", emptyCategoriesAndLabels, "", true, errors.KindContractInvalid},
+		{"invalid, If-Match doesn't match the revision", valid, `"2"`, true, errors.KindStatusConflict},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
-			err := PatchSubscription(context.Background(), testCase.subscription, dic)
+			ctx := utils.WithIfMatch(context.Background(), testCase.ifMatch)
+			err := PatchSubscription(ctx, testCase.subscription, dic)
 			if testCase.errorExpected {
 				require.Error(t, err)
 				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
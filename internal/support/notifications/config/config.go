@@ -30,6 +30,7 @@ type ConfigurationStruct struct {
 	MessageBus bootstrapConfig.MessageBusInfo
 	Smtp       SmtpInfo
 	Retention  NotificationRetention
+	Resend     ResendSchedulerInfo
 }
 
 type WritableInfo struct {
@@ -37,9 +38,26 @@ type WritableInfo struct {
 	// ResendLimit is the default retry limit for attempts to send notifications.
 	ResendLimit int
 	// ResendInterval is the default interval of resending the notification. The format of this field is to be an unsigned integer followed by a unit which may be "ns", "us" (or "µs"), "ms", "s", "m", "h" representing nanoseconds, microseconds, milliseconds, seconds, minutes or hours. Eg, "100ms", "24h"
-	ResendInterval  string
-	InsecureSecrets bootstrapConfig.InsecureSecrets
-	Telemetry       bootstrapConfig.TelemetryInfo
+	ResendInterval string
+	// ResendBackoff is the default backoff policy to calculate the interval between the resend attempts from the ResendInterval.
+	ResendBackoff ResendBackoffInfo
+	// SubscriptionResendBackoffs overrides the ResendBackoff for the specific subscriptions, the key is the subscription name.
+	SubscriptionResendBackoffs map[string]ResendBackoffInfo
+	InsecureSecrets            bootstrapConfig.InsecureSecrets
+	Telemetry                  bootstrapConfig.TelemetryInfo
+}
+
+type ResendBackoffInfo struct {
+	// Strategy is either "fixed" or "exponential". The fixed strategy waits the ResendInterval between the resend attempts, and
+	// the exponential strategy multiplies the interval by the Multiplier after each attempt. Defaults to "fixed" if empty.
+	Strategy string
+	// Multiplier is the factor by which the interval grows after each attempt for the exponential strategy. Defaults to 2 if not greater than 1.
+	Multiplier float64
+	// MaxInterval is the upper bound of the interval for the exponential strategy, e.g. "10m". There is no upper bound if empty.
+	MaxInterval string
+	// Jitter is the fraction between 0 and 1 of the interval which is randomly added to or subtracted from the interval
+	// to avoid the resend attempts of many transmissions happening at the same time.
+	Jitter float64
 }
 
 type SmtpInfo struct {
@@ -55,6 +73,15 @@ type SmtpInfo struct {
 	AuthMode string
 }
 
+type ResendSchedulerInfo struct {
+	// Interval is the interval of polling the RESENDING transmissions whose next resend attempt time is due.
+	Interval string
+	// BatchSize is the maximum number of transmissions to resend at each polling.
+	BatchSize int
+	// Workers is the maximum number of transmissions resent concurrently. Defaults to 10 if not greater than 0.
+	Workers int
+}
+
 type NotificationRetention struct {
 	Enabled  bool
 	Interval string
@@ -109,12 +109,18 @@ func (sc *SubscriptionController) SubscriptionByName(c echo.Context) error {
 	// URL parameters
 	name := c.Param(common.Name)
 
+	// the revision is read first, so that the entity tag is never newer than the returned subscription
+	revision, err := application.SubscriptionRevision(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 	subscription, err := application.SubscriptionByName(name, sc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
 	response := responseDTO.NewSubscriptionResponse("", "", http.StatusOK, subscription)
+	w.Header().Set(utils.HeaderETag, utils.ETag(revision))
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -236,7 +242,7 @@ func (sc *SubscriptionController) PatchSubscription(c echo.Context) error {
 		if err != nil {
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), utils.ErrorCode(err))
 		} else {
 			response = commonDTO.NewBaseResponse(reqId, "", http.StatusOK)
 		}
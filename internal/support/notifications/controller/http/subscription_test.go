@@ -13,6 +13,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -293,6 +295,8 @@ func TestSubscriptionByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
 	dbClientMock.On("SubscriptionByName", notFoundName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("SubscriptionRevision", subscription.Name).Return(uint64(3), nil)
+	dbClientMock.On("SubscriptionRevision", notFoundName).Return(uint64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -345,6 +349,7 @@ func TestSubscriptionByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.subscriptionName, res.Subscription.Name, "Name not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, `"3"`, recorder.Header().Get(utils.HeaderETag), "ETag not as expected")
 			}
 		})
 	}
@@ -582,8 +587,8 @@ func TestDeleteSubscriptionByName(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
-	dbClientMock.On("DeleteSubscriptionByName", subscription.Name).Return(nil)
-	dbClientMock.On("DeleteSubscriptionByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("DeleteSubscriptionByName", subscription.Name, mock.Anything).Return(nil)
+	dbClientMock.On("DeleteSubscriptionByName", notFoundName, mock.Anything).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", notFoundName).Return(subscription, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
 	mqttSender := &channel.MQTTSender{}
@@ -661,7 +666,7 @@ func TestPatchSubscription(t *testing.T) {
 
 	valid := testReq
 	dbClientMock.On("SubscriptionById", *valid.Subscription.Id).Return(subscriptionModel, nil)
-	dbClientMock.On("UpdateSubscription", subscriptionModel).Return(nil)
+	dbClientMock.On("UpdateSubscription", subscriptionModel, mock.Anything).Return(nil)
 	validWithNoReqID := testReq
 	validWithNoReqID.RequestId = ""
 	validWithNoId := testReq
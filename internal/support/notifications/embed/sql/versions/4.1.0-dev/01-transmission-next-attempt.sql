@@ -0,0 +1,11 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- next_attempt is the timestamp in milliseconds when the RESENDING transmission should be resent by the resend scheduler,
+-- the existing transmissions and the ones stored without the next attempt time are at 0, which means they are due
+ALTER TABLE support_notifications.transmission ADD COLUMN IF NOT EXISTS next_attempt BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_transmission_next_attempt
+    ON support_notifications.transmission(next_attempt);
@@ -0,0 +1,8 @@
+--
+-- Copyright (C) 2025 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- revision is increased by every update of the subscription and is used as the ETag for the optimistic concurrency
+-- control, the existing subscriptions start from revision 1
+ALTER TABLE support_notifications.subscription ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1;
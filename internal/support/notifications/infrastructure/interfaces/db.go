@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2021 IOTech Ltd
+// Copyright (C) 2020-2025 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -21,13 +21,14 @@ type DBClient interface {
 	SubscriptionsByCategory(offset, limit int, category string) ([]models.Subscription, errors.EdgeX)
 	SubscriptionsByLabel(offset, limit int, label string) ([]models.Subscription, errors.EdgeX)
 	SubscriptionsByReceiver(offset, limit int, receiver string) ([]models.Subscription, errors.EdgeX)
-	DeleteSubscriptionByName(name string) errors.EdgeX
-	UpdateSubscription(s models.Subscription) errors.EdgeX
+	DeleteSubscriptionByName(name string, ifRevision uint64) errors.EdgeX
+	UpdateSubscription(s models.Subscription, ifRevision uint64) errors.EdgeX
 	SubscriptionsByCategoriesAndLabels(offset, limit int, categories []string, labels []string) ([]models.Subscription, errors.EdgeX)
 	SubscriptionTotalCount() (uint32, errors.EdgeX)
 	SubscriptionCountByCategory(category string) (uint32, errors.EdgeX)
 	SubscriptionCountByLabel(label string) (uint32, errors.EdgeX)
 	SubscriptionCountByReceiver(receiver string) (uint32, errors.EdgeX)
+	SubscriptionRevision(name string) (uint64, errors.EdgeX)
 
 	AddNotification(n models.Notification) (models.Notification, errors.EdgeX)
 	NotificationById(id string) (models.Notification, errors.EdgeX)
@@ -54,10 +55,13 @@ type DBClient interface {
 
 	AddTransmission(trans models.Transmission) (models.Transmission, errors.EdgeX)
 	UpdateTransmission(trans models.Transmission) errors.EdgeX
+	UpdateTransmissionWithNextAttempt(trans models.Transmission, nextAttempt int64) errors.EdgeX
 	TransmissionById(id string) (models.Transmission, errors.EdgeX)
 	TransmissionsByTimeRange(start int64, end int64, offset int, limit int) ([]models.Transmission, errors.EdgeX)
 	AllTransmissions(offset int, limit int) ([]models.Transmission, errors.EdgeX)
 	TransmissionsByStatus(offset, limit int, status string) ([]models.Transmission, errors.EdgeX)
+	TransmissionsByStatusAndNextAttempt(offset, limit int, status string, nextAttempt int64) ([]models.Transmission, errors.EdgeX)
+	IndexTransmissionNextAttempts(status string) errors.EdgeX
 	DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX
 	TransmissionsBySubscriptionName(offset, limit int, subscriptionName string) ([]models.Transmission, errors.EdgeX)
 	TransmissionTotalCount() (uint32, errors.EdgeX)
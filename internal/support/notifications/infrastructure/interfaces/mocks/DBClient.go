@@ -1,4 +1,4 @@
-// Code generated by mockery v2.45.0. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -191,7 +191,7 @@ func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
 	return r0
 }
 
-// CloseSession provides a mock function with given fields:
+// CloseSession provides a mock function with no fields
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
@@ -276,17 +276,37 @@ func (_m *DBClient) DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX {
 	return r0
 }
 
-// DeleteSubscriptionByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// DeleteSubscriptionByName provides a mock function with given fields: name, ifRevision
+func (_m *DBClient) DeleteSubscriptionByName(name string, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(name, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteSubscriptionByName")
 	}
 
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, uint64) errors.EdgeX); ok {
+		r0 = rf(name, ifRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// IndexTransmissionNextAttempts provides a mock function with given fields: status
+func (_m *DBClient) IndexTransmissionNextAttempts(status string) errors.EdgeX {
+	ret := _m.Called(status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IndexTransmissionNextAttempts")
+	}
+
 	var r0 errors.EdgeX
 	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+		r0 = rf(status)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -536,7 +556,7 @@ func (_m *DBClient) NotificationCountByTimeRange(start int64, end int64, ack str
 	return r0, r1
 }
 
-// NotificationTotalCount provides a mock function with given fields:
+// NotificationTotalCount provides a mock function with no fields
 func (_m *DBClient) NotificationTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
 
@@ -908,7 +928,37 @@ func (_m *DBClient) SubscriptionCountByReceiver(receiver string) (uint32, errors
 	return r0, r1
 }
 
-// SubscriptionTotalCount provides a mock function with given fields:
+// SubscriptionRevision provides a mock function with given fields: name
+func (_m *DBClient) SubscriptionRevision(name string) (uint64, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscriptionRevision")
+	}
+
+	var r0 uint64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint64, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SubscriptionTotalCount provides a mock function with no fields
 func (_m *DBClient) SubscriptionTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
 
@@ -1216,7 +1266,7 @@ func (_m *DBClient) TransmissionCountByTimeRange(start int64, end int64) (uint32
 	return r0, r1
 }
 
-// TransmissionTotalCount provides a mock function with given fields:
+// TransmissionTotalCount provides a mock function with no fields
 func (_m *DBClient) TransmissionTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
 
@@ -1310,6 +1360,38 @@ func (_m *DBClient) TransmissionsByStatus(offset int, limit int, status string)
 	return r0, r1
 }
 
+// TransmissionsByStatusAndNextAttempt provides a mock function with given fields: offset, limit, status, nextAttempt
+func (_m *DBClient) TransmissionsByStatusAndNextAttempt(offset int, limit int, status string, nextAttempt int64) ([]models.Transmission, errors.EdgeX) {
+	ret := _m.Called(offset, limit, status, nextAttempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransmissionsByStatusAndNextAttempt")
+	}
+
+	var r0 []models.Transmission
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string, int64) ([]models.Transmission, errors.EdgeX)); ok {
+		return rf(offset, limit, status, nextAttempt)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, int64) []models.Transmission); ok {
+		r0 = rf(offset, limit, status, nextAttempt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transmission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, int64) errors.EdgeX); ok {
+		r1 = rf(offset, limit, status, nextAttempt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // TransmissionsBySubscriptionName provides a mock function with given fields: offset, limit, subscriptionName
 func (_m *DBClient) TransmissionsBySubscriptionName(offset int, limit int, subscriptionName string) ([]models.Transmission, errors.EdgeX) {
 	ret := _m.Called(offset, limit, subscriptionName)
@@ -1414,17 +1496,17 @@ func (_m *DBClient) UpdateNotificationAckStatusByIds(ack bool, ids []string) err
 	return r0
 }
 
-// UpdateSubscription provides a mock function with given fields: s
-func (_m *DBClient) UpdateSubscription(s models.Subscription) errors.EdgeX {
-	ret := _m.Called(s)
+// UpdateSubscription provides a mock function with given fields: s, ifRevision
+func (_m *DBClient) UpdateSubscription(s models.Subscription, ifRevision uint64) errors.EdgeX {
+	ret := _m.Called(s, ifRevision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSubscription")
 	}
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.Subscription) errors.EdgeX); ok {
-		r0 = rf(s)
+	if rf, ok := ret.Get(0).(func(models.Subscription, uint64) errors.EdgeX); ok {
+		r0 = rf(s, ifRevision)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -1454,6 +1536,26 @@ func (_m *DBClient) UpdateTransmission(trans models.Transmission) errors.EdgeX {
 	return r0
 }
 
+// UpdateTransmissionWithNextAttempt provides a mock function with given fields: trans, nextAttempt
+func (_m *DBClient) UpdateTransmissionWithNextAttempt(trans models.Transmission, nextAttempt int64) errors.EdgeX {
+	ret := _m.Called(trans, nextAttempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransmissionWithNextAttempt")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.Transmission, int64) errors.EdgeX); ok {
+		r0 = rf(trans, nextAttempt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDBClient(t interface {
@@ -71,6 +71,17 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
+	resendInterval, err := time.ParseDuration(config.Resend.Interval)
+	if err != nil {
+		lc.Errorf("Failed to parse notification resend interval, %v", err)
+		return false
+	}
+	if resendInterval <= 0 {
+		lc.Errorf("Notification resend interval '%s' should be greater than zero", config.Resend.Interval)
+		return false
+	}
+	application.AsyncResendTransmissions(ctx, wg, resendInterval, dic)
+
 	if config.Retention.Enabled {
 		retentionInterval, err := time.ParseDuration(config.Retention.Interval)
 		if err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	notificationsController "github.com/edgexfoundry/edgex-go/internal/support/notifications/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -19,6 +20,7 @@ import (
 
 func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	authenticationHook := handlers.AutoConfigAuthenticationFunc(dic)
+	r.Use(utils.IfMatch)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)